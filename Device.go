@@ -1,6 +1,9 @@
 package gonvif
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
 	"encoding/xml"
 	"errors"
 	"fmt"
@@ -10,6 +13,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/antchfx/xmlquery"
 	"github.com/beevik/etree"
@@ -17,6 +21,7 @@ import (
 	"github.com/sonnt85/gonvif/gosoap"
 	"github.com/sonnt85/gonvif/networking"
 	"github.com/sonnt85/gonvif/wsdiscovery"
+	"github.com/sonnt85/gonvif/xsd/onvif"
 )
 
 // Xlmns XML Scheam
@@ -24,6 +29,7 @@ var Xlmns = map[string]string{
 	"onvif":   "http://www.onvif.org/ver10/schema",
 	"tds":     "http://www.onvif.org/ver10/device/wsdl",
 	"trt":     "http://www.onvif.org/ver10/media/wsdl",
+	"tr2":     "http://www.onvif.org/ver20/media/wsdl",
 	"tev":     "http://www.onvif.org/ver10/events/wsdl",
 	"tptz":    "http://www.onvif.org/ver20/ptz/wsdl",
 	"timg":    "http://www.onvif.org/ver20/imaging/wsdl",
@@ -73,6 +79,7 @@ type DeviceInfo device.GetDeviceInformationResponse
 type Device struct {
 	params    DeviceParams
 	endpoints map[string]string
+	tlsState  *tls.ConnectionState
 	DeviceInfo
 }
 
@@ -81,6 +88,29 @@ type DeviceParams struct {
 	Username   string
 	Password   string
 	HttpClient *http.Client
+	// Scheme of the device service URL built from a bare host Xaddr, "http" when empty
+	Scheme string
+	// TLSConfig is used for https endpoints (custom RootCAs, client Certificates...)
+	TLSConfig *tls.Config
+	// PinnedSHA256 lists the SHA-256 fingerprints (hex) of the accepted server
+	// certificates. When set it replaces CA verification, e.g. for self-signed cameras
+	PinnedSHA256 []string
+	// SoapVersion of the envelopes sent to the device. NewDevice falls back
+	// from SOAP 1.2 to SOAP 1.1 when the device reports a version mismatch
+	SoapVersion gosoap.SoapVersion
+	// Middlewares wrap every SOAP call, the first one is the outermost
+	Middlewares []Middleware
+	// Retry replays failed idempotent calls, nil disables retries
+	Retry *RetryPolicy
+	// EndpointPolicy rewrites the service addresses advertised by the device
+	EndpointPolicy EndpointPolicy
+	// EndpointHost is the host or host:port forced by EndpointPolicy for every service
+	// including the device service, Xaddr when empty
+	EndpointHost string
+	// EndpointPathPrefix is prepended to every service path, e.g. "/cam1" behind a reverse proxy
+	EndpointPathPrefix string
+	// EndpointMapper maps advertised service addresses, it takes precedence over EndpointPolicy
+	EndpointMapper EndpointMapper
 }
 
 // GetServices return available endpoints
@@ -88,6 +118,21 @@ func (dev *Device) GetServices() map[string]string {
 	return dev.endpoints
 }
 
+// GetSoapVersion return the SOAP version used to talk to the device
+func (dev *Device) GetSoapVersion() gosoap.SoapVersion {
+	return dev.params.SoapVersion
+}
+
+// IsEncrypted reports whether the device service was reached over TLS
+func (dev *Device) IsEncrypted() bool {
+	return dev.tlsState != nil
+}
+
+// GetTLSState return the TLS connection state of the device service, nil over plain http
+func (dev *Device) GetTLSState() *tls.ConnectionState {
+	return dev.tlsState
+}
+
 // GetDeviceInfo return available endpoints
 func (dev *Device) GetDeviceInfo() DeviceInfo {
 	return dev.DeviceInfo
@@ -165,6 +210,43 @@ func (dev *Device) getSupportedServices(resp *http.Response) {
 	}
 }
 
+// serviceNamespaces names the endpoints of the services that GetCapabilities
+// does not advertise, by namespace of their GetServices entry
+var serviceNamespaces = map[string]string{
+	"http://www.onvif.org/ver20/media/wsdl": "media2",
+}
+
+// getServiceEndpoints adds the endpoints only listed by GetServices, e.g.
+// Media2. Devices without GetServices keep the GetCapabilities endpoints
+func (dev *Device) getServiceEndpoints() {
+	resp, err := dev.CallMethod(device.GetServices{})
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return
+	}
+
+	doc := etree.NewDocument()
+	if _, err := doc.ReadFrom(resp.Body); err != nil {
+		return
+	}
+	for _, service := range doc.FindElements("./Envelope/Body/GetServicesResponse/Service") {
+		namespace, xaddr := service.SelectElement("Namespace"), service.SelectElement("XAddr")
+		if namespace == nil || xaddr == nil {
+			continue
+		}
+		key, ok := serviceNamespaces[strings.TrimSpace(namespace.Text())]
+		if !ok {
+			continue
+		}
+		if _, found := dev.endpoints[key]; !found {
+			dev.addEndpoint(key, strings.TrimSpace(xaddr.Text()))
+		}
+	}
+}
+
 // NewDevice function construct a ONVIF Device entity
 func NewDevice(params DeviceParams) (*Device, error) {
 	dev := new(Device)
@@ -176,22 +258,44 @@ func NewDevice(params DeviceParams) (*Device, error) {
 		}
 		dev.addEndpoint("Device", params.Xaddr)
 	} else {
-		dev.addEndpoint("Device", "http://"+dev.params.Xaddr+"/onvif/device_service")
+		scheme := dev.params.Scheme
+		if scheme == "" {
+			scheme = "http"
+		}
+		dev.addEndpoint("Device", scheme+"://"+dev.params.Xaddr+"/onvif/device_service")
 	}
 
 	if dev.params.HttpClient == nil {
 		dev.params.HttpClient = new(http.Client)
 	}
+	if dev.params.TLSConfig != nil || len(dev.params.PinnedSHA256) != 0 {
+		client, err := networking.NewTLSClient(dev.params.HttpClient, dev.params.TLSConfig, dev.params.PinnedSHA256)
+		if err != nil {
+			return nil, err
+		}
+		dev.params.HttpClient = client
+	}
 
-	getCapabilities := device.GetCapabilities{Category: "All"}
+	getCapabilities := device.GetCapabilities{Category: []onvif.CapabilityCategory{"All"}}
 
 	resp, err := dev.CallMethod(getCapabilities)
 
-	if err != nil || resp.StatusCode != http.StatusOK {
+	if err == nil && dev.params.SoapVersion == gosoap.SOAP12 && isVersionMismatch(resp) {
+		dev.params.SoapVersion = gosoap.SOAP11
+		resp, err = dev.CallMethod(getCapabilities)
+	}
+
+	if err != nil {
 		return nil, errors.New("camera is not available at " + dev.params.Xaddr + " or it does not support ONVIF services" + "[" + err.Error() + "]")
 	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, errors.New("camera is not available at " + dev.params.Xaddr + " or it does not support ONVIF services" + "[" + resp.Status + "]")
+	}
+	dev.tlsState = resp.TLS
 
 	dev.getSupportedServices(resp)
+	dev.getServiceEndpoints()
 	if dev.params.Username != "" || dev.params.Password != "" {
 		if resp, err := dev.CallMethod(device.GetDeviceInformation{}); err == nil {
 			// responestr := io.ReadAll(resp)
@@ -213,16 +317,36 @@ func NewDevice(params DeviceParams) (*Device, error) {
 	return dev, nil
 }
 
+// isVersionMismatch reports whether the device rejected the SOAP envelope version,
+// either with HTTP 415 or with a VersionMismatch fault. The body is kept readable
+// when it is not a mismatch
+func isVersionMismatch(resp *http.Response) bool {
+	if resp.StatusCode == http.StatusUnsupportedMediaType {
+		resp.Body.Close()
+		return true
+	}
+	if resp.StatusCode == http.StatusOK {
+		return false
+	}
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return false
+	}
+	if fault := gosoap.ParseFault(data); fault != nil && fault.IsVersionMismatch() {
+		return true
+	}
+	return false
+}
+
 func (dev *Device) addEndpoint(Key, Value string) {
 	//use lowCaseKey
 	//make key having ability to handle Mixed Case for Different vendor devcie (e.g. Events EVENTS, events)
 	lowCaseKey := strings.ToLower(Key)
 
-	// Replace host with host from device params.
-	if u, err := url.Parse(Value); err == nil {
-		u.Host = dev.params.Xaddr
-		Value = u.String()
-	}
+	// Replace host with host from device params according to the endpoint policy.
+	Value = dev.rewriteEndpoint(lowCaseKey, Value)
 
 	dev.endpoints[lowCaseKey] = Value
 }
@@ -242,7 +366,7 @@ func (dev Device) buildMethodSOAP(msg string) (gosoap.SoapMessage, error) {
 	}
 	element := doc.Root()
 
-	soap := gosoap.NewEmptySOAP()
+	soap := gosoap.NewEmptySOAPVersion(dev.params.SoapVersion)
 	soap.AddBodyContent(element)
 
 	return soap, nil
@@ -272,35 +396,90 @@ func (dev Device) getEndpoint(endpoint string) (string, error) {
 // CallMethod functions call an method, defined <method> struct.
 // You should use Authenticate method to call authorized requests.
 func (dev Device) CallMethod(method interface{}) (*http.Response, error) {
-	pkgPath := strings.Split(reflect.TypeOf(method).PkgPath(), "/")
+	return dev.CallMethodContext(context.Background(), method)
+}
+
+// CallMethodContext is CallMethod bound to a context, the request is
+// cancelled together with ctx
+func (dev Device) CallMethodContext(ctx context.Context, method interface{}) (*http.Response, error) {
+	methodType := reflect.TypeOf(method)
+	for methodType.Kind() == reflect.Ptr {
+		methodType = methodType.Elem()
+	}
+	pkgPath := strings.Split(methodType.PkgPath(), "/")
 	pkg := strings.ToLower(pkgPath[len(pkgPath)-1])
 
 	endpoint, err := dev.getEndpoint(pkg)
 	if err != nil {
 		return nil, err
 	}
-	return dev.callMethodDo(endpoint, method)
+	return dev.callMethodDo(ctx, endpoint, method)
 }
 
 // CallMethod functions call an method, defined <method> struct with authentication data
-func (dev Device) callMethodDo(endpoint string, method interface{}) (*http.Response, error) {
+func (dev Device) callMethodDo(ctx context.Context, endpoint string, method interface{}) (*http.Response, error) {
 	output, err := xml.MarshalIndent(method, "  ", "    ")
 	if err != nil {
 		return nil, err
 	}
+	operation := soapOperation(output)
 
-	soap, err := dev.buildMethodSOAP(string(output))
-	if err != nil {
-		return nil, err
+	for attempt := 1; ; attempt++ {
+		soap, err := dev.buildMethodSOAP(string(output))
+		if err != nil {
+			return nil, err
+		}
+
+		soap.AddRootNamespaces(Xlmns)
+		soap.AddAction()
+
+		//Auth Handling
+		if dev.params.Username != "" && dev.params.Password != "" {
+			soap.AddWSSecurity(dev.params.Username, dev.params.Password)
+		}
+
+		req := &SoapRequest{
+			Context:   ctx,
+			Operation: operationName(operation),
+			Action:    gosoap.SoapAction(operation, Xlmns),
+			Endpoint:  endpoint,
+			Envelope:  []byte(soap.String()),
+		}
+		resp, err := dev.roundTrip(req)
+
+		delay, retry := dev.params.Retry.shouldRetry(ctx, req.Operation, attempt, resp, err)
+		if !retry {
+			return resp, err
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
 	}
+}
 
-	soap.AddRootNamespaces(Xlmns)
-	soap.AddAction()
+// send is the last handler of the middleware chain, it posts the envelope to the device
+func (dev Device) send(req *SoapRequest) (*http.Response, error) {
+	return networking.SendSoapContext(req.Context, dev.params.HttpClient, req.Endpoint, string(req.Envelope), dev.params.SoapVersion, req.Action)
+}
 
-	//Auth Handling
-	if dev.params.Username != "" && dev.params.Password != "" {
-		soap.AddWSSecurity(dev.params.Username, dev.params.Password)
+// soapOperation returns the qualified tag (prefix:Operation) of a marshaled method
+func soapOperation(method []byte) string {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(method); err != nil || doc.Root() == nil {
+		return ""
 	}
+	return doc.Root().FullTag()
+}
 
-	return networking.SendSoap(dev.params.HttpClient, endpoint, soap.String())
+// operationName strips the namespace prefix of an operation tag
+func operationName(tag string) string {
+	if i := strings.Index(tag, ":"); i >= 0 {
+		return tag[i+1:]
+	}
+	return tag
 }
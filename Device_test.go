@@ -0,0 +1,78 @@
+package gonvif
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sonnt85/gonvif/gosoap"
+)
+
+const versionMismatch = `<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body><s:Fault>
+	<faultcode>s:VersionMismatch</faultcode><faultstring>SOAP 1.1 only</faultstring>
+</s:Fault></s:Body></s:Envelope>`
+
+func TestIsVersionMismatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		mismatch bool
+	}{
+		{"unsupported media type", http.StatusUnsupportedMediaType, "", true},
+		{"SOAP 1.1 fault", http.StatusInternalServerError, versionMismatch, true},
+		{"SOAP 1.2 fault", http.StatusInternalServerError, `<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope">
+			<env:Body><env:Fault><env:Code><env:Value>env:VersionMismatch</env:Value></env:Code></env:Fault></env:Body></env:Envelope>`, true},
+		{"other fault", http.StatusBadRequest, `<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope">
+			<env:Body><env:Fault><env:Code><env:Value>env:Sender</env:Value></env:Code></env:Fault></env:Body></env:Envelope>`, false},
+		{"not a fault", http.StatusNotFound, "Not Found", false},
+		{"success", http.StatusOK, versionMismatch, false},
+	}
+	for _, test := range tests {
+		resp := &http.Response{StatusCode: test.status, Body: io.NopCloser(strings.NewReader(test.body))}
+		if got := isVersionMismatch(resp); got != test.mismatch {
+			t.Errorf("%s: isVersionMismatch = %v", test.name, got)
+			continue
+		}
+		if !test.mismatch {
+			// the body is left for the caller
+			if data, _ := io.ReadAll(resp.Body); string(data) != test.body {
+				t.Errorf("%s: body not readable after the check: %q", test.name, data)
+			}
+		}
+	}
+}
+
+func TestNewDeviceSoap11Fallback(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(data), gosoap.SOAP11.EnvelopeNamespace()) {
+			w.WriteHeader(http.StatusInternalServerError)
+			io.WriteString(w, versionMismatch)
+			return
+		}
+		if r.Header.Get("SOAPAction") == "" || !strings.HasPrefix(r.Header.Get("Content-Type"), "text/xml") {
+			t.Errorf("SOAP 1.1 request with headers %v", r.Header)
+		}
+		w.Header().Set("Content-Type", "text/xml")
+		io.WriteString(w, `<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/" xmlns:tds="http://www.onvif.org/ver10/device/wsdl"
+			xmlns:tt="http://www.onvif.org/ver10/schema"><s:Body><tds:GetCapabilitiesResponse><tds:Capabilities>
+			<tt:Media><tt:XAddr>`+server.URL+`/onvif/media_service</tt:XAddr></tt:Media>
+		</tds:Capabilities></tds:GetCapabilitiesResponse></s:Body></s:Envelope>`)
+	}))
+	defer server.Close()
+
+	dev, err := NewDevice(DeviceParams{Xaddr: strings.TrimPrefix(server.URL, "http://"), EndpointPolicy: EndpointKeepAdvertised})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dev.params.SoapVersion != gosoap.SOAP11 {
+		t.Errorf("SoapVersion = %s after a version mismatch", dev.params.SoapVersion)
+	}
+	if _, ok := dev.GetServices()["media"]; !ok {
+		t.Errorf("media endpoint not discovered over SOAP 1.1: %v", dev.GetServices())
+	}
+}
@@ -0,0 +1,250 @@
+package imaging
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Caller sends a request to the imaging service and decodes its response,
+// gonvif.Device implements it
+type Caller interface {
+	CallMethodUnmarshal(ctx context.Context, method, response interface{}) error
+}
+
+// OptionError is returned when a setting is not offered by the options
+type OptionError struct {
+	Field string
+	// Value is empty when the whole setting is not supported
+	Value     string
+	Supported []string
+}
+
+func (err *OptionError) Error() string {
+	if err.Value == "" {
+		return fmt.Sprintf("imaging %s is not supported", err.Field)
+	}
+	return fmt.Sprintf("imaging %s %s is not supported, supported: %v", err.Field, err.Value, err.Supported)
+}
+
+// RangeError is returned when a setting is out of its options range
+type RangeError struct {
+	Field string
+	Value float64
+	Range onvif.FloatRange
+}
+
+func (err *RangeError) Error() string {
+	return fmt.Sprintf("imaging %s %g is out of range [%g, %g]", err.Field, err.Value, err.Range.Min, err.Range.Max)
+}
+
+// Client reads and changes the imaging settings of a video source
+type Client struct {
+	VideoSource onvif.ReferenceToken
+	// PollInterval is the GetStatus interval of the focus moves, 200ms when 0
+	PollInterval time.Duration
+
+	caller Caller
+}
+
+// NewClient returns the Client of the video source videoSourceToken
+func NewClient(caller Caller, videoSourceToken onvif.ReferenceToken) *Client {
+	return &Client{VideoSource: videoSourceToken, caller: caller}
+}
+
+// Settings returns the imaging settings
+func (c *Client) Settings(ctx context.Context) (onvif.ImagingSettings20, error) {
+	var response GetImagingSettingsResponse
+	err := c.caller.CallMethodUnmarshal(ctx, GetImagingSettings{VideoSourceToken: c.VideoSource}, &response)
+	return response.ImagingSettings, err
+}
+
+// Options returns the modes and ranges of the imaging settings
+func (c *Client) Options(ctx context.Context) (onvif.ImagingOptions20, error) {
+	var response GetOptionsResponse
+	err := c.caller.CallMethodUnmarshal(ctx, GetOptions{VideoSourceToken: c.VideoSource}, &response)
+	return response.ImagingOptions, err
+}
+
+// Apply validates settings against the options then sets them. The settings
+// left nil are not sent and keep their value
+func (c *Client) Apply(ctx context.Context, settings onvif.ImagingSettings20) error {
+	options, err := c.Options(ctx)
+	if err != nil {
+		return err
+	}
+	if err := Validate(settings, options); err != nil {
+		return err
+	}
+	request := SetImagingSettings{VideoSourceToken: c.VideoSource, ImagingSettings: settings}
+	return c.caller.CallMethodUnmarshal(ctx, request, &SetImagingSettingsResponse{})
+}
+
+// Validate checks the modes and values of settings against options and
+// returns an *OptionError or a *RangeError for the first setting refused
+func Validate(settings onvif.ImagingSettings20, options onvif.ImagingOptions20) error {
+	var check checker
+	if s := settings.BacklightCompensation; s != nil && check.supported("BacklightCompensation", options.BacklightCompensation != nil) {
+		o := options.BacklightCompensation
+		check.mode("BacklightCompensation.Mode", string(s.Mode), o.Mode)
+		check.value("BacklightCompensation.Level", s.Level, o.Level)
+	}
+	check.value("Brightness", settings.Brightness, options.Brightness)
+	check.value("ColorSaturation", settings.ColorSaturation, options.ColorSaturation)
+	check.value("Contrast", settings.Contrast, options.Contrast)
+	check.value("Sharpness", settings.Sharpness, options.Sharpness)
+	if s := settings.Exposure; s != nil && check.supported("Exposure", options.Exposure != nil) {
+		o := options.Exposure
+		check.mode("Exposure.Mode", string(s.Mode), o.Mode)
+		check.mode("Exposure.Priority", string(s.Priority), o.Priority)
+		check.value("Exposure.MinExposureTime", s.MinExposureTime, o.MinExposureTime)
+		check.value("Exposure.MaxExposureTime", s.MaxExposureTime, o.MaxExposureTime)
+		check.value("Exposure.MinGain", s.MinGain, o.MinGain)
+		check.value("Exposure.MaxGain", s.MaxGain, o.MaxGain)
+		check.value("Exposure.MinIris", s.MinIris, o.MinIris)
+		check.value("Exposure.MaxIris", s.MaxIris, o.MaxIris)
+		check.value("Exposure.ExposureTime", s.ExposureTime, o.ExposureTime)
+		check.value("Exposure.Gain", s.Gain, o.Gain)
+		check.value("Exposure.Iris", s.Iris, o.Iris)
+	}
+	if s := settings.Focus; s != nil && check.supported("Focus", options.Focus != nil) {
+		o := options.Focus
+		check.mode("Focus.AutoFocusMode", string(s.AutoFocusMode), o.AutoFocusModes)
+		check.value("Focus.DefaultSpeed", s.DefaultSpeed, o.DefaultSpeed)
+		check.value("Focus.NearLimit", s.NearLimit, o.NearLimit)
+		check.value("Focus.FarLimit", s.FarLimit, o.FarLimit)
+	}
+	check.mode("IrCutFilter", string(settings.IrCutFilter), options.IrCutFilterModes)
+	if s := settings.WideDynamicRange; s != nil && check.supported("WideDynamicRange", options.WideDynamicRange != nil) {
+		check.mode("WideDynamicRange.Mode", string(s.Mode), options.WideDynamicRange.Mode)
+		check.value("WideDynamicRange.Level", s.Level, options.WideDynamicRange.Level)
+	}
+	if s := settings.WhiteBalance; s != nil && check.supported("WhiteBalance", options.WhiteBalance != nil) {
+		o := options.WhiteBalance
+		check.mode("WhiteBalance.Mode", string(s.Mode), o.Mode)
+		check.value("WhiteBalance.CrGain", s.CrGain, o.YrGain)
+		check.value("WhiteBalance.CbGain", s.CbGain, o.YbGain)
+	}
+	if settings.Extension != nil {
+		check.extension(*settings.Extension, options.Extension)
+	}
+	return check.err
+}
+
+// checker keeps the first error of a validation
+type checker struct {
+	err error
+}
+
+// supported records an OptionError when a setting is present without
+// options and reports whether its details are to be checked
+func (check *checker) supported(field string, ok bool) bool {
+	if !ok && check.err == nil {
+		check.err = &OptionError{Field: field}
+	}
+	return ok && check.err == nil
+}
+
+// mode checks a mode against the supported modes, a slice of a string type
+func (check *checker) mode(field, value string, supported interface{}) {
+	if value == "" || check.err != nil {
+		return
+	}
+	modes := reflect.ValueOf(supported)
+	names := make([]string, modes.Len())
+	for i := range names {
+		names[i] = modes.Index(i).String()
+		if names[i] == value {
+			return
+		}
+	}
+	check.err = &OptionError{Field: field, Value: value, Supported: names}
+}
+
+// value checks a value against its range, absent when not supported
+func (check *checker) value(field string, value *float64, r *onvif.FloatRange) {
+	if value == nil || check.err != nil {
+		return
+	}
+	if r == nil {
+		check.err = &OptionError{Field: field, Value: strconv.FormatFloat(*value, 'g', -1, 64)}
+	} else if *value < r.Min || *value > r.Max {
+		check.err = &RangeError{Field: field, Value: *value, Range: *r}
+	}
+}
+
+// level checks that a level is only set when the options allow it
+func (check *checker) level(field string, value *float64, allowed bool) {
+	if value != nil && !allowed && check.err == nil {
+		check.err = &OptionError{Field: field, Value: strconv.FormatFloat(*value, 'g', -1, 64)}
+	}
+}
+
+// extension checks the settings of the extensions
+func (check *checker) extension(settings onvif.ImagingSettingsExtension20, options *onvif.ImagingOptions20Extension) {
+	if options == nil {
+		options = &onvif.ImagingOptions20Extension{}
+	}
+	if s := settings.ImageStabilization; s != nil && check.supported("ImageStabilization", options.ImageStabilization != nil) {
+		check.mode("ImageStabilization.Mode", string(s.Mode), options.ImageStabilization.Mode)
+		check.value("ImageStabilization.Level", s.Level, options.ImageStabilization.Level)
+	}
+	settings202 := settings.Extension
+	if settings202 == nil {
+		return
+	}
+	options2 := options.Extension
+	if options2 == nil {
+		options2 = &onvif.ImagingOptions20Extension2{}
+	}
+	if len(settings202.IrCutFilterAutoAdjustment) > 0 && check.supported("IrCutFilterAutoAdjustment", options2.IrCutFilterAutoAdjustment != nil) {
+		o := options2.IrCutFilterAutoAdjustment
+		for _, s := range settings202.IrCutFilterAutoAdjustment {
+			check.mode("IrCutFilterAutoAdjustment.BoundaryType", s.BoundaryType, o.BoundaryType)
+			check.level("IrCutFilterAutoAdjustment.BoundaryOffset", s.BoundaryOffset, bool(o.BoundaryOffset))
+			check.duration("IrCutFilterAutoAdjustment.ResponseTime", s.ResponseTime, o.ResponseTimeRange)
+		}
+	}
+
+	settings203 := settings202.Extension
+	if settings203 == nil {
+		return
+	}
+	options3 := options2.Extension
+	if options3 == nil {
+		options3 = &onvif.ImagingOptions20Extension3{}
+	}
+	if s := settings203.ToneCompensation; s != nil && check.supported("ToneCompensation", options3.ToneCompensationOptions != nil) {
+		check.mode("ToneCompensation.Mode", s.Mode, options3.ToneCompensationOptions.Mode)
+		check.level("ToneCompensation.Level", s.Level, bool(options3.ToneCompensationOptions.Level))
+	}
+	if s := settings203.Defogging; s != nil && check.supported("Defogging", options3.DefoggingOptions != nil) {
+		check.mode("Defogging.Mode", s.Mode, options3.DefoggingOptions.Mode)
+		check.level("Defogging.Level", s.Level, bool(options3.DefoggingOptions.Level))
+	}
+	if s := settings203.NoiseReduction; s != nil && check.supported("NoiseReduction", options3.NoiseReductionOptions != nil) {
+		check.level("NoiseReduction.Level", &s.Level, bool(options3.NoiseReductionOptions.Level))
+	}
+}
+
+// duration checks a duration against its range, compared in seconds
+func (check *checker) duration(field string, value xsd.Duration, r *onvif.DurationRange) {
+	if value == "" || check.err != nil {
+		return
+	}
+	d, err := value.TimeDuration()
+	if r == nil || err != nil {
+		check.err = &OptionError{Field: field, Value: string(value)}
+		return
+	}
+	min, minErr := r.Min.TimeDuration()
+	max, maxErr := r.Max.TimeDuration()
+	if minErr == nil && maxErr == nil && (d < min || d > max) {
+		check.err = &RangeError{Field: field, Value: d.Seconds(), Range: onvif.FloatRange{Min: min.Seconds(), Max: max.Seconds()}}
+	}
+}
@@ -0,0 +1,158 @@
+package imaging
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sonnt85/gonvif/device"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// imagingCamera answers GetOptions with options and keeps the settings and
+// the last settings set. It stores the IR cut filter, exposure and WDR it
+// is sent, it can refuse them or silently ignore the WDR. Its focus moves
+// to the target over one status poll, the auto focus finds 0.42
+type imagingCamera struct {
+	mu        sync.Mutex
+	options   onvif.ImagingOptions20
+	settings  onvif.ImagingSettings20
+	set       *SetImagingSettings
+	sets      int
+	refuse    bool
+	ignoreWDR bool
+	// cancel is called when a set is refused
+	cancel context.CancelFunc
+	// locations answers GetGeoLocation
+	locations []onvif.LocationEntity
+
+	position, target float64
+	relativeOnly     bool
+	modes            []string
+	moves            []onvif.FocusMove
+	err              string
+}
+
+func (camera *imagingCamera) CallMethodUnmarshal(ctx context.Context, method, response interface{}) error {
+	camera.mu.Lock()
+	defer camera.mu.Unlock()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	switch method := method.(type) {
+	case GetOptions:
+		response.(*GetOptionsResponse).ImagingOptions = camera.options
+	case GetImagingSettings:
+		response.(*GetImagingSettingsResponse).ImagingSettings = camera.settings
+	case SetImagingSettings:
+		camera.sets++
+		if camera.refuse {
+			if camera.cancel != nil {
+				camera.cancel()
+			}
+			return errors.New("ter:InvalidArgVal")
+		}
+		camera.set = &method
+		settings := method.ImagingSettings
+		if settings.IrCutFilter != "" {
+			camera.settings.IrCutFilter = settings.IrCutFilter
+		}
+		if settings.Exposure != nil {
+			camera.settings.Exposure = settings.Exposure
+		}
+		if settings.WideDynamicRange != nil && !camera.ignoreWDR {
+			camera.settings.WideDynamicRange = settings.WideDynamicRange
+		}
+		if focus := settings.Focus; focus != nil {
+			camera.settings.Focus = focus
+			camera.modes = append(camera.modes, string(focus.AutoFocusMode))
+			if focus.AutoFocusMode == AutoFocusAuto {
+				camera.target = 0.42
+			}
+		}
+	case GetMoveOptions:
+		unit := onvif.FloatRange{Max: 1}
+		options := &response.(*GetMoveOptionsResponse).MoveOptions
+		options.Relative = &onvif.RelativeFocusOptions20{Distance: onvif.FloatRange{Min: -1, Max: 1}}
+		if !camera.relativeOnly {
+			options.Absolute = &onvif.AbsoluteFocusOptions{Position: unit, Speed: &unit}
+		}
+	case Move:
+		camera.moves = append(camera.moves, method.Focus)
+		if focus := method.Focus.Absolute; focus != nil {
+			camera.target = float64(focus.Position)
+		} else {
+			camera.target = camera.position + float64(method.Focus.Relative.Distance)
+		}
+	case GetStatus:
+		status := &onvif.FocusStatus20{Position: camera.position, MoveStatus: MoveStatusIdle, Error: camera.err}
+		if camera.position != camera.target {
+			status.MoveStatus = MoveStatusMoving
+			camera.position = camera.target
+		}
+		response.(*GetStatusResponse).Status.FocusStatus20 = status
+	case device.GetGeoLocation:
+		response.(*device.GetGeoLocationResponse).Location = camera.locations
+	default:
+		return errors.New("unexpected method")
+	}
+	return nil
+}
+
+func (camera *imagingCamera) irCutFilter() onvif.IrCutFilterMode {
+	camera.mu.Lock()
+	defer camera.mu.Unlock()
+	return camera.settings.IrCutFilter
+}
+
+func TestApply(t *testing.T) {
+	ctx := context.Background()
+	// brightness, manual exposure and tone compensation without level
+	camera := &imagingCamera{options: onvif.ImagingOptions20{
+		Brightness: &onvif.FloatRange{Max: 100},
+		Exposure: &onvif.ExposureOptions20{
+			Mode:         []onvif.ExposureMode{"AUTO", "MANUAL"},
+			ExposureTime: &onvif.FloatRange{Min: 10, Max: 40000},
+		},
+		Extension: &onvif.ImagingOptions20Extension{Extension: &onvif.ImagingOptions20Extension2{
+			Extension: &onvif.ImagingOptions20Extension3{ToneCompensationOptions: &onvif.ToneCompensationOptions{Mode: []string{"OFF", "AUTO"}}},
+		}},
+	}}
+	c := NewClient(camera, "vs0")
+	brightness, exposure, level := 70.0, 50000.0, 0.5
+
+	var rangeErr *RangeError
+	err := c.Apply(ctx, onvif.ImagingSettings20{Exposure: &onvif.Exposure20{Mode: "MANUAL", ExposureTime: &exposure}})
+	if !errors.As(err, &rangeErr) || rangeErr.Field != "Exposure.ExposureTime" || rangeErr.Range.Max != 40000 {
+		t.Errorf("long exposure: %v", err)
+	}
+	var optionErr *OptionError
+	err = c.Apply(ctx, onvif.ImagingSettings20{Contrast: &brightness})
+	if !errors.As(err, &optionErr) || optionErr.Field != "Contrast" {
+		t.Errorf("contrast: %v", err)
+	}
+	tone := &onvif.ImagingSettingsExtension20{Extension: &onvif.ImagingSettingsExtension202{
+		Extension: &onvif.ImagingSettingsExtension203{ToneCompensation: &onvif.ToneCompensation{Mode: "AUTO", Level: &level}},
+	}}
+	err = c.Apply(ctx, onvif.ImagingSettings20{Extension: tone})
+	if !errors.As(err, &optionErr) || optionErr.Field != "ToneCompensation.Level" {
+		t.Errorf("tone compensation level: %v", err)
+	}
+	if camera.set != nil {
+		t.Fatal("refused settings were sent")
+	}
+
+	tone.Extension.Extension.ToneCompensation.Level = nil
+	if err := c.Apply(ctx, onvif.ImagingSettings20{Brightness: &brightness, Extension: tone}); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := xml.Marshal(camera.set)
+	request := string(data)
+	if !strings.Contains(request, "<onvif:Brightness>70</onvif:Brightness>") || !strings.Contains(request, "<onvif:Mode>AUTO</onvif:Mode>") ||
+		strings.Contains(request, "Exposure") || strings.Contains(request, "Contrast") || strings.Contains(request, "Level") {
+		t.Errorf("unexpected request %s", request)
+	}
+}
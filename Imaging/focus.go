@@ -0,0 +1,259 @@
+package imaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sonnt85/gonvif/internal/rollback"
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Move status of the focus
+const (
+	MoveStatusIdle    = "IDLE"
+	MoveStatusMoving  = "MOVING"
+	MoveStatusUnknown = "UNKNOWN"
+)
+
+// Auto focus modes
+const (
+	AutoFocusAuto   = "AUTO"
+	AutoFocusManual = "MANUAL"
+)
+
+// ErrNoFocusStatus is returned when the device reports no focus status
+var ErrNoFocusStatus = errors.New("imaging status has no focus status")
+
+// ErrNoFocusMove is returned when the device supports neither absolute nor
+// relative focus moves
+var ErrNoFocusMove = errors.New("imaging focus has no absolute or relative move")
+
+// FocusError is the error reported by the focus status
+type FocusError struct {
+	Message string
+}
+
+func (err *FocusError) Error() string {
+	return "imaging focus error: " + err.Message
+}
+
+// FocusSample is a step of a focus sweep
+type FocusSample struct {
+	Position float64
+	// Sharpness is the value measured at the position
+	Sharpness float64
+	// Status is the focus status at the position, some devices report a
+	// focus value in its extension
+	Status onvif.FocusStatus20
+}
+
+// MoveOptions returns the focus moves supported and their ranges
+func (c *Client) MoveOptions(ctx context.Context) (onvif.MoveOptions20, error) {
+	var response GetMoveOptionsResponse
+	err := c.caller.CallMethodUnmarshal(ctx, GetMoveOptions{VideoSourceToken: c.VideoSource}, &response)
+	return response.MoveOptions, err
+}
+
+// FocusStatus returns the position and move status of the focus
+func (c *Client) FocusStatus(ctx context.Context) (onvif.FocusStatus20, error) {
+	var response GetStatusResponse
+	if err := c.caller.CallMethodUnmarshal(ctx, GetStatus{VideoSourceToken: c.VideoSource}, &response); err != nil {
+		return onvif.FocusStatus20{}, err
+	}
+	if response.Status.FocusStatus20 == nil {
+		return onvif.FocusStatus20{}, ErrNoFocusStatus
+	}
+	return *response.Status.FocusStatus20, nil
+}
+
+// StopFocus stops the focus moves
+func (c *Client) StopFocus(ctx context.Context) error {
+	return c.caller.CallMethodUnmarshal(ctx, Stop{VideoSourceToken: c.VideoSource}, &StopResponse{})
+}
+
+// WaitFocus polls the focus status until the focus stops moving and returns
+// the last status. Devices not reporting the move status are idle when two
+// polls give the same position
+func (c *Client) WaitFocus(ctx context.Context) (onvif.FocusStatus20, error) {
+	ticker := time.NewTicker(c.pollInterval())
+	defer ticker.Stop()
+
+	var last *float64
+	for {
+		status, err := c.FocusStatus(ctx)
+		if err != nil {
+			return status, err
+		}
+		if status.Error != "" {
+			return status, &FocusError{Message: status.Error}
+		}
+		if status.MoveStatus != "" && status.MoveStatus != MoveStatusUnknown {
+			if status.MoveStatus != MoveStatusMoving {
+				return status, nil
+			}
+		} else if last != nil && *last == status.Position {
+			return status, nil
+		}
+		last = &status.Position
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return status, ctx.Err()
+		}
+	}
+}
+
+// FocusTo moves the focus to position and waits for the move to complete.
+// Devices without absolute moves are moved relatively from the current
+// position. The speed is the default speed when 0. The focus must be in
+// MANUAL mode
+func (c *Client) FocusTo(ctx context.Context, position, speed float64) (onvif.FocusStatus20, error) {
+	options, err := c.MoveOptions(ctx)
+	if err != nil {
+		return onvif.FocusStatus20{}, err
+	}
+	var move onvif.FocusMove
+	switch {
+	case options.Absolute != nil:
+		if err := checkMove("Absolute.Position", position, &options.Absolute.Position); err != nil {
+			return onvif.FocusStatus20{}, err
+		}
+		move.Absolute = &onvif.AbsoluteFocus{Position: xsd.Float(position)}
+		if move.Absolute.Speed, err = moveSpeed("Absolute.Speed", speed, options.Absolute.Speed); err != nil {
+			return onvif.FocusStatus20{}, err
+		}
+	case options.Relative != nil:
+		status, err := c.FocusStatus(ctx)
+		if err != nil {
+			return status, err
+		}
+		distance := position - status.Position
+		if err := checkMove("Relative.Distance", distance, &options.Relative.Distance); err != nil {
+			return status, err
+		}
+		move.Relative = &onvif.RelativeFocus{Distance: xsd.Float(distance)}
+		if move.Relative.Speed, err = moveSpeed("Relative.Speed", speed, options.Relative.Speed); err != nil {
+			return status, err
+		}
+	default:
+		return onvif.FocusStatus20{}, ErrNoFocusMove
+	}
+
+	if err := c.caller.CallMethodUnmarshal(ctx, Move{VideoSourceToken: c.VideoSource, Focus: move}, &MoveResponse{}); err != nil {
+		return onvif.FocusStatus20{}, err
+	}
+	return c.WaitFocus(ctx)
+}
+
+// checkMove returns a RangeError when value is out of r
+func checkMove(field string, value float64, r *onvif.FloatRange) error {
+	if value < r.Min || value > r.Max {
+		return &RangeError{Field: field, Value: value, Range: *r}
+	}
+	return nil
+}
+
+// moveSpeed returns the speed of a move, nil for 0
+func moveSpeed(field string, speed float64, r *onvif.FloatRange) (*xsd.Float, error) {
+	if speed == 0 {
+		return nil, nil
+	}
+	if r == nil {
+		return nil, &OptionError{Field: field, Value: fmt.Sprint(speed)}
+	}
+	if err := checkMove(field, speed, r); err != nil {
+		return nil, err
+	}
+	value := xsd.Float(speed)
+	return &value, nil
+}
+
+// AutoFocus runs a one-shot auto focus: it switches the focus to AUTO,
+// waits settle and the end of the focus move, then restores MANUAL and
+// returns the focus status reached
+func (c *Client) AutoFocus(ctx context.Context, settle time.Duration) (onvif.FocusStatus20, error) {
+	settings, err := c.Settings(ctx)
+	if err != nil {
+		return onvif.FocusStatus20{}, err
+	}
+	if settings.Focus == nil {
+		return onvif.FocusStatus20{}, &OptionError{Field: "Focus"}
+	}
+	focus := *settings.Focus
+	focus.AutoFocusMode = AutoFocusAuto
+	if err := c.Apply(ctx, onvif.ImagingSettings20{Focus: &focus}); err != nil {
+		return onvif.FocusStatus20{}, err
+	}
+
+	status, err := c.settle(ctx, settle)
+	// restore the manual focus even though the caller gave up
+	focus.AutoFocusMode = AutoFocusManual
+	restore := SetImagingSettings{VideoSourceToken: c.VideoSource, ImagingSettings: onvif.ImagingSettings20{Focus: &focus}}
+	restoreCtx, cancel := rollback.Context()
+	defer cancel()
+	if restoreErr := c.caller.CallMethodUnmarshal(restoreCtx, restore, &SetImagingSettingsResponse{}); err == nil {
+		err = restoreErr
+	}
+	return status, err
+}
+
+// settle waits d then the end of the focus move
+func (c *Client) settle(ctx context.Context, d time.Duration) (onvif.FocusStatus20, error) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		return onvif.FocusStatus20{}, ctx.Err()
+	}
+	return c.WaitFocus(ctx)
+}
+
+// FocusSweep moves the focus through steps positions evenly spaced from
+// from to to and records at each the sharpness returned by measure, nil
+// to record the device status only. The focus must be in MANUAL mode, move
+// to the position of BestFocus to keep the sharpest
+func (c *Client) FocusSweep(ctx context.Context, from, to float64, steps int, measure func(ctx context.Context, position float64) (float64, error)) ([]FocusSample, error) {
+	if steps < 2 {
+		return nil, fmt.Errorf("focus sweep needs at least 2 steps, got %d", steps)
+	}
+	samples := make([]FocusSample, 0, steps)
+	for i := 0; i < steps; i++ {
+		position := from + (to-from)*float64(i)/float64(steps-1)
+		status, err := c.FocusTo(ctx, position, 0)
+		if err != nil {
+			return samples, err
+		}
+		sample := FocusSample{Position: position, Status: status}
+		if measure != nil {
+			if sample.Sharpness, err = measure(ctx, position); err != nil {
+				return samples, err
+			}
+		}
+		samples = append(samples, sample)
+	}
+	return samples, nil
+}
+
+// BestFocus returns the sample of the highest sharpness
+func BestFocus(samples []FocusSample) FocusSample {
+	var best FocusSample
+	for i, sample := range samples {
+		if i == 0 || sample.Sharpness > best.Sharpness {
+			best = sample
+		}
+	}
+	return best
+}
+
+// pollInterval returns the GetStatus interval
+func (c *Client) pollInterval() time.Duration {
+	if c.PollInterval == 0 {
+		return 200 * time.Millisecond
+	}
+	return c.PollInterval
+}
@@ -0,0 +1,57 @@
+package imaging
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+func TestFocus(t *testing.T) {
+	ctx := context.Background()
+	camera := &imagingCamera{
+		options:  onvif.ImagingOptions20{Focus: &onvif.FocusOptions20{AutoFocusModes: []onvif.AutoFocusMode{AutoFocusAuto, AutoFocusManual}}},
+		settings: onvif.ImagingSettings20{Focus: &onvif.FocusConfiguration20{AutoFocusMode: AutoFocusManual}},
+	}
+	c := NewClient(camera, "vs0")
+	c.PollInterval = time.Millisecond
+
+	// the focus moves are sent as xsd.Float
+	if status, err := c.FocusTo(ctx, 0.6, 0.5); err != nil || math.Abs(status.Position-0.6) > 1e-6 || *camera.moves[0].Absolute.Speed != 0.5 {
+		t.Errorf("FocusTo = %+v, %v", status, err)
+	}
+	var rangeErr *RangeError
+	if _, err := c.FocusTo(ctx, 1.5, 0); !errors.As(err, &rangeErr) || rangeErr.Field != "Absolute.Position" {
+		t.Errorf("FocusTo out of range: %v", err)
+	}
+	camera.relativeOnly = true
+	if status, err := c.FocusTo(ctx, 0.2, 0); err != nil || math.Abs(status.Position-0.2) > 1e-6 || camera.moves[1].Relative == nil {
+		t.Errorf("relative FocusTo = %+v, %v", status, err)
+	}
+	camera.relativeOnly = false
+
+	status, err := c.AutoFocus(ctx, time.Millisecond)
+	if err != nil || math.Abs(status.Position-0.42) > 1e-6 || !reflect.DeepEqual(camera.modes, []string{AutoFocusAuto, AutoFocusManual}) {
+		t.Errorf("AutoFocus = %+v, %v, modes %v", status, err, camera.modes)
+	}
+
+	samples, err := c.FocusSweep(ctx, 0, 1, 5, func(ctx context.Context, position float64) (float64, error) {
+		return -(position - 0.75) * (position - 0.75), nil
+	})
+	if err != nil || len(samples) != 5 || samples[4].Status.Position != 1 {
+		t.Fatalf("FocusSweep = %+v, %v", samples, err)
+	}
+	if best := BestFocus(samples); best.Position != 0.75 {
+		t.Errorf("best focus at %g", best.Position)
+	}
+
+	camera.err, camera.target = "motor blocked", 0.9
+	var focusErr *FocusError
+	if _, err := c.WaitFocus(ctx); !errors.As(err, &focusErr) {
+		t.Errorf("WaitFocus with error: %v", err)
+	}
+}
@@ -0,0 +1,269 @@
+package imaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/sonnt85/gonvif/device"
+	"github.com/sonnt85/gonvif/internal/rollback"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// ErrNoGeoLocation is returned when the device reports no geo location
+var ErrNoGeoLocation = errors.New("device has no geo location")
+
+// Scene is a named set of imaging settings, the settings left nil are not
+// changed when it is applied
+type Scene struct {
+	Name     string
+	Settings onvif.ImagingSettings20
+}
+
+// SceneError is returned when the device refuses a scene or does not apply
+// all of it. The settings changed by the scene are then restored
+type SceneError struct {
+	Scene string
+	// Err is the error of SetImagingSettings
+	Err error
+	// Rejected are the fields the device did not apply without an error
+	Rejected []string
+	// Rollback is the error restoring the settings
+	Rollback error
+}
+
+func (err *SceneError) Error() string {
+	message := fmt.Sprintf("imaging scene %s not applied: %s", err.Scene, strings.Join(err.Rejected, ", "))
+	if err.Err != nil {
+		message = fmt.Sprintf("imaging scene %s refused: %v", err.Scene, err.Err)
+	}
+	if err.Rollback != nil {
+		message += fmt.Sprintf(", rollback failed: %v", err.Rollback)
+	}
+	return message
+}
+
+// CaptureScene returns the current settings named by fields, the fields of
+// ImagingSettings20 such as "IrCutFilter" or "Exposure", as a scene. All
+// the settings are captured without fields
+func (c *Client) CaptureScene(ctx context.Context, name string, fields ...string) (Scene, error) {
+	settings, err := c.Settings(ctx)
+	if err != nil {
+		return Scene{}, err
+	}
+	if len(fields) == 0 {
+		return Scene{Name: name, Settings: settings}, nil
+	}
+	scene := Scene{Name: name}
+	from, to := reflect.ValueOf(settings), reflect.ValueOf(&scene.Settings).Elem()
+	for _, field := range fields {
+		value := from.FieldByName(field)
+		if !value.IsValid() {
+			return Scene{}, fmt.Errorf("imaging settings have no field %s", field)
+		}
+		to.FieldByName(field).Set(value)
+	}
+	return scene, nil
+}
+
+// ApplyScene validates the scene against the options and sets it, then
+// reads the settings back. When the device refuses the scene or does not
+// apply all of it, the settings it changes are restored and a *SceneError
+// is returned
+func (c *Client) ApplyScene(ctx context.Context, scene Scene) error {
+	current, err := c.Settings(ctx)
+	if err != nil {
+		return err
+	}
+	options, err := c.Options(ctx)
+	if err != nil {
+		return err
+	}
+	if err := Validate(scene.Settings, options); err != nil {
+		return err
+	}
+
+	request := SetImagingSettings{VideoSourceToken: c.VideoSource, ImagingSettings: scene.Settings}
+	sceneErr := &SceneError{Scene: scene.Name}
+	sceneErr.Err = c.caller.CallMethodUnmarshal(ctx, request, &SetImagingSettingsResponse{})
+	if sceneErr.Err == nil {
+		applied, err := c.Settings(ctx)
+		if err != nil {
+			return err
+		}
+		sceneErr.Rejected = differences(reflect.ValueOf(scene.Settings), reflect.ValueOf(applied), "", false, nil)
+		if len(sceneErr.Rejected) == 0 {
+			return nil
+		}
+	}
+
+	var previous onvif.ImagingSettings20
+	restrict(reflect.ValueOf(&previous).Elem(), reflect.ValueOf(current), reflect.ValueOf(scene.Settings), false)
+	restore := SetImagingSettings{VideoSourceToken: c.VideoSource, ImagingSettings: previous}
+	rollbackCtx, cancel := rollback.Context()
+	defer cancel()
+	sceneErr.Rollback = c.caller.CallMethodUnmarshal(rollbackCtx, restore, &SetImagingSettingsResponse{})
+	return sceneErr
+}
+
+// restrict sets out to the values of from that are set in shape, set tells
+// that shape is a value pointed to and set even when zero
+func restrict(out, from, shape reflect.Value, set bool) {
+	switch shape.Kind() {
+	case reflect.Ptr:
+		if shape.IsNil() || from.IsNil() {
+			return
+		}
+		out.Set(reflect.New(out.Type().Elem()))
+		restrict(out.Elem(), from.Elem(), shape.Elem(), true)
+	case reflect.Struct:
+		for i := 0; i < shape.NumField(); i++ {
+			restrict(out.Field(i), from.Field(i), shape.Field(i), false)
+		}
+	default:
+		if set || !shape.IsZero() {
+			out.Set(from)
+		}
+	}
+}
+
+// differences appends to out the paths of the values set in want that got
+// does not match
+func differences(want, got reflect.Value, path string, set bool, out []string) []string {
+	switch want.Kind() {
+	case reflect.Ptr:
+		if want.IsNil() {
+			return out
+		}
+		if got.IsNil() {
+			return append(out, path)
+		}
+		return differences(want.Elem(), got.Elem(), path, true, out)
+	case reflect.Struct:
+		for i := 0; i < want.NumField(); i++ {
+			name := want.Type().Field(i).Name
+			if path != "" {
+				name = path + "." + name
+			}
+			out = differences(want.Field(i), got.Field(i), name, false, out)
+		}
+		return out
+	case reflect.Slice:
+		if want.Len() == 0 {
+			return out
+		}
+		if got.Len() != want.Len() {
+			return append(out, path)
+		}
+		for i := 0; i < want.Len(); i++ {
+			out = differences(want.Index(i), got.Index(i), fmt.Sprintf("%s[%d]", path, i), false, out)
+		}
+		return out
+	}
+	if !set && want.IsZero() {
+		return out
+	}
+	switch want.Kind() {
+	case reflect.Float32, reflect.Float64:
+		// devices round the values they store
+		if math.Abs(want.Float()-got.Float()) > 1e-3*math.Max(1, math.Abs(want.Float())) {
+			out = append(out, path)
+		}
+	default:
+		if !reflect.DeepEqual(want.Interface(), got.Interface()) {
+			out = append(out, path)
+		}
+	}
+	return out
+}
+
+// GeoLocation returns the geo location of the video source, or of the device
+// when the video source has none
+func (c *Client) GeoLocation(ctx context.Context) (onvif.GeoLocation, error) {
+	var response device.GetGeoLocationResponse
+	if err := c.caller.CallMethodUnmarshal(ctx, device.GetGeoLocation{}, &response); err != nil {
+		return onvif.GeoLocation{}, err
+	}
+	var location *onvif.GeoLocation
+	for _, entity := range response.Location {
+		if entity.GeoLocation == nil {
+			continue
+		}
+		if entity.Entity == "VideoSource" && entity.Token == c.VideoSource {
+			return *entity.GeoLocation, nil
+		}
+		if location == nil {
+			location = entity.GeoLocation
+		}
+	}
+	if location == nil {
+		return onvif.GeoLocation{}, ErrNoGeoLocation
+	}
+	return *location, nil
+}
+
+// SceneSwitcher applies the Day scene from sunrise to sunset and the Night
+// scene otherwise
+type SceneSwitcher struct {
+	Client     *Client
+	Day, Night Scene
+	// Location is the place of the camera, read with GeoLocation when nil
+	Location *onvif.GeoLocation
+	// Offset extends the day before sunrise and after sunset, to switch at
+	// dusk rather than at sunset
+	Offset time.Duration
+	// Interval is the delay between two checks, a minute when 0
+	Interval time.Duration
+	// Now is the clock, time.Now when nil
+	Now func() time.Time
+	// OnSwitch is called after a scene is applied or refused
+	OnSwitch func(scene Scene, err error)
+}
+
+// Run applies the scene of the time of day until ctx is done. A refused scene
+// is applied again at the next check
+func (s *SceneSwitcher) Run(ctx context.Context) error {
+	location := s.Location
+	if location == nil {
+		found, err := s.Client.GeoLocation(ctx)
+		if err != nil {
+			return err
+		}
+		location = &found
+	}
+	interval := s.Interval
+	if interval == 0 {
+		interval = time.Minute
+	}
+	now := s.Now
+	if now == nil {
+		now = time.Now
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	applied, day := false, false
+	for {
+		if isDay := daylight(*location, now(), s.Offset); !applied || isDay != day {
+			scene := s.Night
+			if isDay {
+				scene = s.Day
+			}
+			err := s.Client.ApplyScene(ctx, scene)
+			applied, day = err == nil, isDay
+			if s.OnSwitch != nil {
+				s.OnSwitch(scene, err)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
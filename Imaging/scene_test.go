@@ -0,0 +1,139 @@
+package imaging
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+func TestScenes(t *testing.T) {
+	ctx := context.Background()
+	level := 50.0
+	camera := &imagingCamera{
+		options: onvif.ImagingOptions20{
+			IrCutFilterModes: []onvif.IrCutFilterMode{"ON", "OFF", "AUTO"},
+			Exposure:         &onvif.ExposureOptions20{Mode: []onvif.ExposureMode{"AUTO", "MANUAL"}},
+			WideDynamicRange: &onvif.WideDynamicRangeOptions20{Mode: []onvif.WideDynamicMode{"ON", "OFF"}, Level: &onvif.FloatRange{Max: 100}},
+		},
+		settings: onvif.ImagingSettings20{
+			IrCutFilter:      "ON",
+			Exposure:         &onvif.Exposure20{Mode: "AUTO"},
+			WideDynamicRange: &onvif.WideDynamicRange20{Mode: "OFF"},
+		},
+		locations: []onvif.LocationEntity{
+			{Entity: "Device", GeoLocation: &onvif.GeoLocation{Lat: 1, Lon: 1}},
+			{Entity: "VideoSource", Token: "vs0", GeoLocation: &onvif.GeoLocation{Lat: 48.8566, Lon: 2.3522}},
+		},
+	}
+	c := NewClient(camera, "vs0")
+
+	day, err := c.CaptureScene(ctx, "day", "IrCutFilter", "WideDynamicRange")
+	if err != nil || day.Settings.IrCutFilter != "ON" || day.Settings.WideDynamicRange.Mode != "OFF" || day.Settings.Exposure != nil {
+		t.Fatalf("CaptureScene = %+v, %v", day, err)
+	}
+	night := Scene{Name: "night", Settings: onvif.ImagingSettings20{
+		IrCutFilter:      "OFF",
+		WideDynamicRange: &onvif.WideDynamicRange20{Mode: "ON", Level: &level},
+	}}
+	if err := c.ApplyScene(ctx, night); err != nil || camera.settings.IrCutFilter != "OFF" || *camera.settings.WideDynamicRange.Level != 50 {
+		t.Fatalf("ApplyScene night: %v", err)
+	}
+
+	// the WDR is ignored: the IR cut filter is restored
+	camera.ignoreWDR = true
+	var sceneErr *SceneError
+	err = c.ApplyScene(ctx, day)
+	if !errors.As(err, &sceneErr) || !reflect.DeepEqual(sceneErr.Rejected, []string{"WideDynamicRange.Mode"}) || sceneErr.Rollback != nil {
+		t.Errorf("ignored WDR: %v", err)
+	}
+	if camera.settings.IrCutFilter != "OFF" {
+		t.Errorf("IR cut filter not rolled back: %s", camera.settings.IrCutFilter)
+	}
+	camera.ignoreWDR, camera.refuse = false, true
+	if err := c.ApplyScene(ctx, day); !errors.As(err, &sceneErr) || sceneErr.Err == nil || camera.sets != 5 {
+		t.Errorf("refused scene: %v after %d sets", err, camera.sets)
+	}
+	// the caller gives up as the scene is refused, the rollback is still sent
+	cancelCtx, cancelScene := context.WithCancel(ctx)
+	camera.cancel = cancelScene
+	if err := c.ApplyScene(cancelCtx, day); !errors.As(err, &sceneErr) || errors.Is(sceneErr.Rollback, context.Canceled) || camera.sets != 7 {
+		t.Errorf("cancelled scene: %v after %d sets", err, camera.sets)
+	}
+	camera.refuse, camera.cancel = false, nil
+
+	var optionErr *OptionError
+	if err := c.ApplyScene(ctx, Scene{Name: "dusk", Settings: onvif.ImagingSettings20{IrCutFilter: "NIGHT"}}); !errors.As(err, &optionErr) {
+		t.Errorf("invalid scene: %v", err)
+	}
+
+	// the switcher reads the location of vs0, Paris
+	var mu sync.Mutex
+	now := time.Date(2024, 6, 21, 12, 0, 0, 0, time.UTC)
+	switched := make(chan string, 4)
+	switcher := &SceneSwitcher{
+		Client:   c,
+		Day:      day,
+		Night:    night,
+		Interval: time.Millisecond,
+		Now: func() time.Time {
+			mu.Lock()
+			defer mu.Unlock()
+			return now
+		},
+		OnSwitch: func(scene Scene, err error) { switched <- scene.Name },
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan error)
+	go func() { done <- switcher.Run(ctx) }()
+	if name := <-switched; name != "day" || camera.irCutFilter() != "ON" {
+		t.Errorf("switched to %s at noon", name)
+	}
+	mu.Lock()
+	now = time.Date(2024, 6, 21, 22, 0, 0, 0, time.UTC)
+	mu.Unlock()
+	if name := <-switched; name != "night" || camera.irCutFilter() != "OFF" {
+		t.Errorf("switched to %s at night", name)
+	}
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Run = %v", err)
+	}
+}
+
+func TestSunTimes(t *testing.T) {
+	paris := onvif.GeoLocation{Lat: 48.8566, Lon: 2.3522}
+	sunrise, sunset, err := SunTimes(paris, time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, check := range []struct {
+		got, want time.Time
+	}{
+		{sunrise, time.Date(2024, 6, 21, 3, 47, 0, 0, time.UTC)},
+		{sunset, time.Date(2024, 6, 21, 19, 58, 0, 0, time.UTC)},
+	} {
+		if math.Abs(check.got.Sub(check.want).Minutes()) > 3 {
+			t.Errorf("got %s, want %s", check.got, check.want)
+		}
+	}
+
+	svalbard := onvif.GeoLocation{Lat: 78.22, Lon: 15.65}
+	if _, _, err := SunTimes(svalbard, time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC)); err != ErrPolarDay {
+		t.Errorf("svalbard in june: %v", err)
+	}
+	if _, _, err := SunTimes(svalbard, time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC)); err != ErrPolarNight {
+		t.Errorf("svalbard in december: %v", err)
+	}
+
+	// 9:00 and 20:00 in Sydney, the day before in UTC
+	sydney := onvif.GeoLocation{Lat: -33.87, Lon: 151.21}
+	if !Daylight(sydney, time.Date(2024, 6, 20, 23, 0, 0, 0, time.UTC)) || Daylight(sydney, time.Date(2024, 6, 21, 10, 0, 0, 0, time.UTC)) {
+		t.Error("wrong daylight in Sydney")
+	}
+}
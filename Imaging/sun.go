@@ -0,0 +1,81 @@
+package imaging
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// ErrPolarDay and ErrPolarNight are returned by SunTimes when the sun does
+// not set or does not rise that day
+var (
+	ErrPolarDay   = errors.New("the sun does not set")
+	ErrPolarNight = errors.New("the sun does not rise")
+)
+
+// julianUnixEpoch is the julian date of the unix epoch
+const julianUnixEpoch = 2440587.5
+
+// SunTimes returns the sunrise and sunset at location on the day of date,
+// within a minute or so. The elevation lowers the horizon
+func SunTimes(location onvif.GeoLocation, date time.Time) (time.Time, time.Time, error) {
+	rad := math.Pi / 180
+	noon := time.Date(date.Year(), date.Month(), date.Day(), 12, 0, 0, 0, date.Location())
+	julian := float64(noon.Unix())/86400 + julianUnixEpoch
+
+	// mean solar noon, solar anomaly, equation of center and ecliptic longitude
+	n := math.Round(julian - 2451545.0 + 0.0008)
+	mean := n - float64(location.Lon)/360
+	anomaly := math.Mod(357.5291+0.98560028*mean, 360)
+	m := anomaly * rad
+	center := 1.9148*math.Sin(m) + 0.0200*math.Sin(2*m) + 0.0003*math.Sin(3*m)
+	longitude := math.Mod(anomaly+center+180+102.9372, 360) * rad
+	transit := 2451545.0 + mean + 0.0053*math.Sin(m) - 0.0069*math.Sin(2*longitude)
+
+	declination := math.Asin(math.Sin(longitude) * math.Sin(23.4397*rad))
+	latitude := float64(location.Lat) * rad
+	horizon := -0.833
+	if location.Elevation > 0 {
+		horizon -= 2.076 * math.Sqrt(float64(location.Elevation)) / 60
+	}
+	cosHourAngle := (math.Sin(horizon*rad) - math.Sin(latitude)*math.Sin(declination)) / (math.Cos(latitude) * math.Cos(declination))
+	switch {
+	case cosHourAngle < -1:
+		return time.Time{}, time.Time{}, ErrPolarDay
+	case cosHourAngle > 1:
+		return time.Time{}, time.Time{}, ErrPolarNight
+	}
+	hourAngle := math.Acos(cosHourAngle) / rad
+	return julianTime(transit - hourAngle/360).In(date.Location()), julianTime(transit + hourAngle/360).In(date.Location()), nil
+}
+
+func julianTime(julian float64) time.Time {
+	return time.Unix(0, int64((julian-julianUnixEpoch)*86400*float64(time.Second)))
+}
+
+// Daylight reports whether t is between sunrise and sunset at location
+func Daylight(location onvif.GeoLocation, t time.Time) bool {
+	return daylight(location, t, 0)
+}
+
+// daylight reports whether t is between sunrise and sunset at location, the
+// day extended by offset at both ends. The days around t are checked as
+// the day of t may not be the local day of location
+func daylight(location onvif.GeoLocation, t time.Time, offset time.Duration) bool {
+	for _, days := range []int{-1, 0, 1} {
+		sunrise, sunset, err := SunTimes(location, t.AddDate(0, 0, days))
+		switch err {
+		case ErrPolarDay:
+			if days == 0 {
+				return true
+			}
+		case nil:
+			if !t.Before(sunrise.Add(-offset)) && t.Before(sunset.Add(offset)) {
+				return true
+			}
+		}
+	}
+	return false
+}
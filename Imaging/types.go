@@ -5,20 +5,42 @@ import (
 	"github.com/sonnt85/gonvif/xsd/onvif"
 )
 
+type Capabilities struct {
+	ImageStabilization xsd.Boolean `xml:"ImageStabilization,attr"`
+	Presets            xsd.Boolean `xml:"Presets,attr"`
+}
+
+type ImagingPreset struct {
+	Token onvif.ReferenceToken `xml:"token,attr"`
+	Type  string               `xml:"type,attr"`
+	Name  onvif.Name
+}
+
 type GetServiceCapabilities struct {
 	XMLName string `xml:"timg:GetServiceCapabilities"`
 }
 
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
 type GetImagingSettings struct {
 	XMLName          string               `xml:"timg:GetImagingSettings"`
 	VideoSourceToken onvif.ReferenceToken `xml:"timg:VideoSourceToken"`
 }
 
+type GetImagingSettingsResponse struct {
+	ImagingSettings onvif.ImagingSettings20
+}
+
 type SetImagingSettings struct {
 	XMLName          string                  `xml:"timg:SetImagingSettings"`
 	VideoSourceToken onvif.ReferenceToken    `xml:"timg:VideoSourceToken"`
 	ImagingSettings  onvif.ImagingSettings20 `xml:"timg:ImagingSettings"`
-	ForcePersistence xsd.Boolean             `xml:"timg:ForcePersistence"`
+	ForcePersistence *xsd.Boolean            `xml:"timg:ForcePersistence,omitempty"`
+}
+
+type SetImagingSettingsResponse struct {
 }
 
 type GetOptions struct {
@@ -26,39 +48,68 @@ type GetOptions struct {
 	VideoSourceToken onvif.ReferenceToken `xml:"timg:VideoSourceToken"`
 }
 
+type GetOptionsResponse struct {
+	ImagingOptions onvif.ImagingOptions20
+}
+
 type Move struct {
 	XMLName          string               `xml:"timg:Move"`
 	VideoSourceToken onvif.ReferenceToken `xml:"timg:VideoSourceToken"`
 	Focus            onvif.FocusMove      `xml:"timg:Focus"`
 }
 
+type MoveResponse struct {
+}
+
 type GetMoveOptions struct {
 	XMLName          string               `xml:"timg:GetMoveOptions"`
 	VideoSourceToken onvif.ReferenceToken `xml:"timg:VideoSourceToken"`
 }
 
+type GetMoveOptionsResponse struct {
+	MoveOptions onvif.MoveOptions20
+}
+
 type Stop struct {
 	XMLName          string               `xml:"timg:Stop"`
 	VideoSourceToken onvif.ReferenceToken `xml:"timg:VideoSourceToken"`
 }
 
+type StopResponse struct {
+}
+
 type GetStatus struct {
 	XMLName          string               `xml:"timg:GetStatus"`
 	VideoSourceToken onvif.ReferenceToken `xml:"timg:VideoSourceToken"`
 }
 
+type GetStatusResponse struct {
+	Status onvif.ImagingStatus20
+}
+
 type GetPresets struct {
 	XMLName          string               `xml:"timg:GetPresets"`
 	VideoSourceToken onvif.ReferenceToken `xml:"timg:VideoSourceToken"`
 }
 
+type GetPresetsResponse struct {
+	Preset []ImagingPreset
+}
+
 type GetCurrentPreset struct {
 	XMLName          string               `xml:"timg:GetCurrentPreset"`
 	VideoSourceToken onvif.ReferenceToken `xml:"timg:VideoSourceToken"`
 }
 
+type GetCurrentPresetResponse struct {
+	Preset *ImagingPreset
+}
+
 type SetCurrentPreset struct {
 	XMLName          string               `xml:"timg:SetCurrentPreset"`
 	VideoSourceToken onvif.ReferenceToken `xml:"timg:VideoSourceToken"`
 	PresetToken      onvif.ReferenceToken `xml:"timg:PresetToken"`
 }
+
+type SetCurrentPresetResponse struct {
+}
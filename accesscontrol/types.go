@@ -0,0 +1,186 @@
+// Code generated by gonvif-gen from accesscontrol.wsdl. DO NOT EDIT.
+
+package accesscontrol
+
+import (
+	"github.com/sonnt85/gonvif/gosoap"
+	"github.com/sonnt85/gonvif/xsd"
+)
+
+// Namespace of the accesscontrol service
+const Namespace = "http://www.onvif.org/ver10/accesscontrol/wsdl"
+
+// Prefix of the accesscontrol service elements
+const Prefix = "tac"
+
+// ServiceCapabilities the service capabilities reflect optional functionality of a service
+type ServiceCapabilities struct {
+	MaxLimit xsd.UnsignedInt `xml:"MaxLimit,attr"`
+}
+
+// AccessPointInfoBase used as extension base for AccessPointInfo
+type AccessPointInfoBase struct {
+	xsd.AnyType
+	Name        xsd.AnyType `xml:"tac:Name"`
+	Description xsd.AnyType `xml:"tac:Description,omitempty"`
+	AreaFrom    xsd.AnyType `xml:"tac:AreaFrom,omitempty"`
+	AreaTo      xsd.AnyType `xml:"tac:AreaTo,omitempty"`
+	EntityType  xsd.QName   `xml:"tac:EntityType,omitempty"`
+	Entity      xsd.AnyType `xml:"tac:Entity"`
+}
+
+// AccessPointInfo the AccessPointInfo structure contains basic information about an AccessPoint instance
+type AccessPointInfo struct {
+	AccessPointInfoBase
+	Capabilities AccessPointCapabilities `xml:"tac:Capabilities"`
+}
+
+// AccessPointCapabilities the AccessPoint capabilities reflect optional functionality of a particular physical entity
+type AccessPointCapabilities struct {
+	DisableAccessPoint    xsd.Boolean `xml:"DisableAccessPoint,attr"`
+	Duress                xsd.Boolean `xml:"Duress,attr,omitempty"`
+	AnonymousAccess       xsd.Boolean `xml:"AnonymousAccess,attr,omitempty"`
+	AccessTaken           xsd.Boolean `xml:"AccessTaken,attr,omitempty"`
+	ExternalAuthorization xsd.Boolean `xml:"ExternalAuthorization,attr,omitempty"`
+}
+
+// AreaInfoBase basic information about an Area
+type AreaInfoBase struct {
+	xsd.AnyType
+	Name        xsd.AnyType `xml:"tac:Name"`
+	Description xsd.AnyType `xml:"tac:Description,omitempty"`
+}
+
+// AreaInfo the AreaInfo structure contains basic information about an Area
+type AreaInfo struct {
+	AreaInfoBase
+}
+
+// AccessPointState the AccessPointState contains state information for an AccessPoint
+type AccessPointState struct {
+	Enabled xsd.Boolean `xml:"tac:Enabled"`
+}
+
+// Decision the Decision enumeration represents a choice of two available options for an access request:
+type Decision xsd.String
+
+const (
+	DecisionGranted Decision = "Granted"
+	DecisionDenied  Decision = "Denied"
+)
+
+// DenyReason non-normative enum that describes the various reasons for denying access
+type DenyReason xsd.String
+
+const (
+	DenyReasonCredentialNotEnabled   DenyReason = "CredentialNotEnabled"
+	DenyReasonCredentialNotActive    DenyReason = "CredentialNotActive"
+	DenyReasonCredentialExpired      DenyReason = "CredentialExpired"
+	DenyReasonInvalidPIN             DenyReason = "InvalidPIN"
+	DenyReasonNotPermittedAtThisTime DenyReason = "NotPermittedAtThisTime"
+	DenyReasonUnauthorized           DenyReason = "Unauthorized"
+	DenyReasonOther                  DenyReason = "Other"
+)
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tac:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities ServiceCapabilities
+}
+
+type GetAccessPointInfoList struct {
+	XMLName        string     `xml:"tac:GetAccessPointInfoList"`
+	Limit          xsd.Int    `xml:"tac:Limit,omitempty"`
+	StartReference xsd.String `xml:"tac:StartReference,omitempty"`
+}
+
+type GetAccessPointInfoListResponse struct {
+	NextStartReference xsd.String
+	AccessPointInfo    []AccessPointInfo
+}
+
+type GetAccessPointInfo struct {
+	XMLName string        `xml:"tac:GetAccessPointInfo"`
+	Token   []xsd.AnyType `xml:"tac:Token"`
+}
+
+type GetAccessPointInfoResponse struct {
+	AccessPointInfo []AccessPointInfo
+}
+
+type GetAreaInfoList struct {
+	XMLName        string     `xml:"tac:GetAreaInfoList"`
+	Limit          xsd.Int    `xml:"tac:Limit,omitempty"`
+	StartReference xsd.String `xml:"tac:StartReference,omitempty"`
+}
+
+type GetAreaInfoListResponse struct {
+	NextStartReference xsd.String
+	AreaInfo           []AreaInfo
+}
+
+type GetAreaInfo struct {
+	XMLName string        `xml:"tac:GetAreaInfo"`
+	Token   []xsd.AnyType `xml:"tac:Token"`
+}
+
+type GetAreaInfoResponse struct {
+	AreaInfo []AreaInfo
+}
+
+type GetAccessPointState struct {
+	XMLName string      `xml:"tac:GetAccessPointState"`
+	Token   xsd.AnyType `xml:"tac:Token"`
+}
+
+type GetAccessPointStateResponse struct {
+	AccessPointState AccessPointState
+}
+
+type EnableAccessPoint struct {
+	XMLName string      `xml:"tac:EnableAccessPoint"`
+	Token   xsd.AnyType `xml:"tac:Token"`
+}
+
+type EnableAccessPointResponse struct {
+}
+
+type DisableAccessPoint struct {
+	XMLName string      `xml:"tac:DisableAccessPoint"`
+	Token   xsd.AnyType `xml:"tac:Token"`
+}
+
+type DisableAccessPointResponse struct {
+}
+
+type ExternalAuthorization struct {
+	XMLName          string      `xml:"tac:ExternalAuthorization"`
+	AccessPointToken xsd.AnyType `xml:"tac:AccessPointToken"`
+	CredentialToken  xsd.AnyType `xml:"tac:CredentialToken,omitempty"`
+	Reason           xsd.String  `xml:"tac:Reason,omitempty"`
+	Decision         Decision    `xml:"tac:Decision"`
+}
+
+type ExternalAuthorizationResponse struct {
+}
+
+// Actions maps the request elements of the service to their WS-Addressing action
+var Actions = map[string]string{
+	"tac:GetServiceCapabilities": "http://www.onvif.org/ver10/accesscontrol/wsdl/GetServiceCapabilities",
+	"tac:GetAccessPointInfoList": "http://www.onvif.org/ver10/accesscontrol/wsdl/GetAccessPointInfoList",
+	"tac:GetAccessPointInfo":     "http://www.onvif.org/ver10/accesscontrol/wsdl/GetAccessPointInfo",
+	"tac:GetAreaInfoList":        "http://www.onvif.org/ver10/accesscontrol/wsdl/GetAreaInfoList",
+	"tac:GetAreaInfo":            "http://www.onvif.org/ver10/accesscontrol/wsdl/GetAreaInfo",
+	"tac:GetAccessPointState":    "http://www.onvif.org/ver10/accesscontrol/wsdl/GetAccessPointState",
+	"tac:EnableAccessPoint":      "http://www.onvif.org/ver10/accesscontrol/wsdl/EnableAccessPoint",
+	"tac:DisableAccessPoint":     "http://www.onvif.org/ver10/accesscontrol/wsdl/DisableAccessPoint",
+	"tac:ExternalAuthorization":  "http://www.onvif.org/ver10/accesscontrol/wsdl/ExternalAuthorization",
+}
+
+func init() {
+	for tag, action := range Actions {
+		gosoap.RegisterAction(tag, action)
+	}
+}
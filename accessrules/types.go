@@ -0,0 +1,141 @@
+// Code generated by gonvif-gen from accessrules.wsdl. DO NOT EDIT.
+
+package accessrules
+
+import (
+	"github.com/sonnt85/gonvif/gosoap"
+	"github.com/sonnt85/gonvif/xsd"
+)
+
+// Namespace of the accessrules service
+const Namespace = "http://www.onvif.org/ver10/accessrules/wsdl"
+
+// Prefix of the accessrules service elements
+const Prefix = "tar"
+
+// ServiceCapabilities the service capabilities reflect optional functionality of a service
+type ServiceCapabilities struct {
+	MaxLimit                                 xsd.String  `xml:"MaxLimit,attr"`
+	MaxAccessProfiles                        xsd.String  `xml:"MaxAccessProfiles,attr"`
+	MaxAccessPoliciesPerAccessProfile        xsd.String  `xml:"MaxAccessPoliciesPerAccessProfile,attr"`
+	MultipleSchedulesPerAccessPointSupported xsd.Boolean `xml:"MultipleSchedulesPerAccessPointSupported,attr"`
+}
+
+// AccessPolicy the access policy is an association of an access point and a schedule
+type AccessPolicy struct {
+	ScheduleToken xsd.AnyType            `xml:"tar:ScheduleToken"`
+	Entity        xsd.AnyType            `xml:"tar:Entity"`
+	EntityType    xsd.QName              `xml:"tar:EntityType,omitempty"`
+	Extension     *AccessPolicyExtension `xml:"tar:Extension,omitempty"`
+}
+
+type AccessPolicyExtension struct {
+}
+
+// AccessProfileInfo the AccessProfileInfo structure contains basic information about an access profile
+type AccessProfileInfo struct {
+	xsd.AnyType
+	Name        xsd.AnyType `xml:"tar:Name"`
+	Description xsd.AnyType `xml:"tar:Description,omitempty"`
+}
+
+// AccessProfile the access profile structure contains information about the collection of access policies
+type AccessProfile struct {
+	AccessProfileInfo
+	AccessPolicy []AccessPolicy          `xml:"tar:AccessPolicy"`
+	Extension    *AccessProfileExtension `xml:"tar:Extension,omitempty"`
+}
+
+type AccessProfileExtension struct {
+}
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tar:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities ServiceCapabilities
+}
+
+type GetAccessProfileInfo struct {
+	XMLName string        `xml:"tar:GetAccessProfileInfo"`
+	Token   []xsd.AnyType `xml:"tar:Token"`
+}
+
+type GetAccessProfileInfoResponse struct {
+	AccessProfileInfo []AccessProfileInfo
+}
+
+type GetAccessProfileInfoList struct {
+	XMLName        string     `xml:"tar:GetAccessProfileInfoList"`
+	Limit          xsd.Int    `xml:"tar:Limit,omitempty"`
+	StartReference xsd.String `xml:"tar:StartReference,omitempty"`
+}
+
+type GetAccessProfileInfoListResponse struct {
+	NextStartReference xsd.String
+	AccessProfileInfo  []AccessProfileInfo
+}
+
+type GetAccessProfiles struct {
+	XMLName string        `xml:"tar:GetAccessProfiles"`
+	Token   []xsd.AnyType `xml:"tar:Token"`
+}
+
+type GetAccessProfilesResponse struct {
+	AccessProfile []AccessProfile
+}
+
+type GetAccessProfileList struct {
+	XMLName        string     `xml:"tar:GetAccessProfileList"`
+	Limit          xsd.Int    `xml:"tar:Limit,omitempty"`
+	StartReference xsd.String `xml:"tar:StartReference,omitempty"`
+}
+
+type GetAccessProfileListResponse struct {
+	NextStartReference xsd.String
+	AccessProfile      []AccessProfile
+}
+
+type CreateAccessProfile struct {
+	XMLName       string        `xml:"tar:CreateAccessProfile"`
+	AccessProfile AccessProfile `xml:"tar:AccessProfile"`
+}
+
+type CreateAccessProfileResponse struct {
+	Token xsd.AnyType
+}
+
+type ModifyAccessProfile struct {
+	XMLName       string        `xml:"tar:ModifyAccessProfile"`
+	AccessProfile AccessProfile `xml:"tar:AccessProfile"`
+}
+
+type ModifyAccessProfileResponse struct {
+}
+
+type DeleteAccessProfile struct {
+	XMLName string      `xml:"tar:DeleteAccessProfile"`
+	Token   xsd.AnyType `xml:"tar:Token"`
+}
+
+type DeleteAccessProfileResponse struct {
+}
+
+// Actions maps the request elements of the service to their WS-Addressing action
+var Actions = map[string]string{
+	"tar:GetServiceCapabilities":   "http://www.onvif.org/ver10/accessrules/wsdl/GetServiceCapabilities",
+	"tar:GetAccessProfileInfo":     "http://www.onvif.org/ver10/accessrules/wsdl/GetAccessProfileInfo",
+	"tar:GetAccessProfileInfoList": "http://www.onvif.org/ver10/accessrules/wsdl/GetAccessProfileInfoList",
+	"tar:GetAccessProfiles":        "http://www.onvif.org/ver10/accessrules/wsdl/GetAccessProfiles",
+	"tar:GetAccessProfileList":     "http://www.onvif.org/ver10/accessrules/wsdl/GetAccessProfileList",
+	"tar:CreateAccessProfile":      "http://www.onvif.org/ver10/accessrules/wsdl/CreateAccessProfile",
+	"tar:ModifyAccessProfile":      "http://www.onvif.org/ver10/accessrules/wsdl/ModifyAccessProfile",
+	"tar:DeleteAccessProfile":      "http://www.onvif.org/ver10/accessrules/wsdl/DeleteAccessProfile",
+}
+
+func init() {
+	for tag, action := range Actions {
+		gosoap.RegisterAction(tag, action)
+	}
+}
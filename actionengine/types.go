@@ -0,0 +1,417 @@
+// Code generated by gonvif-gen from actionengine.wsdl. DO NOT EDIT.
+
+package actionengine
+
+import (
+	"github.com/sonnt85/gonvif/gosoap"
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Namespace of the actionengine service
+const Namespace = "http://www.onvif.org/ver10/actionengine/wsdl"
+
+// Prefix of the actionengine service elements
+const Prefix = "tae"
+
+type GetSupportedActions struct {
+	XMLName string `xml:"tae:GetSupportedActions"`
+}
+
+type GetSupportedActionsResponse struct {
+	SupportedActions SupportedActions
+}
+
+type GetActions struct {
+	XMLName string `xml:"tae:GetActions"`
+}
+
+type GetActionsResponse struct {
+	Action []Action
+}
+
+type CreateActions struct {
+	XMLName string                `xml:"tae:CreateActions"`
+	Action  []ActionConfiguration `xml:"tae:Action"`
+}
+
+type CreateActionsResponse struct {
+	Action []Action
+}
+
+type DeleteActions struct {
+	XMLName string                 `xml:"tae:DeleteActions"`
+	Token   []onvif.ReferenceToken `xml:"tae:Token"`
+}
+
+type DeleteActionsResponse struct {
+}
+
+type ModifyActions struct {
+	XMLName string   `xml:"tae:ModifyActions"`
+	Action  []Action `xml:"tae:Action"`
+}
+
+type ModifyActionsResponse struct {
+}
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tae:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities ActionEngineCapabilities
+}
+
+type GetActionTriggers struct {
+	XMLName string `xml:"tae:GetActionTriggers"`
+}
+
+type GetActionTriggersResponse struct {
+	ActionTrigger []ActionTrigger
+}
+
+type CreateActionTriggers struct {
+	XMLName       string                       `xml:"tae:CreateActionTriggers"`
+	ActionTrigger []ActionTriggerConfiguration `xml:"tae:ActionTrigger"`
+}
+
+type CreateActionTriggersResponse struct {
+	ActionTrigger []ActionTrigger
+}
+
+type ModifyActionTriggers struct {
+	XMLName       string          `xml:"tae:ModifyActionTriggers"`
+	ActionTrigger []ActionTrigger `xml:"tae:ActionTrigger"`
+}
+
+type ModifyActionTriggersResponse struct {
+}
+
+type DeleteActionTriggers struct {
+	XMLName string                 `xml:"tae:DeleteActionTriggers"`
+	Token   []onvif.ReferenceToken `xml:"tae:Token"`
+}
+
+type DeleteActionTriggersResponse struct {
+}
+
+// ActionConfigDescription describes the configuration parameters of an action
+type ActionConfigDescription struct {
+	ParameterDescription xsd.AnyType `xml:"tae:ParameterDescription"`
+	Name                 xsd.QName   `xml:"Name,attr"`
+}
+
+// SupportedActions supportedActions data structure lists the available action types that service provider supports
+type SupportedActions struct {
+	ActionContentSchemaLocation []xsd.AnyURI               `xml:"tae:ActionContentSchemaLocation"`
+	ActionDescription           []ActionConfigDescription  `xml:"tae:ActionDescription"`
+	Extension                   *SupportedActionsExtension `xml:"tae:Extension,omitempty"`
+}
+
+type SupportedActionsExtension struct {
+}
+
+// ActionEngineCapabilities action Engine Capabilities data structure contains the maximum number of supported actions and number of actions in use for generic as well as specific action types
+type ActionEngineCapabilities struct {
+	ActionCapabilities []ActionTypeLimits                 `xml:"tae:ActionCapabilities"`
+	Extension          *ActionEngineCapabilitiesExtension `xml:"tae:Extension,omitempty"`
+	MaximumTriggers    xsd.PositiveInteger                `xml:"MaximumTriggers,attr,omitempty"`
+	MaximumActions     xsd.PositiveInteger                `xml:"MaximumActions,attr,omitempty"`
+}
+
+type ActionEngineCapabilitiesExtension struct {
+}
+
+// ActionTypeLimits actionTypeLimits data structure contains maximum and current usage information for a specific action type in the service provider
+type ActionTypeLimits struct {
+	Type    xsd.QName              `xml:"Type,attr"`
+	Maximum xsd.PositiveInteger    `xml:"Maximum,attr"`
+	InUse   xsd.NonNegativeInteger `xml:"InUse,attr,omitempty"`
+}
+
+// ActionConfiguration action Configuration data type contains the configuration settings of action configuration parameters, service requester given action Name, and service provider supported action type value
+type ActionConfiguration struct {
+	Parameters onvif.ItemList `xml:"tae:Parameters"`
+	Name       xsd.String     `xml:"Name,attr"`
+	Type       xsd.QName      `xml:"Type,attr"`
+}
+
+// Action action data type contains the configuration settings of one action instance and service provider assigned unique identifier for this action configuration
+type Action struct {
+	Configuration ActionConfiguration  `xml:"tae:Configuration"`
+	Token         onvif.ReferenceToken `xml:"Token,attr"`
+}
+
+// ActionTriggerConfiguration action Trigger configuration data type contains mandatory Topic Expression (Section Topic Filter in [Core Specification]), optional Message content expression (Section Message Content Filter in [Core Specification]), and set of actions to be triggered
+type ActionTriggerConfiguration struct {
+	TopicExpression   xsd.AnyType                          `xml:"tae:TopicExpression"`
+	ContentExpression xsd.AnyType                          `xml:"tae:ContentExpression,omitempty"`
+	ActionToken       []onvif.ReferenceToken               `xml:"tae:ActionToken"`
+	Extension         *ActionTriggerConfigurationExtension `xml:"tae:Extension,omitempty"`
+}
+
+type ActionTriggerConfigurationExtension struct {
+}
+
+// ActionTrigger action Trigger data type contains the service provider assigned unique identifier for the configuration and action trigger configuration data
+type ActionTrigger struct {
+	Configuration ActionTriggerConfiguration `xml:"tae:Configuration"`
+	Token         onvif.ReferenceToken       `xml:"Token,attr"`
+}
+
+type OnvifAction struct {
+	ActionDescription []ActionConfigDescription `xml:"tae:ActionDescription"`
+}
+
+type EMailServerConfiguration struct {
+	SMTPConfig           SMTPConfig           `xml:"tae:SMTPConfig"`
+	POPConfig            POPConfig            `xml:"tae:POPConfig"`
+	AuthenticationConfig AuthenticationConfig `xml:"tae:AuthenticationConfig"`
+}
+
+type SMTPConfig struct {
+	HostAddress HostAddress         `xml:"tae:HostAddress"`
+	PortNo      xsd.PositiveInteger `xml:"portNo,attr,omitempty"`
+}
+
+type POPConfig struct {
+	HostAddress HostAddress `xml:"tae:HostAddress"`
+}
+
+type HostAddress struct {
+	Value      xsd.String        `xml:"tae:Value"`
+	FormatType AddressFormatType `xml:"formatType,attr"`
+}
+
+type AddressFormatType xsd.String
+
+const (
+	AddressFormatTypeHostname AddressFormatType = "hostname"
+	AddressFormatTypeIpv4     AddressFormatType = "ipv4"
+	AddressFormatTypeIpv6     AddressFormatType = "ipv6"
+	AddressFormatTypeExtended AddressFormatType = "Extended"
+)
+
+type UserCredentials struct {
+	Username  xsd.String                `xml:"tae:username"`
+	Password  xsd.Base64Binary          `xml:"tae:password,omitempty"`
+	Extension *UserCredentialsExtension `xml:"tae:Extension,omitempty"`
+}
+
+type UserCredentialsExtension struct {
+}
+
+type AuthenticationConfig struct {
+	User UserCredentials         `xml:"tae:User"`
+	Mode EMailAuthenticationMode `xml:"mode,attr"`
+}
+
+type EMailAuthenticationMode xsd.String
+
+const (
+	EMailAuthenticationModeNone     EMailAuthenticationMode = "none"
+	EMailAuthenticationModeSMTP     EMailAuthenticationMode = "SMTP"
+	EMailAuthenticationModePOPSMTP  EMailAuthenticationMode = "POPSMTP"
+	EMailAuthenticationModeExtended EMailAuthenticationMode = "Extended"
+)
+
+type EMailReceiverConfiguration struct {
+	TO        []xsd.String                         `xml:"tae:TO"`
+	CC        []xsd.String                         `xml:"tae:CC"`
+	Extension *EMailReceiverConfigurationExtension `xml:"tae:Extension,omitempty"`
+}
+
+type EMailReceiverConfigurationExtension struct {
+}
+
+type EMailAttachmentConfiguration struct {
+	FileName  xsd.String                             `xml:"tae:FileName,omitempty"`
+	DoSuffix  FileSuffixType                         `xml:"tae:doSuffix,omitempty"`
+	Extension *EMailAttachmentConfigurationExtension `xml:"tae:Extension,omitempty"`
+}
+
+type EMailAttachmentConfigurationExtension struct {
+}
+
+type EMailBodyTextConfiguration struct {
+	IncludeEvent xsd.Boolean `xml:"includeEvent,attr,omitempty"`
+	Type         xsd.String  `xml:"type,attr,omitempty"`
+}
+
+type MediaSource struct {
+	ProfileToken onvif.ReferenceToken `xml:"tae:ProfileToken"`
+}
+
+type HttpHostConfigurations struct {
+	HttpDestination []HttpDestinationConfiguration   `xml:"tae:HttpDestination"`
+	Extension       *HttpHostConfigurationsExtension `xml:"tae:Extension,omitempty"`
+}
+
+type HttpHostConfigurationsExtension struct {
+}
+
+type HttpDestinationConfiguration struct {
+	HostAddress        HttpHostAddress                        `xml:"tae:HostAddress"`
+	HttpAuthentication *HttpAuthenticationConfiguration       `xml:"tae:HttpAuthentication,omitempty"`
+	Extension          *HttpDestinationConfigurationExtension `xml:"tae:Extension,omitempty"`
+	Uri                xsd.String                             `xml:"uri,attr,omitempty"`
+	Protocol           HttpProtocolType                       `xml:"protocol,attr,omitempty"`
+}
+
+type HttpProtocolType xsd.String
+
+const (
+	HttpProtocolTypeHttp     HttpProtocolType = "http"
+	HttpProtocolTypeHttps    HttpProtocolType = "https"
+	HttpProtocolTypeExtended HttpProtocolType = "Extended"
+)
+
+type HttpDestinationConfigurationExtension struct {
+}
+
+type HttpAuthenticationConfiguration struct {
+	User      *UserCredentials                          `xml:"tae:User,omitempty"`
+	Extension *HttpAuthenticationConfigurationExtension `xml:"tae:Extension,omitempty"`
+	Method    HttpAuthenticationMethodType              `xml:"method,attr,omitempty"`
+}
+
+type HttpAuthenticationConfigurationExtension struct {
+}
+
+type HttpAuthenticationMethodType xsd.String
+
+const (
+	HttpAuthenticationMethodTypeNone      HttpAuthenticationMethodType = "none"
+	HttpAuthenticationMethodTypeMD5Digest HttpAuthenticationMethodType = "MD5Digest"
+	HttpAuthenticationMethodTypeExtended  HttpAuthenticationMethodType = "Extended"
+)
+
+type HttpHostAddress struct {
+	Value      xsd.String        `xml:"tae:Value"`
+	FormatType AddressFormatType `xml:"formatType,attr"`
+	PortNo     xsd.Integer       `xml:"portNo,attr,omitempty"`
+}
+
+type PostContentConfiguration struct {
+	MediaReference *MediaSource          `xml:"tae:MediaReference,omitempty"`
+	PostBody       PostBodyConfiguration `xml:"tae:PostBody"`
+}
+
+type PostBodyConfiguration struct {
+	FormData     xsd.String  `xml:"formData,attr,omitempty"`
+	IncludeEvent xsd.Boolean `xml:"includeEvent,attr,omitempty"`
+	IncludeMedia xsd.Boolean `xml:"includeMedia,attr,omitempty"`
+}
+
+type FtpHostConfigurations struct {
+	FtpDestination []FtpDestinationConfiguration   `xml:"tae:FtpDestination"`
+	Extension      *FtpHostConfigurationsExtension `xml:"tae:Extension,omitempty"`
+}
+
+type FtpHostConfigurationsExtension struct {
+}
+
+type FtpDestinationConfiguration struct {
+	HostAddress       FtpHostAddress                        `xml:"tae:HostAddress"`
+	UploadPath        xsd.String                            `xml:"tae:UploadPath"`
+	FtpAuthentication FtpAuthenticationConfiguration        `xml:"tae:FtpAuthentication"`
+	Extension         *FtpDestinationConfigurationExtension `xml:"tae:Extension,omitempty"`
+}
+
+type FtpDestinationConfigurationExtension struct {
+}
+
+type FtpAuthenticationConfiguration struct {
+	User      *UserCredentials                         `xml:"tae:User,omitempty"`
+	Extension *FtpAuthenticationConfigurationExtension `xml:"tae:Extension,omitempty"`
+}
+
+type FtpAuthenticationConfigurationExtension struct {
+}
+
+type FtpHostAddress struct {
+	Value      xsd.String        `xml:"tae:Value"`
+	FormatType AddressFormatType `xml:"formatType,attr"`
+	PortNo     xsd.Integer       `xml:"portNo,attr,omitempty"`
+}
+
+type FtpContent struct {
+	FtpContentConfig FtpContentConfiguration `xml:"tae:FtpContentConfig"`
+}
+
+type FtpFileNameConfigurations struct {
+	FileName xsd.String     `xml:"file_name,attr,omitempty"`
+	Suffix   FileSuffixType `xml:"suffix,attr,omitempty"`
+}
+
+type FileSuffixType xsd.String
+
+const (
+	FileSuffixTypeNone     FileSuffixType = "none"
+	FileSuffixTypeSequence FileSuffixType = "sequence"
+	FileSuffixTypeDateTime FileSuffixType = "dateTime"
+	FileSuffixTypeExtended FileSuffixType = "Extended"
+)
+
+type FtpContentConfiguration struct {
+	UploadImages *FtpContentConfigurationUploadImages `xml:"tae:UploadImages,omitempty"`
+	UploadFile   *FtpContentConfigurationUploadFile   `xml:"tae:UploadFile,omitempty"`
+	Type         xsd.String                           `xml:"Type,attr"`
+}
+
+type FtpContentConfigurationUploadImages struct {
+	HowLong        xsd.Duration              `xml:"tae:HowLong"`
+	SampleInterval xsd.Duration              `xml:"tae:SampleInterval"`
+	FileName       FtpFileNameConfigurations `xml:"tae:FileName"`
+}
+
+type FtpContentConfigurationUploadFile struct {
+	SourceFileName      xsd.String `xml:"tae:sourceFileName"`
+	DestinationFileName xsd.String `xml:"tae:destinationFileName"`
+}
+
+type SMSProviderConfiguration struct {
+	ProviderURL xsd.AnyURI      `xml:"tae:ProviderURL"`
+	User        UserCredentials `xml:"tae:User"`
+}
+
+type SMSSenderConfiguration struct {
+	EMail xsd.String `xml:"tae:EMail"`
+}
+
+type SMSMessage struct {
+	Text xsd.String `xml:"tae:Text"`
+}
+
+type TriggeredRecordingConfiguration struct {
+	PreRecordDuration  xsd.Duration        `xml:"tae:PreRecordDuration"`
+	PostRecordDuration xsd.Duration        `xml:"tae:PostRecordDuration"`
+	RecordDuration     xsd.Duration        `xml:"tae:RecordDuration"`
+	RecordFrameRate    xsd.PositiveInteger `xml:"tae:RecordFrameRate,omitempty"`
+	DoRecordAudio      xsd.Boolean         `xml:"tae:DoRecordAudio"`
+}
+
+type RecordingActionConfiguration struct {
+	RecordConfig TriggeredRecordingConfiguration `xml:"tae:RecordConfig"`
+}
+
+// Actions maps the request elements of the service to their WS-Addressing action
+var Actions = map[string]string{
+	"tae:GetSupportedActions":    "http://www.onvif.org/ver10/actionengine/wsdl/GetSupportedActions",
+	"tae:GetActions":             "http://www.onvif.org/ver10/actionengine/wsdl/GetActions",
+	"tae:CreateActions":          "http://www.onvif.org/ver10/actionengine/wsdl/CreateActions",
+	"tae:DeleteActions":          "http://www.onvif.org/ver10/actionengine/wsdl/DeleteActions",
+	"tae:ModifyActions":          "http://www.onvif.org/ver10/actionengine/wsdl/ModifyActions",
+	"tae:GetServiceCapabilities": "http://www.onvif.org/ver10/actionengine/wsdl/GetServiceCapabilities",
+	"tae:GetActionTriggers":      "http://www.onvif.org/ver10/actionengine/wsdl/GetActionTriggers",
+	"tae:CreateActionTriggers":   "http://www.onvif.org/ver10/actionengine/wsdl/CreateActionTriggers",
+	"tae:DeleteActionTriggers":   "http://www.onvif.org/ver10/actionengine/wsdl/DeleteActionTriggers",
+	"tae:ModifyActionTriggers":   "http://www.onvif.org/ver10/actionengine/wsdl/ModifyActionTriggers",
+}
+
+func init() {
+	for tag, action := range Actions {
+		gosoap.RegisterAction(tag, action)
+	}
+}
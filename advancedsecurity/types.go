@@ -0,0 +1,770 @@
+// Code generated by gonvif-gen from advancedsecurity.wsdl. DO NOT EDIT.
+
+package advancedsecurity
+
+import (
+	"github.com/sonnt85/gonvif/gosoap"
+	"github.com/sonnt85/gonvif/xsd"
+)
+
+// Namespace of the advancedsecurity service
+const Namespace = "http://www.onvif.org/ver10/advancedsecurity/wsdl"
+
+// Prefix of the advancedsecurity service elements
+const Prefix = "tas"
+
+// KeyID unique identifier for keys in the keystore
+type KeyID xsd.NCName
+
+// CertificateID unique identifier for certificates in the keystore
+type CertificateID xsd.NCName
+
+// CertificationPathID unique identifier for certification paths in the keystore
+type CertificationPathID xsd.NCName
+
+// PassphraseID unique identifier for passphrases in the keystore
+type PassphraseID xsd.NCName
+
+// Dot1XID unique identifier for 802.1X configurations in the keystore
+type Dot1XID xsd.NCName
+
+// KeyStatus the status of a key in the keystore
+type KeyStatus xsd.String
+
+const (
+	KeyStatusOk         KeyStatus = "ok"
+	KeyStatusGenerating KeyStatus = "generating"
+	KeyStatusCorrupt    KeyStatus = "corrupt"
+)
+
+// DotDecimalOID an object identifier (OID) in dot-decimal form as specified in RFC4512
+type DotDecimalOID xsd.String
+
+// DNAttributeType the distinguished name attribute type encoded as specified in RFC 4514
+type DNAttributeType xsd.String
+
+type DNAttributeValue xsd.String
+
+// KeyAttribute the attributes of a key in the keystore
+type KeyAttribute struct {
+	KeyID               KeyID                  `xml:"tas:KeyID"`
+	Alias               xsd.String             `xml:"tas:Alias,omitempty"`
+	HasPrivateKey       *xsd.Boolean           `xml:"tas:hasPrivateKey,omitempty"`
+	KeyStatus           xsd.String             `xml:"tas:KeyStatus"`
+	ExternallyGenerated *xsd.Boolean           `xml:"tas:externallyGenerated,omitempty"`
+	SecurelyStored      *xsd.Boolean           `xml:"tas:securelyStored,omitempty"`
+	Extension           *KeyAttributeExtension `xml:"tas:Extension,omitempty"`
+}
+
+// DNAttributeTypeAndValue a distinguished name attribute type and value pair
+type DNAttributeTypeAndValue struct {
+	Type  DNAttributeType  `xml:"tas:Type"`
+	Value DNAttributeValue `xml:"tas:Value"`
+}
+
+// MultiValuedRDN a multi-valued RDN
+type MultiValuedRDN struct {
+	Attribute []DNAttributeTypeAndValue `xml:"tas:Attribute"`
+}
+
+type DistinguishedName struct {
+	Country                    []DNAttributeValue             `xml:"tas:Country"`
+	Organization               []DNAttributeValue             `xml:"tas:Organization"`
+	OrganizationalUnit         []DNAttributeValue             `xml:"tas:OrganizationalUnit"`
+	DistinguishedNameQualifier []DNAttributeValue             `xml:"tas:DistinguishedNameQualifier"`
+	StateOrProvinceName        []DNAttributeValue             `xml:"tas:StateOrProvinceName"`
+	CommonName                 []DNAttributeValue             `xml:"tas:CommonName"`
+	SerialNumber               []DNAttributeValue             `xml:"tas:SerialNumber"`
+	Locality                   []DNAttributeValue             `xml:"tas:Locality"`
+	Title                      []DNAttributeValue             `xml:"tas:Title"`
+	Surname                    []DNAttributeValue             `xml:"tas:Surname"`
+	GivenName                  []DNAttributeValue             `xml:"tas:GivenName"`
+	Initials                   []DNAttributeValue             `xml:"tas:Initials"`
+	Pseudonym                  []DNAttributeValue             `xml:"tas:Pseudonym"`
+	GenerationQualifier        []DNAttributeValue             `xml:"tas:GenerationQualifier"`
+	GenericAttribute           []DNAttributeTypeAndValue      `xml:"tas:GenericAttribute"`
+	MultiValuedRDN             []MultiValuedRDN               `xml:"tas:MultiValuedRDN"`
+	AnyAttribute               *DistinguishedNameAnyAttribute `xml:"tas:anyAttribute,omitempty"`
+}
+
+// AlgorithmIdentifier an identifier of an algorithm
+type AlgorithmIdentifier struct {
+	Algorithm     DotDecimalOID                     `xml:"tas:algorithm"`
+	Parameters    Base64DERencodedASN1Value         `xml:"tas:parameters,omitempty"`
+	AnyParameters *AlgorithmIdentifierAnyParameters `xml:"tas:anyParameters,omitempty"`
+}
+
+// BasicRequestAttribute a CSR attribute as specified in RFC 2986
+type BasicRequestAttribute struct {
+	OID   DotDecimalOID             `xml:"tas:OID"`
+	Value Base64DERencodedASN1Value `xml:"tas:value"`
+}
+
+// CSRAttribute a CSR attribute as specified in PKCS#10
+type CSRAttribute struct {
+	X509v3Extension       *X509v3Extension          `xml:"tas:X509v3Extension,omitempty"`
+	BasicRequestAttribute *BasicRequestAttribute    `xml:"tas:BasicRequestAttribute,omitempty"`
+	AnyAttribute          *CSRAttributeAnyAttribute `xml:"tas:anyAttribute,omitempty"`
+}
+
+// Base64DERencodedASN1Value a base64-encoded ASN.1 value
+type Base64DERencodedASN1Value xsd.Base64Binary
+
+// X509v3Extension an X.509v3 extension field as specified in RFC 5280
+type X509v3Extension struct {
+	ExtnOID   DotDecimalOID             `xml:"tas:extnOID"`
+	Critical  xsd.Boolean               `xml:"tas:critical"`
+	ExtnValue Base64DERencodedASN1Value `xml:"tas:extnValue"`
+}
+
+// X509Certificate an X.509 cerficiate as specified in RFC 5280
+type X509Certificate struct {
+	CertificateID      CertificateID             `xml:"tas:CertificateID"`
+	KeyID              KeyID                     `xml:"tas:KeyID"`
+	Alias              xsd.String                `xml:"tas:Alias,omitempty"`
+	CertificateContent Base64DERencodedASN1Value `xml:"tas:CertificateContent"`
+}
+
+// CertificateIDs a sequence of certificate IDs
+type CertificateIDs struct {
+	CertificateID []CertificateID `xml:"tas:CertificateID"`
+}
+
+// CertificationPath an X.509 certification path as defined in RFC 5280
+type CertificationPath struct {
+	CertificateID []CertificateID              `xml:"tas:CertificateID"`
+	Alias         xsd.String                   `xml:"tas:Alias,omitempty"`
+	AnyElement    *CertificationPathAnyElement `xml:"tas:anyElement,omitempty"`
+}
+
+type PassphraseAttribute struct {
+	PassphraseID PassphraseID `xml:"tas:PassphraseID"`
+	Alias        xsd.String   `xml:"tas:Alias,omitempty"`
+}
+
+// Dot1XMethods a list of supported 802.1X authentication methods, such as "EAP-PEAP/MSCHAPv2" and "EAP-MD5"
+// The value is a space separated list.
+type Dot1XMethods xsd.String
+
+// Dot1XCapabilities the capabilities of the 802.1X implementation on a device
+type Dot1XCapabilities struct {
+	MaximumNumberOfDot1XConfigurations xsd.PositiveInteger `xml:"MaximumNumberOfDot1XConfigurations,attr,omitempty"`
+	Dot1XMethods                       Dot1XMethods        `xml:"Dot1XMethods,attr,omitempty"`
+}
+
+// Dot1XStage the configuration parameters required for a particular authentication method
+type Dot1XStage struct {
+	Identity            xsd.String           `xml:"tas:Identity,omitempty"`
+	CertificationPathID CertificationPathID  `xml:"tas:CertificationPathID,omitempty"`
+	PassphraseID        PassphraseID         `xml:"tas:PassphraseID,omitempty"`
+	Inner               *Dot1XStage          `xml:"tas:Inner,omitempty"`
+	Extension           *Dot1XStageExtension `xml:"tas:Extension,omitempty"`
+	Method              xsd.String           `xml:"Method,attr"`
+}
+
+type Dot1XStageExtension struct {
+}
+
+type Dot1XConfiguration struct {
+	Dot1XID Dot1XID    `xml:"tas:Dot1XID,omitempty"`
+	Alias   xsd.String `xml:"tas:Alias,omitempty"`
+	Outer   Dot1XStage `xml:"tas:Outer"`
+}
+
+type CRLID xsd.NCName
+
+type CertPathValidationPolicyID xsd.NCName
+
+type CRL struct {
+	CRLID      CRLID                     `xml:"tas:CRLID"`
+	Alias      xsd.String                `xml:"tas:Alias"`
+	CRLContent Base64DERencodedASN1Value `xml:"tas:CRLContent"`
+}
+
+type CertPathValidationParameters struct {
+	RequireTLSWWWClientAuthExtendedKeyUsage *xsd.Boolean                               `xml:"tas:RequireTLSWWWClientAuthExtendedKeyUsage,omitempty"`
+	UseDeltaCRLs                            *xsd.Boolean                               `xml:"tas:UseDeltaCRLs,omitempty"`
+	AnyParameters                           *CertPathValidationParametersAnyParameters `xml:"tas:anyParameters,omitempty"`
+}
+
+type TrustAnchor struct {
+	CertificateID CertificateID `xml:"tas:CertificateID"`
+}
+
+type CertPathValidationPolicy struct {
+	CertPathValidationPolicyID CertPathValidationPolicyID             `xml:"tas:CertPathValidationPolicyID"`
+	Alias                      xsd.String                             `xml:"tas:Alias,omitempty"`
+	Parameters                 CertPathValidationParameters           `xml:"tas:Parameters"`
+	TrustAnchor                []TrustAnchor                          `xml:"tas:TrustAnchor"`
+	AnyParameters              *CertPathValidationPolicyAnyParameters `xml:"tas:anyParameters,omitempty"`
+}
+
+// RSAKeyLengths a list of RSA key lenghts in bits
+// The value is a space separated list.
+type RSAKeyLengths xsd.String
+
+// X509Versions a list of X.509 versions
+// The value is a space separated list.
+type X509Versions xsd.String
+
+// TLSVersions a list of TLS versions
+// The value is a space separated list.
+type TLSVersions xsd.String
+
+// PasswordBasedEncryptionAlgorithms a list of password based encryption algorithms
+// The value is a space separated list.
+type PasswordBasedEncryptionAlgorithms xsd.String
+
+// PasswordBasedMACAlgorithms a list of password based MAC algorithms
+// The value is a space separated list.
+type PasswordBasedMACAlgorithms xsd.String
+
+// KeystoreCapabilities the capabilities of a keystore implementation on a device
+type KeystoreCapabilities struct {
+	SignatureAlgorithms                                []AlgorithmIdentifier             `xml:"tas:SignatureAlgorithms"`
+	AnyElement                                         *KeystoreCapabilitiesAnyElement   `xml:"tas:anyElement,omitempty"`
+	MaximumNumberOfKeys                                xsd.PositiveInteger               `xml:"MaximumNumberOfKeys,attr,omitempty"`
+	MaximumNumberOfCertificates                        xsd.PositiveInteger               `xml:"MaximumNumberOfCertificates,attr,omitempty"`
+	MaximumNumberOfCertificationPaths                  xsd.PositiveInteger               `xml:"MaximumNumberOfCertificationPaths,attr,omitempty"`
+	RSAKeyPairGeneration                               xsd.Boolean                       `xml:"RSAKeyPairGeneration,attr,omitempty"`
+	RSAKeyLengths                                      RSAKeyLengths                     `xml:"RSAKeyLengths,attr,omitempty"`
+	PKCS10ExternalCertificationWithRSA                 xsd.Boolean                       `xml:"PKCS10ExternalCertificationWithRSA,attr,omitempty"`
+	SelfSignedCertificateCreationWithRSA               xsd.Boolean                       `xml:"SelfSignedCertificateCreationWithRSA,attr,omitempty"`
+	X509Versions                                       X509Versions                      `xml:"X509Versions,attr,omitempty"`
+	MaximumNumberOfPassphrases                         xsd.NonNegativeInteger            `xml:"MaximumNumberOfPassphrases,attr,omitempty"`
+	PKCS8RSAKeyPairUpload                              xsd.Boolean                       `xml:"PKCS8RSAKeyPairUpload,attr,omitempty"`
+	PKCS12CertificateWithRSAPrivateKeyUpload           xsd.Boolean                       `xml:"PKCS12CertificateWithRSAPrivateKeyUpload,attr,omitempty"`
+	PasswordBasedEncryptionAlgorithms                  PasswordBasedEncryptionAlgorithms `xml:"PasswordBasedEncryptionAlgorithms,attr,omitempty"`
+	PasswordBasedMACAlgorithms                         PasswordBasedMACAlgorithms        `xml:"PasswordBasedMACAlgorithms,attr,omitempty"`
+	MaximumNumberOfCRLs                                xsd.NonNegativeInteger            `xml:"MaximumNumberOfCRLs,attr,omitempty"`
+	MaximumNumberOfCertificationPathValidationPolicies xsd.NonNegativeInteger            `xml:"MaximumNumberOfCertificationPathValidationPolicies,attr,omitempty"`
+	EnforceTLSWebClientAuthExtKeyUsage                 xsd.Boolean                       `xml:"EnforceTLSWebClientAuthExtKeyUsage,attr,omitempty"`
+}
+
+// TLSServerCapabilities the capabilities of a TLS server implementation on a device
+type TLSServerCapabilities struct {
+	TLSServerSupported                                    TLSVersions            `xml:"TLSServerSupported,attr,omitempty"`
+	MaximumNumberOfTLSCertificationPaths                  xsd.PositiveInteger    `xml:"MaximumNumberOfTLSCertificationPaths,attr,omitempty"`
+	TLSClientAuthSupported                                xsd.Boolean            `xml:"TLSClientAuthSupported,attr,omitempty"`
+	MaximumNumberOfTLSCertificationPathValidationPolicies xsd.NonNegativeInteger `xml:"MaximumNumberOfTLSCertificationPathValidationPolicies,attr,omitempty"`
+}
+
+// Capabilities the capabilities of an Advanced Security Service implementation on a device
+type Capabilities struct {
+	KeystoreCapabilities  KeystoreCapabilities  `xml:"tas:KeystoreCapabilities"`
+	TLSServerCapabilities TLSServerCapabilities `xml:"tas:TLSServerCapabilities"`
+	Dot1XCapabilities     *Dot1XCapabilities    `xml:"tas:Dot1XCapabilities,omitempty"`
+}
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tas:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type CreateRSAKeyPair struct {
+	XMLName   string                 `xml:"tas:CreateRSAKeyPair"`
+	KeyLength xsd.NonNegativeInteger `xml:"tas:KeyLength"`
+	Alias     xsd.String             `xml:"tas:Alias,omitempty"`
+}
+
+type CreateRSAKeyPairResponse struct {
+	KeyID                 KeyID
+	EstimatedCreationTime xsd.Duration
+}
+
+type UploadKeyPairInPKCS8 struct {
+	XMLName                string                    `xml:"tas:UploadKeyPairInPKCS8"`
+	KeyPair                Base64DERencodedASN1Value `xml:"tas:KeyPair"`
+	Alias                  xsd.String                `xml:"tas:Alias,omitempty"`
+	EncryptionPassphraseID PassphraseID              `xml:"tas:EncryptionPassphraseID,omitempty"`
+	EncryptionPassphrase   xsd.String                `xml:"tas:EncryptionPassphrase,omitempty"`
+}
+
+type UploadKeyPairInPKCS8Response struct {
+	KeyID KeyID
+}
+
+type UploadCertificateWithPrivateKeyInPKCS12 struct {
+	XMLName                      string                    `xml:"tas:UploadCertificateWithPrivateKeyInPKCS12"`
+	CertWithPrivateKey           Base64DERencodedASN1Value `xml:"tas:CertWithPrivateKey"`
+	CertificationPathAlias       xsd.String                `xml:"tas:CertificationPathAlias,omitempty"`
+	KeyAlias                     xsd.String                `xml:"tas:KeyAlias,omitempty"`
+	IgnoreAdditionalCertificates *xsd.Boolean              `xml:"tas:IgnoreAdditionalCertificates,omitempty"`
+	IntegrityPassphraseID        PassphraseID              `xml:"tas:IntegrityPassphraseID,omitempty"`
+	EncryptionPassphraseID       PassphraseID              `xml:"tas:EncryptionPassphraseID,omitempty"`
+	Passphrase                   xsd.String                `xml:"tas:Passphrase,omitempty"`
+}
+
+type UploadCertificateWithPrivateKeyInPKCS12Response struct {
+	CertificationPathID CertificationPathID
+	KeyID               KeyID
+}
+
+type GetKeyStatus struct {
+	XMLName string `xml:"tas:GetKeyStatus"`
+	KeyID   KeyID  `xml:"tas:KeyID"`
+}
+
+type GetKeyStatusResponse struct {
+	KeyStatus xsd.String
+}
+
+type GetPrivateKeyStatus struct {
+	XMLName string `xml:"tas:GetPrivateKeyStatus"`
+	KeyID   KeyID  `xml:"tas:KeyID"`
+}
+
+type GetPrivateKeyStatusResponse struct {
+	HasPrivateKey xsd.Boolean `xml:"hasPrivateKey"`
+}
+
+type GetAllKeys struct {
+	XMLName string `xml:"tas:GetAllKeys"`
+}
+
+type GetAllKeysResponse struct {
+	KeyAttribute []KeyAttribute
+}
+
+type DeleteKey struct {
+	XMLName string `xml:"tas:DeleteKey"`
+	KeyID   KeyID  `xml:"tas:KeyID"`
+}
+
+type DeleteKeyResponse struct {
+}
+
+type CreatePKCS10CSR struct {
+	XMLName            string              `xml:"tas:CreatePKCS10CSR"`
+	Subject            DistinguishedName   `xml:"tas:Subject"`
+	KeyID              KeyID               `xml:"tas:KeyID"`
+	CSRAttribute       []CSRAttribute      `xml:"tas:CSRAttribute"`
+	SignatureAlgorithm AlgorithmIdentifier `xml:"tas:SignatureAlgorithm"`
+}
+
+type CreatePKCS10CSRResponse struct {
+	PKCS10CSR Base64DERencodedASN1Value
+}
+
+type CreateSelfSignedCertificate struct {
+	XMLName            string              `xml:"tas:CreateSelfSignedCertificate"`
+	X509Version        xsd.PositiveInteger `xml:"tas:X509Version,omitempty"`
+	Subject            DistinguishedName   `xml:"tas:Subject"`
+	KeyID              KeyID               `xml:"tas:KeyID"`
+	Alias              xsd.String          `xml:"tas:Alias,omitempty"`
+	NotValidBefore     xsd.DateTime        `xml:"tas:notValidBefore,omitempty"`
+	NotValidAfter      xsd.DateTime        `xml:"tas:notValidAfter,omitempty"`
+	SignatureAlgorithm AlgorithmIdentifier `xml:"tas:SignatureAlgorithm"`
+	Extension          []X509v3Extension   `xml:"tas:Extension"`
+}
+
+type CreateSelfSignedCertificateResponse struct {
+	CertificateID CertificateID
+}
+
+type UploadCertificate struct {
+	XMLName            string                    `xml:"tas:UploadCertificate"`
+	Certificate        Base64DERencodedASN1Value `xml:"tas:Certificate"`
+	Alias              xsd.String                `xml:"tas:Alias,omitempty"`
+	KeyAlias           xsd.String                `xml:"tas:KeyAlias,omitempty"`
+	PrivateKeyRequired *xsd.Boolean              `xml:"tas:PrivateKeyRequired,omitempty"`
+}
+
+type UploadCertificateResponse struct {
+	CertificateID CertificateID
+	KeyID         KeyID
+}
+
+type GetCertificate struct {
+	XMLName       string        `xml:"tas:GetCertificate"`
+	CertificateID CertificateID `xml:"tas:CertificateID"`
+}
+
+type GetCertificateResponse struct {
+	Certificate X509Certificate
+}
+
+type GetAllCertificates struct {
+	XMLName string `xml:"tas:GetAllCertificates"`
+}
+
+// GetAllCertificatesResponse a list with all certificates stored in the keystore
+type GetAllCertificatesResponse struct {
+	Certificate []X509Certificate
+}
+
+type DeleteCertificate struct {
+	XMLName       string        `xml:"tas:DeleteCertificate"`
+	CertificateID CertificateID `xml:"tas:CertificateID"`
+}
+
+type DeleteCertificateResponse struct {
+}
+
+type CreateCertificationPath struct {
+	XMLName        string         `xml:"tas:CreateCertificationPath"`
+	CertificateIDs CertificateIDs `xml:"tas:CertificateIDs"`
+	Alias          xsd.String     `xml:"tas:Alias,omitempty"`
+}
+
+type CreateCertificationPathResponse struct {
+	CertificationPathID CertificationPathID
+}
+
+type GetCertificationPath struct {
+	XMLName             string              `xml:"tas:GetCertificationPath"`
+	CertificationPathID CertificationPathID `xml:"tas:CertificationPathID"`
+}
+
+type GetCertificationPathResponse struct {
+	CertificationPath CertificationPath
+}
+
+type GetAllCertificationPaths struct {
+	XMLName string `xml:"tas:GetAllCertificationPaths"`
+}
+
+type GetAllCertificationPathsResponse struct {
+	CertificationPathID []CertificationPathID
+}
+
+type DeleteCertificationPath struct {
+	XMLName             string              `xml:"tas:DeleteCertificationPath"`
+	CertificationPathID CertificationPathID `xml:"tas:CertificationPathID"`
+}
+
+type DeleteCertificationPathResponse struct {
+}
+
+type UploadPassphrase struct {
+	XMLName         string     `xml:"tas:UploadPassphrase"`
+	Passphrase      xsd.String `xml:"tas:Passphrase"`
+	PassphraseAlias xsd.String `xml:"tas:PassphraseAlias,omitempty"`
+}
+
+type UploadPassphraseResponse struct {
+	PassphraseID PassphraseID
+}
+
+type GetAllPassphrases struct {
+	XMLName string `xml:"tas:GetAllPassphrases"`
+}
+
+type GetAllPassphrasesResponse struct {
+	PassphraseAttribute []PassphraseAttribute
+}
+
+type DeletePassphrase struct {
+	XMLName      string       `xml:"tas:DeletePassphrase"`
+	PassphraseID PassphraseID `xml:"tas:PassphraseID"`
+}
+
+type DeletePassphraseResponse struct {
+}
+
+type AddServerCertificateAssignment struct {
+	XMLName             string              `xml:"tas:AddServerCertificateAssignment"`
+	CertificationPathID CertificationPathID `xml:"tas:CertificationPathID"`
+}
+
+type AddServerCertificateAssignmentResponse struct {
+}
+
+type RemoveServerCertificateAssignment struct {
+	XMLName             string              `xml:"tas:RemoveServerCertificateAssignment"`
+	CertificationPathID CertificationPathID `xml:"tas:CertificationPathID"`
+}
+
+type RemoveServerCertificateAssignmentResponse struct {
+}
+
+type ReplaceServerCertificateAssignment struct {
+	XMLName                string              `xml:"tas:ReplaceServerCertificateAssignment"`
+	OldCertificationPathID CertificationPathID `xml:"tas:OldCertificationPathID"`
+	NewCertificationPathID CertificationPathID `xml:"tas:NewCertificationPathID"`
+}
+
+type ReplaceServerCertificateAssignmentResponse struct {
+}
+
+type GetAssignedServerCertificates struct {
+	XMLName string `xml:"tas:GetAssignedServerCertificates"`
+}
+
+type GetAssignedServerCertificatesResponse struct {
+	CertificationPathID []CertificationPathID
+}
+
+type UploadCRL struct {
+	XMLName       string                    `xml:"tas:UploadCRL"`
+	Crl           Base64DERencodedASN1Value `xml:"tas:Crl"`
+	Alias         xsd.String                `xml:"tas:Alias,omitempty"`
+	AnyParameters *UploadCRLAnyParameters   `xml:"tas:anyParameters,omitempty"`
+}
+
+type UploadCRLResponse struct {
+	CrlID CRLID
+}
+
+type GetCRL struct {
+	XMLName string `xml:"tas:GetCRL"`
+	CrlID   CRLID  `xml:"tas:CrlID"`
+}
+
+type GetCRLResponse struct {
+	Crl CRL
+}
+
+type GetAllCRLs struct {
+	XMLName string `xml:"tas:GetAllCRLs"`
+}
+
+type GetAllCRLsResponse struct {
+	Crl []CRL
+}
+
+type DeleteCRL struct {
+	XMLName string `xml:"tas:DeleteCRL"`
+	CrlID   CRLID  `xml:"tas:CrlID"`
+}
+
+type DeleteCRLResponse struct {
+}
+
+type CreateCertPathValidationPolicy struct {
+	XMLName       string                                       `xml:"tas:CreateCertPathValidationPolicy"`
+	Alias         xsd.String                                   `xml:"tas:Alias,omitempty"`
+	Parameters    CertPathValidationParameters                 `xml:"tas:Parameters"`
+	TrustAnchor   []TrustAnchor                                `xml:"tas:TrustAnchor"`
+	AnyParameters *CreateCertPathValidationPolicyAnyParameters `xml:"tas:anyParameters,omitempty"`
+}
+
+type CreateCertPathValidationPolicyResponse struct {
+	CertPathValidationPolicyID CertPathValidationPolicyID
+}
+
+type GetCertPathValidationPolicy struct {
+	XMLName                    string                     `xml:"tas:GetCertPathValidationPolicy"`
+	CertPathValidationPolicyID CertPathValidationPolicyID `xml:"tas:CertPathValidationPolicyID"`
+}
+
+type GetCertPathValidationPolicyResponse struct {
+	CertPathValidationPolicy CertPathValidationPolicy
+}
+
+type GetAllCertPathValidationPolicies struct {
+	XMLName string `xml:"tas:GetAllCertPathValidationPolicies"`
+}
+
+type GetAllCertPathValidationPoliciesResponse struct {
+	CertPathValidationPolicy []CertPathValidationPolicy
+}
+
+type DeleteCertPathValidationPolicy struct {
+	XMLName                    string                     `xml:"tas:DeleteCertPathValidationPolicy"`
+	CertPathValidationPolicyID CertPathValidationPolicyID `xml:"tas:CertPathValidationPolicyID"`
+}
+
+type DeleteCertPathValidationPolicyResponse struct {
+}
+
+type SetClientAuthenticationRequired struct {
+	XMLName                      string      `xml:"tas:SetClientAuthenticationRequired"`
+	ClientAuthenticationRequired xsd.Boolean `xml:"tas:clientAuthenticationRequired"`
+}
+
+type SetClientAuthenticationRequiredResponse struct {
+}
+
+type GetClientAuthenticationRequired struct {
+	XMLName string `xml:"tas:GetClientAuthenticationRequired"`
+}
+
+type GetClientAuthenticationRequiredResponse struct {
+	ClientAuthenticationRequired xsd.Boolean `xml:"clientAuthenticationRequired"`
+}
+
+type AddCertPathValidationPolicyAssignment struct {
+	XMLName                    string                     `xml:"tas:AddCertPathValidationPolicyAssignment"`
+	CertPathValidationPolicyID CertPathValidationPolicyID `xml:"tas:CertPathValidationPolicyID"`
+}
+
+type AddCertPathValidationPolicyAssignmentResponse struct {
+}
+
+type RemoveCertPathValidationPolicyAssignment struct {
+	XMLName                    string                     `xml:"tas:RemoveCertPathValidationPolicyAssignment"`
+	CertPathValidationPolicyID CertPathValidationPolicyID `xml:"tas:CertPathValidationPolicyID"`
+}
+
+type RemoveCertPathValidationPolicyAssignmentResponse struct {
+}
+
+type ReplaceCertPathValidationPolicyAssignment struct {
+	XMLName                       string                     `xml:"tas:ReplaceCertPathValidationPolicyAssignment"`
+	OldCertPathValidationPolicyID CertPathValidationPolicyID `xml:"tas:OldCertPathValidationPolicyID"`
+	NewCertPathValidationPolicyID CertPathValidationPolicyID `xml:"tas:NewCertPathValidationPolicyID"`
+}
+
+type ReplaceCertPathValidationPolicyAssignmentResponse struct {
+}
+
+type GetAssignedCertPathValidationPolicies struct {
+	XMLName string `xml:"tas:GetAssignedCertPathValidationPolicies"`
+}
+
+type GetAssignedCertPathValidationPoliciesResponse struct {
+	CertPathValidationPolicyID []CertPathValidationPolicyID
+}
+
+type AddDot1XConfiguration struct {
+	XMLName            string             `xml:"tas:AddDot1XConfiguration"`
+	Dot1XConfiguration Dot1XConfiguration `xml:"tas:Dot1XConfiguration"`
+}
+
+type AddDot1XConfigurationResponse struct {
+	Dot1XID Dot1XID
+}
+
+type GetAllDot1XConfigurations struct {
+	XMLName string `xml:"tas:GetAllDot1XConfigurations"`
+}
+
+type GetAllDot1XConfigurationsResponse struct {
+	Configuration []Dot1XConfiguration
+}
+
+type GetDot1XConfiguration struct {
+	XMLName string  `xml:"tas:GetDot1XConfiguration"`
+	Dot1XID Dot1XID `xml:"tas:Dot1XID"`
+}
+
+type GetDot1XConfigurationResponse struct {
+	Dot1XConfiguration Dot1XConfiguration
+}
+
+type DeleteDot1XConfiguration struct {
+	XMLName string  `xml:"tas:DeleteDot1XConfiguration"`
+	Dot1XID Dot1XID `xml:"tas:Dot1XID"`
+}
+
+type DeleteDot1XConfigurationResponse struct {
+}
+
+type SetNetworkInterfaceDot1XConfiguration struct {
+	XMLName string     `xml:"tas:SetNetworkInterfaceDot1XConfiguration"`
+	Token   xsd.String `xml:"tas:token"`
+	Dot1XID Dot1XID    `xml:"tas:Dot1XID"`
+}
+
+type SetNetworkInterfaceDot1XConfigurationResponse struct {
+	RebootNeeded xsd.Boolean
+}
+
+type GetNetworkInterfaceDot1XConfiguration struct {
+	XMLName string     `xml:"tas:GetNetworkInterfaceDot1XConfiguration"`
+	Token   xsd.String `xml:"tas:token"`
+}
+
+type GetNetworkInterfaceDot1XConfigurationResponse struct {
+	Dot1XID Dot1XID
+}
+
+type DeleteNetworkInterfaceDot1XConfiguration struct {
+	XMLName string     `xml:"tas:DeleteNetworkInterfaceDot1XConfiguration"`
+	Token   xsd.String `xml:"tas:token"`
+}
+
+type DeleteNetworkInterfaceDot1XConfigurationResponse struct {
+	RebootNeeded xsd.Boolean
+}
+
+type KeyAttributeExtension struct {
+}
+
+// DistinguishedNameAnyAttribute required extension point
+type DistinguishedNameAnyAttribute struct {
+}
+
+type AlgorithmIdentifierAnyParameters struct {
+}
+
+type CSRAttributeAnyAttribute struct {
+}
+
+type CertificationPathAnyElement struct {
+}
+
+type CertPathValidationParametersAnyParameters struct {
+}
+
+type CertPathValidationPolicyAnyParameters struct {
+}
+
+type KeystoreCapabilitiesAnyElement struct {
+}
+
+type UploadCRLAnyParameters struct {
+}
+
+type CreateCertPathValidationPolicyAnyParameters struct {
+}
+
+// Actions maps the request elements of the service to their WS-Addressing action
+var Actions = map[string]string{
+	"tas:GetServiceCapabilities":                    "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetServiceCapabilities",
+	"tas:CreateRSAKeyPair":                          "http://www.onvif.org/ver10/advancedsecurity/wsdl/CreateRSAKeyPair",
+	"tas:UploadKeyPairInPKCS8":                      "http://www.onvif.org/ver10/advancedsecurity/wsdl/UploadKeyPairInPKCS8",
+	"tas:UploadCertificateWithPrivateKeyInPKCS12":   "http://www.onvif.org/ver10/advancedsecurity/wsdl/UploadCertificateWithPrivateKeyInPKCS12",
+	"tas:GetKeyStatus":                              "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetKeyStatus",
+	"tas:GetPrivateKeyStatus":                       "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetPrivateKeyStatus",
+	"tas:GetAllKeys":                                "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetAllKeys",
+	"tas:DeleteKey":                                 "http://www.onvif.org/ver10/advancedsecurity/wsdl/DeleteKey",
+	"tas:CreatePKCS10CSR":                           "http://www.onvif.org/ver10/advancedsecurity/wsdl/CreatePKCS10CSR",
+	"tas:CreateSelfSignedCertificate":               "http://www.onvif.org/ver10/advancedsecurity/wsdl/CreateSelfSignedCertificate",
+	"tas:UploadCertificate":                         "http://www.onvif.org/ver10/advancedsecurity/wsdl/UploadCertificate",
+	"tas:GetCertificate":                            "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetCertificate",
+	"tas:GetAllCertificates":                        "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetAllCertificates",
+	"tas:DeleteCertificate":                         "http://www.onvif.org/ver10/advancedsecurity/wsdl/DeleteCertificate",
+	"tas:CreateCertificationPath":                   "http://www.onvif.org/ver10/advancedsecurity/wsdl/CreateCertificationPath",
+	"tas:GetCertificationPath":                      "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetCertificationPath",
+	"tas:GetAllCertificationPaths":                  "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetAllCertificationPaths",
+	"tas:DeleteCertificationPath":                   "http://www.onvif.org/ver10/advancedsecurity/wsdl/DeleteCertificationPath",
+	"tas:UploadPassphrase":                          "http://www.onvif.org/ver10/advancedsecurity/wsdl/UploadPassphrase",
+	"tas:GetAllPassphrases":                         "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetAllPassphrases",
+	"tas:DeletePassphrase":                          "http://www.onvif.org/ver10/advancedsecurity/wsdl/DeletePassphrase",
+	"tas:UploadCRL":                                 "http://www.onvif.org/ver10/advancedsecurity/wsdl/UploadCRL",
+	"tas:GetCRL":                                    "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetCRL",
+	"tas:GetAllCRLs":                                "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetAllCRLs",
+	"tas:DeleteCRL":                                 "http://www.onvif.org/ver10/advancedsecurity/wsdl/DeleteCRL",
+	"tas:CreateCertPathValidationPolicy":            "http://www.onvif.org/ver10/advancedsecurity/wsdl/CreateCertPathValidationPolicy",
+	"tas:GetCertPathValidationPolicy":               "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetCertPathValidationPolicy",
+	"tas:GetAllCertPathValidationPolicies":          "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetAllCertPathValidationPolicies",
+	"tas:DeleteCertPathValidationPolicy":            "http://www.onvif.org/ver10/advancedsecurity/wsdl/DeleteCertPathValidationPolicy",
+	"tas:AddServerCertificateAssignment":            "http://www.onvif.org/ver10/advancedsecurity/wsdl/AddServerCertificateAssignment",
+	"tas:RemoveServerCertificateAssignment":         "http://www.onvif.org/ver10/advancedsecurity/wsdl/RemoveServerCertificateAssignment",
+	"tas:ReplaceServerCertificateAssignment":        "http://www.onvif.org/ver10/advancedsecurity/wsdl/ReplaceServerCertificateAssignment",
+	"tas:GetAssignedServerCertificates":             "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetAssignedServerCertificates",
+	"tas:SetClientAuthenticationRequired":           "http://www.onvif.org/ver10/advancedsecurity/wsdl/SetClientAuthenticationRequired",
+	"tas:GetClientAuthenticationRequired":           "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetClientAuthenticationRequired",
+	"tas:AddCertPathValidationPolicyAssignment":     "http://www.onvif.org/ver10/advancedsecurity/wsdl/AddCertPathValidationPolicyAssignment",
+	"tas:RemoveCertPathValidationPolicyAssignment":  "http://www.onvif.org/ver10/advancedsecurity/wsdl/RemoveCertPathValidationPolicyAssignment",
+	"tas:ReplaceCertPathValidationPolicyAssignment": "http://www.onvif.org/ver10/advancedsecurity/wsdl/ReplaceCertPathValidationPolicyAssignment",
+	"tas:GetAssignedCertPathValidationPolicies":     "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetAssignedCertPathValidationPolicies",
+	"tas:AddDot1XConfiguration":                     "http://www.onvif.org/ver10/advancedsecurity/wsdl/AddDot1XConfiguration",
+	"tas:GetAllDot1XConfigurations":                 "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetAllDot1XConfigurations",
+	"tas:GetDot1XConfiguration":                     "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetDot1XConfiguration",
+	"tas:DeleteDot1XConfiguration":                  "http://www.onvif.org/ver10/advancedsecurity/wsdl/DeleteDot1XConfiguration",
+	"tas:SetNetworkInterfaceDot1XConfiguration":     "http://www.onvif.org/ver10/advancedsecurity/wsdl/SetNetworkInterfaceDot1XConfiguration",
+	"tas:GetNetworkInterfaceDot1XConfiguration":     "http://www.onvif.org/ver10/advancedsecurity/wsdl/GetNetworkInterfaceDot1XConfiguration",
+	"tas:DeleteNetworkInterfaceDot1XConfiguration":  "http://www.onvif.org/ver10/advancedsecurity/wsdl/DeleteNetworkInterfaceDot1XConfiguration",
+}
+
+func init() {
+	for tag, action := range Actions {
+		gosoap.RegisterAction(tag, action)
+	}
+}
@@ -13,13 +13,13 @@ type GetSupportedRules struct {
 type CreateRules struct {
 	XMLName            string               `xml:"tan:CreateRules"`
 	ConfigurationToken onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
-	Rule               onvif.Config         `xml:"tan:Rule"`
+	Rule               []onvif.Config       `xml:"tan:Rule"`
 }
 
 type DeleteRules struct {
 	XMLName            string               `xml:"tan:DeleteRules"`
 	ConfigurationToken onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
-	RuleName           xsd.String           `xml:"tan:RuleName"`
+	RuleName           []xsd.String         `xml:"tan:RuleName"`
 }
 
 type GetRules struct {
@@ -29,14 +29,14 @@ type GetRules struct {
 
 type GetRuleOptions struct {
 	XMLName            string               `xml:"tan:GetRuleOptions"`
-	RuleType           xsd.QName            `xml:"tan:RuleType"`
+	RuleType           xsd.QName            `xml:"tan:RuleType,omitempty"`
 	ConfigurationToken onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
 }
 
 type ModifyRules struct {
 	XMLName            string               `xml:"tan:ModifyRules"`
 	ConfigurationToken onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
-	Rule               onvif.Config         `xml:"tan:Rule"`
+	Rule               []onvif.Config       `xml:"tan:Rule"`
 }
 
 type GetServiceCapabilities struct {
@@ -55,15 +55,15 @@ type GetAnalyticsModuleOptions struct {
 }
 
 type CreateAnalyticsModules struct {
-	XMLName            string               `xml:"tev:CreateAnalyticsModules"`
+	XMLName            string               `xml:"tan:CreateAnalyticsModules"`
 	ConfigurationToken onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
-	AnalyticsModule    onvif.Config         `xml:"tan:AnalyticsModule"`
+	AnalyticsModule    []onvif.Config       `xml:"tan:AnalyticsModule"`
 }
 
 type DeleteAnalyticsModules struct {
 	XMLName             string               `xml:"tan:DeleteAnalyticsModules"`
 	ConfigurationToken  onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
-	AnalyticsModuleName xsd.String           `xml:"tan:AnalyticsModuleName"`
+	AnalyticsModuleName []xsd.String         `xml:"tan:AnalyticsModuleName"`
 }
 
 type GetAnalyticsModules struct {
@@ -74,5 +74,5 @@ type GetAnalyticsModules struct {
 type ModifyAnalyticsModules struct {
 	XMLName            string               `xml:"tan:ModifyAnalyticsModules"`
 	ConfigurationToken onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
-	AnalyticsModule    onvif.Config         `xml:"tan:AnalyticsModule"`
+	AnalyticsModule    []onvif.Config       `xml:"tan:AnalyticsModule"`
 }
@@ -0,0 +1,194 @@
+// Code generated by gonvif-gen from analyticsdevice.wsdl. DO NOT EDIT.
+
+package analyticsdevice
+
+import (
+	"github.com/sonnt85/gonvif/gosoap"
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Namespace of the analyticsdevice service
+const Namespace = "http://www.onvif.org/ver10/analyticsdevice/wsdl"
+
+// Prefix of the analyticsdevice service elements
+const Prefix = "tad"
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tad:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type Capabilities struct {
+}
+
+type DeleteAnalyticsEngineControl struct {
+	XMLName            string               `xml:"tad:DeleteAnalyticsEngineControl"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tad:ConfigurationToken"`
+}
+
+type DeleteAnalyticsEngineControlResponse struct {
+}
+
+type CreateAnalyticsEngineInputs struct {
+	XMLName          string        `xml:"tad:CreateAnalyticsEngineInputs"`
+	Configuration    []xsd.AnyType `xml:"tad:Configuration"`
+	ForcePersistence []xsd.Boolean `xml:"tad:ForcePersistence"`
+}
+
+type CreateAnalyticsEngineInputsResponse struct {
+	Configuration []xsd.AnyType
+}
+
+type CreateAnalyticsEngineControl struct {
+	XMLName       string      `xml:"tad:CreateAnalyticsEngineControl"`
+	Configuration xsd.AnyType `xml:"tad:Configuration"`
+}
+
+type CreateAnalyticsEngineControlResponse struct {
+	Configuration []xsd.AnyType
+}
+
+type SetAnalyticsEngineControl struct {
+	XMLName          string      `xml:"tad:SetAnalyticsEngineControl"`
+	Configuration    xsd.AnyType `xml:"tad:Configuration"`
+	ForcePersistence xsd.Boolean `xml:"tad:ForcePersistence"`
+}
+
+type SetAnalyticsEngineControlResponse struct {
+}
+
+type GetAnalyticsEngineControl struct {
+	XMLName            string               `xml:"tad:GetAnalyticsEngineControl"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tad:ConfigurationToken"`
+}
+
+type GetAnalyticsEngineControlResponse struct {
+	Configuration xsd.AnyType
+}
+
+type GetAnalyticsEngineControls struct {
+	XMLName string `xml:"tad:GetAnalyticsEngineControls"`
+}
+
+type GetAnalyticsEngineControlsResponse struct {
+	AnalyticsEngineControls []xsd.AnyType
+}
+
+type GetAnalyticsEngine struct {
+	XMLName            string               `xml:"tad:GetAnalyticsEngine"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tad:ConfigurationToken"`
+}
+
+type GetAnalyticsEngineResponse struct {
+	Configuration xsd.AnyType
+}
+
+type GetAnalyticsEngines struct {
+	XMLName string `xml:"tad:GetAnalyticsEngines"`
+}
+
+type GetAnalyticsEnginesResponse struct {
+	Configuration []xsd.AnyType
+}
+
+type SetVideoAnalyticsConfiguration struct {
+	XMLName          string                            `xml:"tad:SetVideoAnalyticsConfiguration"`
+	Configuration    onvif.VideoAnalyticsConfiguration `xml:"tad:Configuration"`
+	ForcePersistence xsd.Boolean                       `xml:"tad:ForcePersistence"`
+}
+
+type SetVideoAnalyticsConfigurationResponse struct {
+}
+
+type SetAnalyticsEngineInput struct {
+	XMLName          string      `xml:"tad:SetAnalyticsEngineInput"`
+	Configuration    xsd.AnyType `xml:"tad:Configuration"`
+	ForcePersistence xsd.Boolean `xml:"tad:ForcePersistence"`
+}
+
+type SetAnalyticsEngineInputResponse struct {
+}
+
+type GetAnalyticsEngineInput struct {
+	XMLName            string               `xml:"tad:GetAnalyticsEngineInput"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tad:ConfigurationToken"`
+}
+
+type GetAnalyticsEngineInputResponse struct {
+	Configuration xsd.AnyType
+}
+
+type GetAnalyticsEngineInputs struct {
+	XMLName string `xml:"tad:GetAnalyticsEngineInputs"`
+}
+
+type GetAnalyticsEngineInputsResponse struct {
+	Configuration []xsd.AnyType
+}
+
+type GetAnalyticsDeviceStreamUri struct {
+	XMLName                     string               `xml:"tad:GetAnalyticsDeviceStreamUri"`
+	StreamSetup                 onvif.StreamSetup    `xml:"tad:StreamSetup"`
+	AnalyticsEngineControlToken onvif.ReferenceToken `xml:"tad:AnalyticsEngineControlToken"`
+}
+
+type GetAnalyticsDeviceStreamUriResponse struct {
+	Uri xsd.AnyURI
+}
+
+type GetVideoAnalyticsConfiguration struct {
+	XMLName            string               `xml:"tad:GetVideoAnalyticsConfiguration"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tad:ConfigurationToken"`
+}
+
+type GetVideoAnalyticsConfigurationResponse struct {
+	Configuration onvif.VideoAnalyticsConfiguration
+}
+
+type DeleteAnalyticsEngineInputs struct {
+	XMLName            string                 `xml:"tad:DeleteAnalyticsEngineInputs"`
+	ConfigurationToken []onvif.ReferenceToken `xml:"tad:ConfigurationToken"`
+}
+
+type DeleteAnalyticsEngineInputsResponse struct {
+}
+
+type GetAnalyticsState struct {
+	XMLName                     string               `xml:"tad:GetAnalyticsState"`
+	AnalyticsEngineControlToken onvif.ReferenceToken `xml:"tad:AnalyticsEngineControlToken"`
+}
+
+type GetAnalyticsStateResponse struct {
+	State xsd.AnyType
+}
+
+// Actions maps the request elements of the service to their WS-Addressing action
+var Actions = map[string]string{
+	"tad:GetServiceCapabilities":         "http://www.onvif.org/ver10/analyticsdevice/wsdl/GetServiceCapabilities",
+	"tad:DeleteAnalyticsEngineControl":   "http://www.onvif.org/ver10/analyticsdevice/wsdl/DeleteAnalyticsEngineControl",
+	"tad:CreateAnalyticsEngineControl":   "http://www.onvif.org/ver10/analyticsdevice/wsdl/CreateAnalyticsEngineControl",
+	"tad:SetAnalyticsEngineControl":      "http://www.onvif.org/ver10/analyticsdevice/wsdl/SetAnalyticsEngineControl",
+	"tad:GetAnalyticsEngineControl":      "http://www.onvif.org/ver10/analyticsdevice/wsdl/GetAnalyticsEngineControl",
+	"tad:GetAnalyticsEngineControls":     "http://www.onvif.org/ver10/analyticsdevice/wsdl/GetAnalyticsEngineControls",
+	"tad:GetAnalyticsEngine":             "http://www.onvif.org/ver10/analyticsdevice/wsdl/GetAnalyticsEngine",
+	"tad:GetAnalyticsEngines":            "http://www.onvif.org/ver10/analyticsdevice/wsdl/GetAnalyticsEngines",
+	"tad:SetVideoAnalyticsConfiguration": "http://www.onvif.org/ver10/analyticsdevice/wsdl/SetVideoAnalyticsConfiguration",
+	"tad:SetAnalyticsEngineInput":        "http://www.onvif.org/ver10/analyticsdevice/wsdl/SetAnalyticsEngineInput",
+	"tad:GetAnalyticsEngineInput":        "http://www.onvif.org/ver10/analyticsdevice/wsdl/GetAnalyticsEngineInput",
+	"tad:GetAnalyticsEngineInputs":       "http://www.onvif.org/ver10/analyticsdevice/wsdl/GetAnalyticsEngineInputs",
+	"tad:GetAnalyticsDeviceStreamUri":    "http://www.onvif.org/ver10/analyticsdevice/wsdl/GetAnalyticsDeviceStreamUri",
+	"tad:GetVideoAnalyticsConfiguration": "http://www.onvif.org/ver10/analyticsdevice/wsdl/GetVideoAnalyticsConfiguration",
+	"tad:CreateAnalyticsEngineInputs":    "http://www.onvif.org/ver10/analyticsdevice/wsdl/CreateAnalyticsEngineInputs",
+	"tad:DeleteAnalyticsEngineInputs":    "http://www.onvif.org/ver10/analyticsdevice/wsdl/DeleteAnalyticsEngineInputs",
+	"tad:GetAnalyticsState":              "http://www.onvif.org/ver10/analyticsdevice/wsdl/GetAnalyticsState",
+}
+
+func init() {
+	for tag, action := range Actions {
+		gosoap.RegisterAction(tag, action)
+	}
+}
@@ -0,0 +1,650 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/beevik/etree"
+)
+
+const (
+	xmlSchemaNamespace = "http://www.w3.org/2001/XMLSchema"
+	onvifNamespace     = "http://www.onvif.org/ver10/schema"
+)
+
+// operation of the service port type
+type operation struct {
+	Name     string
+	Request  string // input element
+	Response string // output element
+	Action   string // WS-Addressing action of the input message
+}
+
+// pendingType is an anonymous complex type declared inside an element
+type pendingType struct {
+	name   string
+	node   *etree.Element
+	tagged bool
+}
+
+type generator struct {
+	source     string
+	pkg        string
+	prefix     string
+	namespace  string
+	namespaces map[string]string // prefix -> namespace
+	schemas    []*etree.Element
+	operations []operation
+	requests   map[string]bool
+	responses  map[string]bool
+
+	localStructs map[string]bool // complex types and elements of the WSDL
+	onvifTypes   map[string]ast.Expr
+	xsdTypes     map[string]ast.Expr
+
+	declared map[string]bool
+	structs  map[string]bool // generated structs, for the registry
+	pending  []pendingType
+	imports  map[string]bool
+	buf      bytes.Buffer
+}
+
+// newGenerator reads the WSDL in file and the Go types of the onvif and xsd packages
+func newGenerator(file, prefix, onvifDir, xsdDir string) (*generator, error) {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromFile(file); err != nil {
+		return nil, err
+	}
+	root := doc.Root()
+	if root == nil || root.Tag != "definitions" {
+		return nil, errors.New(file + " is not a WSDL document")
+	}
+
+	gen := &generator{
+		source:       filepath.Base(file),
+		prefix:       prefix,
+		namespace:    root.SelectAttrValue("targetNamespace", ""),
+		namespaces:   make(map[string]string),
+		requests:     make(map[string]bool),
+		responses:    make(map[string]bool),
+		localStructs: make(map[string]bool),
+		declared:     make(map[string]bool),
+		structs:      make(map[string]bool),
+		imports:      make(map[string]bool),
+	}
+	gen.addNamespaces(root)
+	gen.schemas = root.FindElements("./types/schema")
+	for _, schema := range gen.schemas {
+		gen.addNamespaces(schema)
+		for _, child := range schema.ChildElements() {
+			switch {
+			case child.Tag == "complexType":
+				gen.localStructs[child.SelectAttrValue("name", "")] = true
+			case child.Tag == "element" && child.SelectElement("complexType") != nil:
+				gen.localStructs[child.SelectAttrValue("name", "")] = true
+			}
+		}
+	}
+	if gen.prefix == "" {
+		gen.prefix = gen.prefixOf(gen.namespace)
+	}
+	if gen.prefix == "" {
+		return nil, errors.New("no prefix declared for " + gen.namespace + ", use -prefix")
+	}
+	gen.pkg = gen.prefix
+
+	gen.readOperations(root)
+
+	var err error
+	if gen.onvifTypes, err = readTypes(onvifDir); err != nil {
+		return nil, err
+	}
+	if gen.xsdTypes, err = readTypes(xsdDir); err != nil {
+		return nil, err
+	}
+	return gen, nil
+}
+
+func (gen *generator) addNamespaces(element *etree.Element) {
+	for _, attr := range element.Attr {
+		if attr.Space == "xmlns" {
+			gen.namespaces[attr.Key] = attr.Value
+		}
+	}
+}
+
+// prefixOf returns the prefix declared for namespace, tns is only used as a last resort
+func (gen *generator) prefixOf(namespace string) string {
+	var prefixes []string
+	for prefix, ns := range gen.namespaces {
+		if ns == namespace {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	sort.Slice(prefixes, func(i, j int) bool {
+		return prefixes[i] != "tns" && (prefixes[j] == "tns" || prefixes[i] < prefixes[j])
+	})
+	if len(prefixes) == 0 {
+		return ""
+	}
+	return prefixes[0]
+}
+
+// readOperations collects the operations of the port types with their
+// request and response elements and actions
+func (gen *generator) readOperations(root *etree.Element) {
+	messages := make(map[string]string)
+	for _, message := range root.SelectElements("message") {
+		if part := message.SelectElement("part"); part != nil {
+			messages[message.SelectAttrValue("name", "")] = localName(part.SelectAttrValue("element", ""))
+		}
+	}
+	soapActions := make(map[string]string)
+	for _, op := range root.FindElements("./binding/operation") {
+		if soapOp := op.SelectElement("operation"); soapOp != nil {
+			soapActions[op.SelectAttrValue("name", "")] = soapOp.SelectAttrValue("soapAction", "")
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, op := range root.FindElements("./portType/operation") {
+		input, output := op.SelectElement("input"), op.SelectElement("output")
+		if input == nil {
+			continue
+		}
+		o := operation{
+			Name:    op.SelectAttrValue("name", ""),
+			Request: messages[localName(input.SelectAttrValue("message", ""))],
+			Action:  input.SelectAttrValue("Action", ""),
+		}
+		if output != nil {
+			o.Response = messages[localName(output.SelectAttrValue("message", ""))]
+		}
+		if o.Action == "" {
+			o.Action = soapActions[o.Name]
+		}
+		if o.Action == "" {
+			o.Action = gen.namespace + "/" + o.Name
+		}
+		if o.Request == "" || seen[o.Request] {
+			continue
+		}
+		seen[o.Request] = true
+		gen.requests[o.Request] = true
+		if o.Response != "" {
+			gen.responses[o.Response] = true
+		}
+		gen.operations = append(gen.operations, o)
+	}
+}
+
+// readTypes returns the type declarations of the Go package in dir
+func readTypes(dir string) (map[string]ast.Expr, error) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	types := make(map[string]ast.Expr)
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.TYPE {
+					for _, spec := range gen.Specs {
+						spec := spec.(*ast.TypeSpec)
+						types[spec.Name.Name] = spec.Type
+					}
+				}
+			}
+		}
+	}
+	return types, nil
+}
+
+// generate returns the source of the service package
+func (gen *generator) generate() ([]byte, error) {
+	gen.printf("// Namespace of the %s service\n", gen.pkg)
+	gen.printf("const Namespace = %q\n\n", gen.namespace)
+	gen.printf("// Prefix of the %s service elements\n", gen.pkg)
+	gen.printf("const Prefix = %q\n\n", gen.prefix)
+
+	for _, schema := range gen.schemas {
+		for _, child := range schema.ChildElements() {
+			name := child.SelectAttrValue("name", "")
+			switch child.Tag {
+			case "simpleType":
+				gen.simpleType(name, child)
+			case "complexType":
+				gen.complexType(name, child, true, false)
+			case "element":
+				if complexType := child.SelectElement("complexType"); complexType != nil {
+					request := gen.requests[name]
+					gen.complexType(name, complexType, request, request)
+				} else if simpleType := child.SelectElement("simpleType"); simpleType != nil {
+					gen.simpleType(name, simpleType)
+				} else if gen.requests[name] || gen.responses[name] {
+					gen.typedElement(name, child)
+				}
+			}
+		}
+	}
+	for len(gen.pending) != 0 {
+		pending := gen.pending[0]
+		gen.pending = gen.pending[1:]
+		gen.complexType(pending.name, pending.node, pending.tagged, false)
+	}
+	gen.actions()
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by gonvif-gen from %s. DO NOT EDIT.\n\n", gen.source)
+	fmt.Fprintf(&src, "package %s\n\n", gen.pkg)
+	if len(gen.imports) != 0 {
+		var imports []string
+		for imp := range gen.imports {
+			imports = append(imports, strconv.Quote(imp))
+		}
+		sort.Strings(imports)
+		fmt.Fprintf(&src, "import (\n%s\n)\n\n", strings.Join(imports, "\n"))
+	}
+	src.Write(gen.buf.Bytes())
+	return src.Bytes(), nil
+}
+
+// generateRegistry returns the source registering the structs of the service in onvifutils.GetOnvifStruct
+func (gen *generator) generateRegistry(service, importPath string) ([]byte, error) {
+	var entries []string
+	for _, op := range gen.operations {
+		for _, name := range []string{op.Request, op.Response} {
+			if gen.structs[name] {
+				entries = append(entries, fmt.Sprintf("%q: &%s.%s{},", name, gen.pkg, exportName(name)))
+			}
+		}
+	}
+	if len(entries) == 0 {
+		return nil, errors.New("no operation to register")
+	}
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by gonvif-gen from %s. DO NOT EDIT.\n\n", gen.source)
+	fmt.Fprintf(&src, "package onvifutils\n\n")
+	if path.Base(importPath) == gen.pkg {
+		fmt.Fprintf(&src, "import %q\n\n", importPath)
+	} else {
+		fmt.Fprintf(&src, "import %s %q\n\n", gen.pkg, importPath)
+	}
+	fmt.Fprintf(&src, "func init() {\n\tGetOnvifStruct[%q] = map[string]interface{}{\n%s\n\t}\n}\n", service, strings.Join(entries, "\n"))
+	return src.Bytes(), nil
+}
+
+func (gen *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&gen.buf, format, args...)
+}
+
+// actions emits the WS-Addressing actions of the requests and registers them
+func (gen *generator) actions() {
+	if len(gen.operations) == 0 {
+		return
+	}
+	gen.imports["github.com/sonnt85/gonvif/gosoap"] = true
+	gen.printf("// Actions maps the request elements of the service to their WS-Addressing action\n")
+	gen.printf("var Actions = map[string]string{\n")
+	for _, op := range gen.operations {
+		gen.printf("%q: %q,\n", gen.prefix+":"+op.Request, op.Action)
+	}
+	gen.printf("}\n\n")
+	gen.printf("func init() {\nfor tag, action := range Actions {\ngosoap.RegisterAction(tag, action)\n}\n}\n")
+}
+
+// simpleType emits a named simple type, enumerations become typed constants
+func (gen *generator) simpleType(name string, node *etree.Element) {
+	name = exportName(name)
+	if name == "" || gen.declared[name] {
+		return
+	}
+	gen.declared[name] = true
+
+	base, list := "xsd.String", false
+	var values []string
+	if restriction := node.SelectElement("restriction"); restriction != nil {
+		base = gen.goType(restriction.SelectAttrValue("base", "xs:string"))
+		for _, enum := range restriction.SelectElements("enumeration") {
+			values = append(values, enum.SelectAttrValue("value", ""))
+		}
+	} else if node.SelectElement("list") != nil {
+		list = true
+		gen.imports["github.com/sonnt85/gonvif/xsd"] = true
+	} else {
+		gen.imports["github.com/sonnt85/gonvif/xsd"] = true
+	}
+
+	gen.comment(name, node)
+	if list {
+		gen.printf("// The value is a space separated list.\n")
+	}
+	gen.printf("type %s %s\n\n", name, base)
+	if len(values) == 0 {
+		return
+	}
+
+	quote := gen.isStringType(base)
+	used := make(map[string]bool)
+	gen.printf("const (\n")
+	for _, value := range values {
+		constant := name + exportName(value)
+		for i := 2; used[constant] || gen.declared[constant]; i++ {
+			constant = name + exportName(value) + strconv.Itoa(i)
+		}
+		used[constant] = true
+		literal := value
+		if quote {
+			literal = strconv.Quote(value)
+		}
+		gen.printf("%s %s = %s\n", constant, name, literal)
+	}
+	gen.printf(")\n\n")
+}
+
+// complexType emits a struct. Tagged structs (requests and WSDL types) name
+// their elements with the service prefix so they can be encoded, responses
+// are only decoded and keep untagged fields
+func (gen *generator) complexType(name string, node *etree.Element, tagged, request bool) {
+	goName := exportName(name)
+	if goName == "" || gen.declared[goName] {
+		return
+	}
+	gen.declared[goName] = true
+	gen.structs[name] = true
+
+	gen.comment(goName, node)
+	gen.printf("type %s struct {\n", goName)
+	if request {
+		gen.printf("XMLName string `xml:\"%s:%s\"`\n", gen.prefix, name)
+	}
+	gen.fields(goName, node, tagged, false)
+	gen.printf("}\n\n")
+}
+
+// typedElement emits the struct of a request or response element declared
+// with type="…", the named type is embedded so that operations sharing a type
+// still get a struct of their own
+func (gen *generator) typedElement(name string, node *etree.Element) {
+	goName := exportName(name)
+	if goName == "" || gen.declared[goName] {
+		return
+	}
+	gen.declared[goName] = true
+	gen.structs[name] = true
+
+	typ := gen.goType(node.SelectAttrValue("type", "xs:anyType"))
+	gen.comment(goName, node)
+	gen.printf("type %s struct {\n", goName)
+	if gen.requests[name] {
+		gen.printf("XMLName string `xml:\"%s:%s\"`\n", gen.prefix, name)
+	}
+	if gen.isStruct(typ) {
+		gen.printf("%s\n", typ)
+	} else {
+		gen.printf("Value %s `xml:\",chardata\"`\n", typ)
+	}
+	gen.printf("}\n\n")
+}
+
+// fields emits the fields of the content model of node
+func (gen *generator) fields(owner string, node *etree.Element, tagged, optional bool) {
+	for _, child := range node.ChildElements() {
+		switch child.Tag {
+		case "sequence", "all":
+			gen.fields(owner, child, tagged, optional || child.SelectAttrValue("minOccurs", "1") == "0")
+		case "choice":
+			gen.fields(owner, child, tagged, true)
+		case "complexContent", "simpleContent":
+			gen.fields(owner, child, tagged, optional)
+		case "extension":
+			base := gen.goType(child.SelectAttrValue("base", ""))
+			if node.Tag == "simpleContent" {
+				gen.printf("Value %s `xml:\",chardata\"`\n", base)
+			} else {
+				gen.printf("%s\n", base)
+			}
+			gen.fields(owner, child, tagged, optional)
+		case "element":
+			gen.element(owner, child, tagged, optional)
+		case "attribute":
+			gen.attribute(child)
+		}
+	}
+}
+
+// element emits the field of a child element
+func (gen *generator) element(owner string, node *etree.Element, tagged, optional bool) {
+	name, typ := node.SelectAttrValue("name", ""), ""
+	switch {
+	case name == "":
+		ref := node.SelectAttrValue("ref", "")
+		name = localName(ref)
+		if gen.namespaceOf(ref) == gen.namespace && gen.localStructs[name] {
+			typ = exportName(name)
+		} else {
+			typ = gen.goType(ref)
+		}
+	case node.SelectAttr("type") != nil:
+		typ = gen.goType(node.SelectAttrValue("type", ""))
+	case node.SelectElement("complexType") != nil:
+		typ = owner + exportName(name)
+		gen.localStructs[typ] = true
+		gen.pending = append(gen.pending, pendingType{name: typ, node: node.SelectElement("complexType"), tagged: tagged})
+	case node.SelectElement("simpleType") != nil:
+		if restriction := node.SelectElement("simpleType").SelectElement("restriction"); restriction != nil {
+			typ = gen.goType(restriction.SelectAttrValue("base", "xs:string"))
+		} else {
+			typ = gen.goType("xs:string")
+		}
+	default:
+		typ = gen.goType("xs:anyType")
+	}
+	if name == "" {
+		return
+	}
+
+	minOccurs, maxOccurs := node.SelectAttrValue("minOccurs", "1"), node.SelectAttrValue("maxOccurs", "1")
+	optional = optional || minOccurs == "0"
+	repeated := maxOccurs != "1" && maxOccurs != "0"
+
+	fieldType, omitempty := typ, false
+	switch {
+	case repeated:
+		fieldType = "[]" + typ
+	case optional && (gen.isStruct(typ) || gen.isBool(typ)):
+		// omitempty does not omit structs and would drop an explicit false
+		fieldType, omitempty = "*"+typ, true
+	case optional:
+		omitempty = true
+	}
+
+	fieldName, tag := exportName(name), ""
+	if tagged {
+		tag = gen.prefix + ":" + name
+		if omitempty {
+			tag += ",omitempty"
+		}
+	} else if fieldName != name {
+		tag = name
+	}
+	if tag != "" {
+		gen.printf("%s %s `xml:\"%s\"`\n", fieldName, fieldType, tag)
+	} else {
+		gen.printf("%s %s\n", fieldName, fieldType)
+	}
+}
+
+// attribute emits the field of an attribute
+func (gen *generator) attribute(node *etree.Element) {
+	name := node.SelectAttrValue("name", "")
+	if name == "" {
+		return
+	}
+	typ := gen.goType(node.SelectAttrValue("type", "xs:string"))
+	tag := name + ",attr"
+	if node.SelectAttrValue("use", "optional") != "required" {
+		tag += ",omitempty"
+	}
+	gen.printf("%s %s `xml:\"%s\"`\n", exportName(name), typ, tag)
+}
+
+// comment emits the first sentence of the schema documentation of node
+func (gen *generator) comment(name string, node *etree.Element) {
+	doc := node.FindElement("./annotation/documentation")
+	if doc == nil && node.Parent() != nil && node.Parent().Tag == "element" {
+		doc = node.Parent().FindElement("./annotation/documentation")
+	}
+	if doc == nil {
+		return
+	}
+	text := strings.Join(strings.Fields(doc.Text()), " ")
+	if i := strings.Index(text, ". "); i >= 0 {
+		text = text[:i]
+	}
+	text = strings.TrimSuffix(text, ".")
+	if text == "" {
+		return
+	}
+	runes := []rune(text)
+	if len(runes) > 1 && !unicode.IsUpper(runes[1]) {
+		runes[0] = unicode.ToLower(runes[0])
+	}
+	gen.printf("// %s %s\n", name, string(runes))
+}
+
+// namespaceOf returns the namespace of a prefixed name
+func (gen *generator) namespaceOf(qname string) string {
+	if i := strings.Index(qname, ":"); i >= 0 {
+		return gen.namespaces[qname[:i]]
+	}
+	return gen.namespace
+}
+
+// goType maps a schema type name to a Go type, unknown types become xsd.AnyType
+func (gen *generator) goType(qname string) string {
+	name := localName(qname)
+	switch gen.namespaceOf(qname) {
+	case xmlSchemaNamespace:
+		if _, ok := gen.xsdTypes[exportName(name)]; ok {
+			gen.imports["github.com/sonnt85/gonvif/xsd"] = true
+			return "xsd." + exportName(name)
+		}
+	case gen.namespace:
+		return exportName(name)
+	case onvifNamespace:
+		if _, ok := gen.onvifTypes[name]; ok {
+			gen.imports["github.com/sonnt85/gonvif/xsd/onvif"] = true
+			return "onvif." + name
+		}
+	}
+	gen.imports["github.com/sonnt85/gonvif/xsd"] = true
+	return "xsd.AnyType"
+}
+
+// isStruct reports whether the Go type is a struct, optional structs are pointers
+func (gen *generator) isStruct(typ string) bool {
+	switch {
+	case strings.HasPrefix(typ, "onvif."):
+		return resolvesToStruct(gen.onvifTypes, strings.TrimPrefix(typ, "onvif."))
+	case strings.HasPrefix(typ, "xsd."):
+		return false
+	}
+	return gen.localStructs[typ] || gen.localStructs[localName(typ)]
+}
+
+// isBool reports whether the Go type is a boolean
+func (gen *generator) isBool(typ string) bool {
+	return gen.underlying(typ) == "bool"
+}
+
+// isStringType reports whether enumeration values of typ are quoted
+func (gen *generator) isStringType(typ string) bool {
+	switch gen.underlying(typ) {
+	case "bool", "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
+		return false
+	}
+	return true
+}
+
+// underlying returns the predeclared type under an xsd or onvif type, "" when unknown
+func (gen *generator) underlying(typ string) string {
+	types, name := gen.xsdTypes, strings.TrimPrefix(typ, "xsd.")
+	if strings.HasPrefix(typ, "onvif.") {
+		types, name = gen.onvifTypes, strings.TrimPrefix(typ, "onvif.")
+	}
+	for i := 0; i < 16; i++ {
+		switch e := types[name].(type) {
+		case *ast.Ident:
+			if ast.IsExported(e.Name) {
+				name = e.Name
+				continue
+			}
+			return e.Name
+		case *ast.SelectorExpr:
+			if x, ok := e.X.(*ast.Ident); ok && x.Name == "xsd" {
+				types = gen.xsdTypes
+			}
+			name = e.Sel.Name
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+// resolvesToStruct follows type aliases of types up to their underlying type
+func resolvesToStruct(types map[string]ast.Expr, name string) bool {
+	for i := 0; i < 16; i++ {
+		switch e := types[name].(type) {
+		case *ast.StructType:
+			return true
+		case *ast.Ident:
+			name = e.Name
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// localName strips the prefix of a qualified name
+func localName(qname string) string {
+	if i := strings.LastIndex(qname, ":"); i >= 0 {
+		return qname[i+1:]
+	}
+	return qname
+}
+
+// exportName turns a schema name or enumeration value into an exported Go identifier
+func exportName(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	s := b.String()
+	if s != "" && unicode.IsDigit([]rune(s)[0]) {
+		s = "V" + s
+	}
+	return s
+}
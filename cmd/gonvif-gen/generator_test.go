@@ -0,0 +1,108 @@
+package main
+
+import (
+	"go/format"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func generateFrom(t *testing.T, wsdl string) *generator {
+	gen, err := newGenerator(filepath.Join("..", "..", "docs", "wsdl", wsdl), "", filepath.Join("..", "..", "xsd", "onvif"), filepath.Join("..", "..", "xsd"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gen
+}
+
+// normalize formats src and collapses white space so that expectations do not depend on alignment
+func normalize(t *testing.T, src []byte) string {
+	formatted, err := format.Source(src)
+	if err != nil {
+		t.Fatalf("generated source does not parse: %v\n%s", err, src)
+	}
+	return strings.Join(strings.Fields(string(formatted)), " ")
+}
+
+func TestGenerateImaging(t *testing.T) {
+	gen := generateFrom(t, "imaging.wsdl")
+	src, err := gen.generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := normalize(t, src)
+
+	for _, want := range []string{
+		"package timg",
+		`const Namespace = "http://www.onvif.org/ver20/imaging/wsdl"`,
+		"type GetImagingSettings struct { XMLName string `xml:\"timg:GetImagingSettings\"` VideoSourceToken onvif.ReferenceToken `xml:\"timg:VideoSourceToken\"` }",
+		"type GetImagingSettingsResponse struct { ImagingSettings onvif.ImagingSettings20 }",
+		"ForcePersistence *xsd.Boolean `xml:\"timg:ForcePersistence,omitempty\"`",
+		"type GetPresetsResponse struct { Preset []ImagingPreset }",
+		`ImagingPresetTypeSunsetRear ImagingPresetType = "Sunset(Rear)"`,
+		`"timg:GetImagingSettings": "http://www.onvif.org/ver20/imaging/wsdl/GetImagingSettings"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %s", want)
+		}
+	}
+
+	gen.pkg = "imaging"
+	registry, err := gen.generateRegistry("imaging", "github.com/sonnt85/gonvif/Imaging")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out = normalize(t, registry)
+	for _, want := range []string{
+		`import imaging "github.com/sonnt85/gonvif/Imaging"`,
+		`GetOnvifStruct["imaging"] = map[string]interface{}{`,
+		`"GetPresetsResponse": &imaging.GetPresetsResponse{}`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %s", want)
+		}
+	}
+}
+
+func TestGenerateAddressingActions(t *testing.T) {
+	src, err := generateFrom(t, "event.wsdl").generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := normalize(t, src)
+	want := `"tev:CreatePullPointSubscription": "http://www.onvif.org/ver10/events/wsdl/EventPortType/CreatePullPointSubscriptionRequest"`
+	if !strings.Contains(out, want) {
+		t.Errorf("missing %s", want)
+	}
+}
+
+func TestGenerateTypedElements(t *testing.T) {
+	gen := generateFrom(t, "media2.wsdl")
+	src, err := gen.generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := normalize(t, src)
+
+	for _, want := range []string{
+		"type GetVideoEncoderConfigurations struct { XMLName string `xml:\"tr2:GetVideoEncoderConfigurations\"` GetConfiguration }",
+		"type StartMulticastStreaming struct { XMLName string `xml:\"tr2:StartMulticastStreaming\"` StartStopMulticastStreaming }",
+		"type SetOSDResponse struct { SetConfigurationResponse }",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %s", want)
+		}
+	}
+	// elements that are not part of an operation are left to their type
+	if strings.Contains(out, "type Capabilities struct") {
+		t.Error("unexpected struct for the Capabilities element")
+	}
+
+	registry, err := gen.generateRegistry("media2", "github.com/sonnt85/gonvif/media2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out := normalize(t, registry); !strings.Contains(out, `"GetVideoEncoderConfigurations": &tr2.GetVideoEncoderConfigurations{}`) {
+		t.Error("typed request element is not registered")
+	}
+}
@@ -0,0 +1,90 @@
+// Command gonvif-gen generates a service package from an ONVIF WSDL.
+//
+// It reads the schema embedded in the WSDL and emits the request and response
+// structs with their namespace prefix, slices for repeated elements, pointers
+// or omitempty for optional ones, typed constants for enumerations and the
+// WS-Addressing action of every operation. Types of the ONVIF schema (tt:) are
+// taken from xsd/onvif. Optionally it also emits the onvifutils.GetOnvifStruct
+// registry entries of the service.
+//
+// Run it from the module root:
+//
+//	go run ./cmd/gonvif-gen -wsdl docs/wsdl/thermal.wsdl -pkg thermal -o thermal/types.go \
+//		-import github.com/sonnt85/gonvif/thermal -registry onvifutils/thermal.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	wsdl := flag.String("wsdl", "", "WSDL file to generate from")
+	pkg := flag.String("pkg", "", "name of the generated package, the WSDL namespace prefix by default")
+	prefix := flag.String("prefix", "", "namespace prefix of the request elements, as declared in the WSDL by default")
+	out := flag.String("o", "", "output file, stdout when empty")
+	onvifDir := flag.String("onvif", filepath.Join("xsd", "onvif"), "directory of the onvif schema package")
+	xsdDir := flag.String("xsd", "xsd", "directory of the xsd built-in types package")
+	registry := flag.String("registry", "", "write the onvifutils.GetOnvifStruct entries of the service to this file")
+	importPath := flag.String("import", "", "import path of the generated package, needed by -registry")
+	service := flag.String("service", "", "registry key of the service, the package name by default")
+	flag.Parse()
+
+	if *wsdl == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	gen, err := newGenerator(*wsdl, *prefix, *onvifDir, *xsdDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *pkg != "" {
+		gen.pkg = *pkg
+	}
+
+	src, err := gen.generate()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := write(*out, src); err != nil {
+		log.Fatal(err)
+	}
+
+	if *registry != "" {
+		if *importPath == "" {
+			log.Fatal("-registry needs -import")
+		}
+		key := *service
+		if key == "" {
+			key = gen.pkg
+		}
+		src, err := gen.generateRegistry(key, *importPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := write(*registry, src); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// write formats src and writes it to name, or to stdout when name is empty
+func write(name string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%v\n%s", err, src)
+	}
+	if name == "" {
+		_, err = os.Stdout.Write(formatted)
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(name, formatted, 0o644)
+}
@@ -0,0 +1,299 @@
+// Code generated by gonvif-gen from credential.wsdl. DO NOT EDIT.
+
+package credential
+
+import (
+	"github.com/sonnt85/gonvif/gosoap"
+	"github.com/sonnt85/gonvif/xsd"
+)
+
+// Namespace of the credential service
+const Namespace = "http://www.onvif.org/ver10/credential/wsdl"
+
+// Prefix of the credential service elements
+const Prefix = "tcr"
+
+// ServiceCapabilities the service capabilities reflect optional functionality of a service
+type ServiceCapabilities struct {
+	SupportedIdentifierType                  []xsd.AnyType                 `xml:"tcr:SupportedIdentifierType"`
+	Extension                                *ServiceCapabilitiesExtension `xml:"tcr:Extension,omitempty"`
+	MaxLimit                                 xsd.AnyType                   `xml:"MaxLimit,attr"`
+	CredentialValiditySupported              xsd.Boolean                   `xml:"CredentialValiditySupported,attr"`
+	CredentialAccessProfileValiditySupported xsd.Boolean                   `xml:"CredentialAccessProfileValiditySupported,attr"`
+	ValiditySupportsTimeValue                xsd.Boolean                   `xml:"ValiditySupportsTimeValue,attr"`
+	MaxCredentials                           xsd.AnyType                   `xml:"MaxCredentials,attr"`
+	MaxAccessProfilesPerCredential           xsd.AnyType                   `xml:"MaxAccessProfilesPerCredential,attr"`
+	ResetAntipassbackSupported               xsd.Boolean                   `xml:"ResetAntipassbackSupported,attr"`
+}
+
+type ServiceCapabilitiesExtension struct {
+	SupportedExemptionType []xsd.AnyType `xml:"tcr:SupportedExemptionType"`
+}
+
+// CredentialInfo the CredentialInfo type represents the credential as a logical object
+type CredentialInfo struct {
+	xsd.AnyType
+	Description               xsd.AnyType  `xml:"tcr:Description,omitempty"`
+	CredentialHolderReference xsd.String   `xml:"tcr:CredentialHolderReference"`
+	ValidFrom                 xsd.DateTime `xml:"tcr:ValidFrom,omitempty"`
+	ValidTo                   xsd.DateTime `xml:"tcr:ValidTo,omitempty"`
+}
+
+// Credential a Credential is a physical/tangible object, a piece of knowledge, or a facet of a person's physical being, that enables an individual access to a given physical facility or computer-based information system
+type Credential struct {
+	CredentialInfo
+	CredentialIdentifier    []CredentialIdentifier    `xml:"tcr:CredentialIdentifier"`
+	CredentialAccessProfile []CredentialAccessProfile `xml:"tcr:CredentialAccessProfile"`
+	Attribute               []xsd.AnyType             `xml:"tcr:Attribute"`
+	Extension               *CredentialExtension      `xml:"tcr:Extension,omitempty"`
+}
+
+type CredentialExtension struct {
+}
+
+// CredentialIdentifier a credential identifier is a card number, unique card information, PIN or biometric information such as fingerprint, iris, vein, face recognition, that can be validated in an access point
+type CredentialIdentifier struct {
+	Type                       CredentialIdentifierType `xml:"tcr:Type"`
+	ExemptedFromAuthentication xsd.Boolean              `xml:"tcr:ExemptedFromAuthentication"`
+	Value                      xsd.HexBinary            `xml:"tcr:Value"`
+}
+
+// CredentialIdentifierType specifies the name of credential identifier type and its format for the credential value
+type CredentialIdentifierType struct {
+	Name       xsd.AnyType `xml:"tcr:Name"`
+	FormatType xsd.String  `xml:"tcr:FormatType"`
+}
+
+// CredentialAccessProfile the association between a credential and an access profile
+type CredentialAccessProfile struct {
+	AccessProfileToken xsd.AnyType  `xml:"tcr:AccessProfileToken"`
+	ValidFrom          xsd.DateTime `xml:"tcr:ValidFrom,omitempty"`
+	ValidTo            xsd.DateTime `xml:"tcr:ValidTo,omitempty"`
+}
+
+// CredentialState the CredentialState structure contains information about the state of the credential and optionally the reason of why the credential was disabled
+type CredentialState struct {
+	Enabled           xsd.Boolean               `xml:"tcr:Enabled"`
+	Reason            xsd.AnyType               `xml:"tcr:Reason,omitempty"`
+	AntipassbackState *AntipassbackState        `xml:"tcr:AntipassbackState,omitempty"`
+	Extension         *CredentialStateExtension `xml:"tcr:Extension,omitempty"`
+}
+
+type CredentialStateExtension struct {
+}
+
+// AntipassbackState a structure containing anti-passback related state information
+type AntipassbackState struct {
+	AntipassbackViolated xsd.Boolean `xml:"tcr:AntipassbackViolated"`
+}
+
+// CredentialIdentifierFormatTypeInfo contains information about a format type
+type CredentialIdentifierFormatTypeInfo struct {
+	FormatType  xsd.String                                   `xml:"tcr:FormatType"`
+	Description xsd.AnyType                                  `xml:"tcr:Description"`
+	Extension   *CredentialIdentifierFormatTypeInfoExtension `xml:"tcr:Extension,omitempty"`
+}
+
+type CredentialIdentifierFormatTypeInfoExtension struct {
+}
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tcr:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities ServiceCapabilities
+}
+
+type GetSupportedFormatTypes struct {
+	XMLName                      string     `xml:"tcr:GetSupportedFormatTypes"`
+	CredentialIdentifierTypeName xsd.String `xml:"tcr:CredentialIdentifierTypeName"`
+}
+
+type GetSupportedFormatTypesResponse struct {
+	FormatTypeInfo []CredentialIdentifierFormatTypeInfo
+}
+
+type GetCredentialInfo struct {
+	XMLName string        `xml:"tcr:GetCredentialInfo"`
+	Token   []xsd.AnyType `xml:"tcr:Token"`
+}
+
+type GetCredentialInfoResponse struct {
+	CredentialInfo []CredentialInfo
+}
+
+type GetCredentialInfoList struct {
+	XMLName        string     `xml:"tcr:GetCredentialInfoList"`
+	Limit          xsd.Int    `xml:"tcr:Limit,omitempty"`
+	StartReference xsd.String `xml:"tcr:StartReference,omitempty"`
+}
+
+type GetCredentialInfoListResponse struct {
+	NextStartReference xsd.String
+	CredentialInfo     []CredentialInfo
+}
+
+type GetCredentials struct {
+	XMLName string        `xml:"tcr:GetCredentials"`
+	Token   []xsd.AnyType `xml:"tcr:Token"`
+}
+
+type GetCredentialsResponse struct {
+	Credential []Credential
+}
+
+type GetCredentialList struct {
+	XMLName        string     `xml:"tcr:GetCredentialList"`
+	Limit          xsd.Int    `xml:"tcr:Limit,omitempty"`
+	StartReference xsd.String `xml:"tcr:StartReference,omitempty"`
+}
+
+type GetCredentialListResponse struct {
+	NextStartReference xsd.String
+	Credential         []Credential
+}
+
+type CreateCredential struct {
+	XMLName    string          `xml:"tcr:CreateCredential"`
+	Credential Credential      `xml:"tcr:Credential"`
+	State      CredentialState `xml:"tcr:State"`
+}
+
+type CreateCredentialResponse struct {
+	Token xsd.AnyType
+}
+
+type ModifyCredential struct {
+	XMLName    string     `xml:"tcr:ModifyCredential"`
+	Credential Credential `xml:"tcr:Credential"`
+}
+
+type ModifyCredentialResponse struct {
+}
+
+type DeleteCredential struct {
+	XMLName string      `xml:"tcr:DeleteCredential"`
+	Token   xsd.AnyType `xml:"tcr:Token"`
+}
+
+type DeleteCredentialResponse struct {
+}
+
+type GetCredentialState struct {
+	XMLName string      `xml:"tcr:GetCredentialState"`
+	Token   xsd.AnyType `xml:"tcr:Token"`
+}
+
+type GetCredentialStateResponse struct {
+	State CredentialState
+}
+
+type EnableCredential struct {
+	XMLName string      `xml:"tcr:EnableCredential"`
+	Token   xsd.AnyType `xml:"tcr:Token"`
+	Reason  xsd.AnyType `xml:"tcr:Reason,omitempty"`
+}
+
+type EnableCredentialResponse struct {
+}
+
+type DisableCredential struct {
+	XMLName string      `xml:"tcr:DisableCredential"`
+	Token   xsd.AnyType `xml:"tcr:Token"`
+	Reason  xsd.AnyType `xml:"tcr:Reason,omitempty"`
+}
+
+type DisableCredentialResponse struct {
+}
+
+type ResetAntipassbackViolation struct {
+	XMLName         string      `xml:"tcr:ResetAntipassbackViolation"`
+	CredentialToken xsd.AnyType `xml:"tcr:CredentialToken"`
+}
+
+type ResetAntipassbackViolationResponse struct {
+}
+
+type GetCredentialIdentifiers struct {
+	XMLName         string      `xml:"tcr:GetCredentialIdentifiers"`
+	CredentialToken xsd.AnyType `xml:"tcr:CredentialToken"`
+}
+
+type GetCredentialIdentifiersResponse struct {
+	CredentialIdentifier []CredentialIdentifier
+}
+
+type SetCredentialIdentifier struct {
+	XMLName              string               `xml:"tcr:SetCredentialIdentifier"`
+	CredentialToken      xsd.AnyType          `xml:"tcr:CredentialToken"`
+	CredentialIdentifier CredentialIdentifier `xml:"tcr:CredentialIdentifier"`
+}
+
+type SetCredentialIdentifierResponse struct {
+}
+
+type DeleteCredentialIdentifier struct {
+	XMLName                      string      `xml:"tcr:DeleteCredentialIdentifier"`
+	CredentialToken              xsd.AnyType `xml:"tcr:CredentialToken"`
+	CredentialIdentifierTypeName xsd.AnyType `xml:"tcr:CredentialIdentifierTypeName"`
+}
+
+type DeleteCredentialIdentifierResponse struct {
+}
+
+type GetCredentialAccessProfiles struct {
+	XMLName         string      `xml:"tcr:GetCredentialAccessProfiles"`
+	CredentialToken xsd.AnyType `xml:"tcr:CredentialToken"`
+}
+
+type GetCredentialAccessProfilesResponse struct {
+	CredentialAccessProfile []CredentialAccessProfile
+}
+
+type SetCredentialAccessProfiles struct {
+	XMLName                 string                    `xml:"tcr:SetCredentialAccessProfiles"`
+	CredentialToken         xsd.AnyType               `xml:"tcr:CredentialToken"`
+	CredentialAccessProfile []CredentialAccessProfile `xml:"tcr:CredentialAccessProfile"`
+}
+
+type SetCredentialAccessProfilesResponse struct {
+}
+
+type DeleteCredentialAccessProfiles struct {
+	XMLName            string        `xml:"tcr:DeleteCredentialAccessProfiles"`
+	CredentialToken    xsd.AnyType   `xml:"tcr:CredentialToken"`
+	AccessProfileToken []xsd.AnyType `xml:"tcr:AccessProfileToken"`
+}
+
+type DeleteCredentialAccessProfilesResponse struct {
+}
+
+// Actions maps the request elements of the service to their WS-Addressing action
+var Actions = map[string]string{
+	"tcr:GetServiceCapabilities":         "http://www.onvif.org/ver10/credential/wsdl/GetServiceCapabilities",
+	"tcr:GetSupportedFormatTypes":        "http://www.onvif.org/ver10/credential/wsdl/GetSupportedFormatTypes",
+	"tcr:GetCredentialInfo":              "http://www.onvif.org/ver10/credential/wsdl/GetCredentialInfo",
+	"tcr:GetCredentialInfoList":          "http://www.onvif.org/ver10/credential/wsdl/GetCredentialInfoList",
+	"tcr:GetCredentials":                 "http://www.onvif.org/ver10/credential/wsdl/GetCredentials",
+	"tcr:GetCredentialList":              "http://www.onvif.org/ver10/credential/wsdl/GetCredentialList",
+	"tcr:CreateCredential":               "http://www.onvif.org/ver10/credential/wsdl/CreateCredential",
+	"tcr:ModifyCredential":               "http://www.onvif.org/ver10/credential/wsdl/ModifyCredential",
+	"tcr:DeleteCredential":               "http://www.onvif.org/ver10/credential/wsdl/DeleteCredential",
+	"tcr:GetCredentialState":             "http://www.onvif.org/ver10/credential/wsdl/GetCredentialState",
+	"tcr:EnableCredential":               "http://www.onvif.org/ver10/credential/wsdl/EnableCredential",
+	"tcr:DisableCredential":              "http://www.onvif.org/ver10/credential/wsdl/DisableCredential",
+	"tcr:ResetAntipassbackViolation":     "http://www.onvif.org/ver10/credential/wsdl/ResetAntipassbackViolation",
+	"tcr:GetCredentialIdentifiers":       "http://www.onvif.org/ver10/credential/wsdl/GetCredentialIdentifiers",
+	"tcr:SetCredentialIdentifier":        "http://www.onvif.org/ver10/credential/wsdl/SetCredentialIdentifier",
+	"tcr:DeleteCredentialIdentifier":     "http://www.onvif.org/ver10/credential/wsdl/DeleteCredentialIdentifier",
+	"tcr:GetCredentialAccessProfiles":    "http://www.onvif.org/ver10/credential/wsdl/GetCredentialAccessProfiles",
+	"tcr:SetCredentialAccessProfiles":    "http://www.onvif.org/ver10/credential/wsdl/SetCredentialAccessProfiles",
+	"tcr:DeleteCredentialAccessProfiles": "http://www.onvif.org/ver10/credential/wsdl/DeleteCredentialAccessProfiles",
+}
+
+func init() {
+	for tag, action := range Actions {
+		gosoap.RegisterAction(tag, action)
+	}
+}
@@ -0,0 +1,281 @@
+package gonvif
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/sonnt85/gonvif/gosoap"
+)
+
+// CallMethodUnmarshal calls method and decodes the body of the answer into
+// response, a pointer to the matching <Method>Response struct. SOAP faults are
+// returned as *gosoap.Fault
+func (dev Device) CallMethodUnmarshal(ctx context.Context, method, response interface{}) error {
+	resp, err := dev.CallMethodContext(ctx, method)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		if fault := gosoap.ParseFault(data); fault != nil {
+			return fault
+		}
+		return errors.New("unexpected response status " + resp.Status)
+	}
+	return UnmarshalResponse(data, response)
+}
+
+// UnmarshalResponse decodes the first element of the body of a SOAP envelope
+// into response.
+//
+// Unlike encoding/xml it matches elements and attributes by local name, so the
+// prefixed tags of the schema types (xml:"onvif:Name") decode whatever prefix
+// the device chose for the namespace
+func UnmarshalResponse(data []byte, response interface{}) error {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		return err
+	}
+	body := doc.FindElement("./Envelope/Body")
+	if body == nil {
+		return errors.New("no SOAP body in response")
+	}
+	children := body.ChildElements()
+	if len(children) == 0 {
+		return errors.New("empty SOAP body in response")
+	}
+	if children[0].Tag == "Fault" {
+		if fault := gosoap.ParseFault(data); fault != nil {
+			return fault
+		}
+	}
+	return UnmarshalElement(children[0], response)
+}
+
+// UnmarshalElement decodes el into v, a non nil pointer, matching names
+// without their namespace prefix
+func UnmarshalElement(el *etree.Element, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("unmarshal needs a non nil pointer")
+	}
+	return decodeElement(el, rv.Elem())
+}
+
+// fieldTag is the parsed xml tag of a struct field
+type fieldTag struct {
+	name     string
+	attr     bool
+	chardata bool
+	skip     bool
+}
+
+func parseFieldTag(field reflect.StructField) fieldTag {
+	tag, ok := field.Tag.Lookup("xml")
+	if !ok {
+		return fieldTag{name: field.Name}
+	}
+	if tag == "-" {
+		return fieldTag{skip: true}
+	}
+	parts := strings.Split(tag, ",")
+	ft := fieldTag{name: localName(parts[0])}
+	for _, flag := range parts[1:] {
+		switch flag {
+		case "attr":
+			ft.attr = true
+		case "chardata":
+			ft.chardata = true
+		case "innerxml", "comment", "any":
+			ft.skip = true
+		}
+	}
+	if ft.name == "" {
+		ft.name = field.Name
+	}
+	return ft
+}
+
+// localName strips the namespace prefix of a name
+func localName(name string) string {
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+func decodeElement(el *etree.Element, v reflect.Value) error {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	// list types are also sent as the text of an element, e.g. BitrateList
+	if v.Kind() != reflect.Struct || isListWrapper(v.Type()) && len(el.ChildElements()) == 0 {
+		return setValue(v, el.Text())
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" || field.Name == "XMLName" {
+			continue
+		}
+		fv := v.Field(i)
+		// embedded structs hold fields of the same element
+		if field.Anonymous && indirectType(field.Type).Kind() == reflect.Struct {
+			if err := decodeElement(el, fv); err != nil {
+				return err
+			}
+			continue
+		}
+		tag := parseFieldTag(field)
+		switch {
+		case tag.skip:
+		case tag.attr:
+			for _, attr := range el.Attr {
+				if attr.Key == tag.name {
+					if err := setValue(fv, attr.Value); err != nil {
+						return fmt.Errorf("%s.%s: %v", t.Name(), field.Name, err)
+					}
+					break
+				}
+			}
+		case tag.chardata:
+			if err := setValue(fv, el.Text()); err != nil {
+				return fmt.Errorf("%s.%s: %v", t.Name(), field.Name, err)
+			}
+		default:
+			if err := decodeChildren(el, tag.name, fv); err != nil {
+				return fmt.Errorf("%s.%s: %v", t.Name(), field.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// decodeChildren decodes the child elements named name into v, appending
+// every match to slices and keeping the first one otherwise
+func decodeChildren(el *etree.Element, name string, v reflect.Value) error {
+	isSlice := v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8
+	for _, child := range el.ChildElements() {
+		if child.Tag != name {
+			continue
+		}
+		if !isSlice {
+			return decodeElement(child, v)
+		}
+		item := reflect.New(v.Type().Elem()).Elem()
+		if err := decodeElement(child, item); err != nil {
+			return err
+		}
+		v.Set(reflect.Append(v, item))
+	}
+	return nil
+}
+
+// setValue parses the text of an element or attribute into v
+func setValue(v reflect.Value, text string) error {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	text = strings.TrimSpace(text)
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(text)
+	case reflect.Bool:
+		if text == "" {
+			return nil
+		}
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if text == "" {
+			return nil
+		}
+		n, err := strconv.ParseInt(text, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if text == "" {
+			return nil
+		}
+		n, err := strconv.ParseUint(text, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		if text == "" {
+			return nil
+		}
+		f, err := strconv.ParseFloat(text, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes([]byte(text))
+			return nil
+		}
+		// list types, e.g. IntAttrList: white space separated items
+		for _, item := range strings.Fields(text) {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if err := setValue(elem, item); err != nil {
+				return err
+			}
+			v.Set(reflect.Append(v, elem))
+		}
+	case reflect.Struct:
+		// attribute lists wrapping a single slice field, e.g. onvif.IntAttrList
+		if isListWrapper(v.Type()) {
+			return setValue(v.Field(0), text)
+		}
+	}
+	return nil
+}
+
+// isListWrapper reports whether t wraps a single list, like onvif.IntAttrList
+func isListWrapper(t reflect.Type) bool {
+	if t.NumField() != 1 {
+		return false
+	}
+	field := t.Field(0)
+	_, tagged := field.Tag.Lookup("xml")
+	if field.PkgPath != "" || tagged || field.Type.Kind() != reflect.Slice {
+		return false
+	}
+	switch field.Type.Elem().Kind() {
+	case reflect.Struct, reflect.Ptr, reflect.Slice, reflect.Interface:
+		return false
+	}
+	return true
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
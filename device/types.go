@@ -6,10 +6,10 @@ import (
 )
 
 type Service struct {
-	Namespace xsd.AnyURI
-	XAddr     xsd.AnyURI
-	Capabilities
-	Version onvif.OnvifVersion
+	Namespace    xsd.AnyURI
+	XAddr        xsd.AnyURI
+	Capabilities *Capabilities `xml:"Capabilities,omitempty"`
+	Version      onvif.OnvifVersion
 }
 
 type Capabilities struct {
@@ -20,7 +20,7 @@ type DeviceServiceCapabilities struct {
 	Network  NetworkCapabilities
 	Security SecurityCapabilities
 	System   SystemCapabilities
-	Misc     MiscCapabilities
+	Misc     *MiscCapabilities `xml:"Misc,omitempty"`
 }
 
 type NetworkCapabilities struct {
@@ -87,17 +87,17 @@ type StorageConfiguration struct {
 }
 
 type StorageConfigurationData struct {
-	Type       xsd.String     `xml:"type,attr"`
-	LocalPath  xsd.AnyURI     `xml:"tds:LocalPath"`
-	StorageUri xsd.AnyURI     `xml:"tds:StorageUri"`
-	User       UserCredential `xml:"tds:User"`
-	Extension  xsd.AnyURI     `xml:"tds:Extension"`
+	Type       xsd.String      `xml:"type,attr"`
+	LocalPath  xsd.AnyURI      `xml:"tds:LocalPath,omitempty"`
+	StorageUri xsd.AnyURI      `xml:"tds:StorageUri,omitempty"`
+	User       *UserCredential `xml:"tds:User,omitempty"`
+	Extension  xsd.AnyURI      `xml:"tds:Extension,omitempty"`
 }
 
 type UserCredential struct {
 	UserName  xsd.String  `xml:"tds:UserName"`
-	Password  xsd.String  `xml:"tds:Password"`
-	Extension xsd.AnyType `xml:"tds:Extension"`
+	Password  xsd.String  `xml:"tds:Password,omitempty"`
+	Extension xsd.AnyType `xml:"tds:Extension,omitempty"`
 }
 
 //Device main types
@@ -108,7 +108,7 @@ type GetServices struct {
 }
 
 type GetServicesResponse struct {
-	Service Service
+	Service []Service
 }
 
 type GetServiceCapabilities struct {
@@ -135,8 +135,8 @@ type SetSystemDateAndTime struct {
 	XMLName         string                `xml:"tds:SetSystemDateAndTime"`
 	DateTimeType    onvif.SetDateTimeType `xml:"tds:DateTimeType"`
 	DaylightSavings xsd.Boolean           `xml:"tds:DaylightSavings"`
-	TimeZone        onvif.TimeZone        `xml:"tds:TimeZone"`
-	UTCDateTime     onvif.DateTime        `xml:"tds:UTCDateTime"`
+	TimeZone        *onvif.TimeZone       `xml:"tds:TimeZone,omitempty"`
+	UTCDateTime     *onvif.DateTime       `xml:"tds:UTCDateTime,omitempty"`
 }
 
 type SetSystemDateAndTimeResponse struct {
@@ -175,10 +175,9 @@ type SystemRebootResponse struct {
 	Message string
 }
 
-//TODO: one or more repetitions
 type RestoreSystem struct {
-	XMLName     string           `xml:"tds:RestoreSystem"`
-	BackupFiles onvif.BackupFile `xml:"tds:BackupFiles"`
+	XMLName     string             `xml:"tds:RestoreSystem"`
+	BackupFiles []onvif.BackupFile `xml:"tds:BackupFiles"`
 }
 
 type RestoreSystemResponse struct {
@@ -189,7 +188,7 @@ type GetSystemBackup struct {
 }
 
 type GetSystemBackupResponse struct {
-	BackupFiles onvif.BackupFile
+	BackupFiles []onvif.BackupFile
 }
 
 type GetSystemLog struct {
@@ -214,35 +213,32 @@ type GetScopes struct {
 }
 
 type GetScopesResponse struct {
-	Scopes onvif.Scope
+	Scopes []onvif.Scope
 }
 
-//TODO: one or more scopes
 type SetScopes struct {
-	XMLName string     `xml:"tds:SetScopes"`
-	Scopes  xsd.AnyURI `xml:"tds:Scopes"`
+	XMLName string       `xml:"tds:SetScopes"`
+	Scopes  []xsd.AnyURI `xml:"tds:Scopes"`
 }
 
 type SetScopesResponse struct {
 }
 
-//TODO: list of scopes
 type AddScopes struct {
-	XMLName   string     `xml:"tds:AddScopes"`
-	ScopeItem xsd.AnyURI `xml:"tds:ScopeItem"`
+	XMLName   string       `xml:"tds:AddScopes"`
+	ScopeItem []xsd.AnyURI `xml:"tds:ScopeItem"`
 }
 
 type AddScopesResponse struct {
 }
 
-//TODO: One or more repetitions
 type RemoveScopes struct {
-	XMLName   string     `xml:"tds:RemoveScopes"`
-	ScopeItem xsd.AnyURI `xml:"onvif:ScopeItem"`
+	XMLName   string       `xml:"tds:RemoveScopes"`
+	ScopeItem []xsd.AnyURI `xml:"tds:ScopeItem"`
 }
 
 type RemoveScopesResponse struct {
-	ScopeItem xsd.AnyURI
+	ScopeItem []xsd.AnyURI
 }
 
 type GetDiscoveryMode struct {
@@ -282,12 +278,12 @@ type GetDPAddresses struct {
 }
 
 type GetDPAddressesResponse struct {
-	DPAddress onvif.NetworkHost
+	DPAddress []onvif.NetworkHost
 }
 
 type SetDPAddresses struct {
-	XMLName   string            `xml:"tds:SetDPAddresses"`
-	DPAddress onvif.NetworkHost `xml:"tds:DPAddress"`
+	XMLName   string              `xml:"tds:SetDPAddresses"`
+	DPAddress []onvif.NetworkHost `xml:"tds:DPAddress"`
 }
 
 type SetDPAddressesResponse struct {
@@ -306,12 +302,12 @@ type GetRemoteUser struct {
 }
 
 type GetRemoteUserResponse struct {
-	RemoteUser onvif.RemoteUser
+	RemoteUser *onvif.RemoteUser `xml:"RemoteUser,omitempty"`
 }
 
 type SetRemoteUser struct {
-	XMLName    string           `xml:"tds:SetRemoteUser"`
-	RemoteUser onvif.RemoteUser `xml:"tds:RemoteUser"`
+	XMLName    string            `xml:"tds:SetRemoteUser"`
+	RemoteUser *onvif.RemoteUser `xml:"tds:RemoteUser,omitempty"`
 }
 
 type SetRemoteUserResponse struct {
@@ -322,30 +318,28 @@ type GetUsers struct {
 }
 
 type GetUsersResponse struct {
-	User onvif.User
+	User []onvif.User
 }
 
-//TODO: List of users
 type CreateUsers struct {
-	XMLName string     `xml:"tds:CreateUsers"`
-	User    onvif.User `xml:"tds:User,omitempty"`
+	XMLName string       `xml:"tds:CreateUsers"`
+	User    []onvif.User `xml:"tds:User,omitempty"`
 }
 
 type CreateUsersResponse struct {
 }
 
-//TODO: one or more Username
 type DeleteUsers struct {
-	XMLName  xsd.String `xml:"tds:DeleteUsers"`
-	Username xsd.String `xml:"tds:Username"`
+	XMLName  xsd.String   `xml:"tds:DeleteUsers"`
+	Username []xsd.String `xml:"tds:Username"`
 }
 
 type DeleteUsersResponse struct {
 }
 
 type SetUser struct {
-	XMLName string     `xml:"tds:SetUser"`
-	User    onvif.User `xml:"tds:User"`
+	XMLName string       `xml:"tds:SetUser"`
+	User    []onvif.User `xml:"tds:User"`
 }
 
 type SetUserResponse struct {
@@ -360,8 +354,8 @@ type GetWsdlUrlResponse struct {
 }
 
 type GetCapabilities struct {
-	XMLName  string                   `xml:"tds:GetCapabilities"`
-	Category onvif.CapabilityCategory `xml:"tds:Category"`
+	XMLName  string                     `xml:"tds:GetCapabilities"`
+	Category []onvif.CapabilityCategory `xml:"tds:Category"`
 }
 
 type GetCapabilitiesResponse struct {
@@ -402,10 +396,10 @@ type GetDNSResponse struct {
 }
 
 type SetDNS struct {
-	XMLName      string          `xml:"tds:SetDNS"`
-	FromDHCP     xsd.Boolean     `xml:"tds:FromDHCP"`
-	SearchDomain xsd.Token       `xml:"tds:SearchDomain"`
-	DNSManual    onvif.IPAddress `xml:"tds:DNSManual"`
+	XMLName      string            `xml:"tds:SetDNS"`
+	FromDHCP     xsd.Boolean       `xml:"tds:FromDHCP"`
+	SearchDomain []xsd.Token       `xml:"tds:SearchDomain"`
+	DNSManual    []onvif.IPAddress `xml:"tds:DNSManual"`
 }
 
 type SetDNSResponse struct {
@@ -420,9 +414,9 @@ type GetNTPResponse struct {
 }
 
 type SetNTP struct {
-	XMLName   string            `xml:"tds:SetNTP"`
-	FromDHCP  xsd.Boolean       `xml:"tds:FromDHCP"`
-	NTPManual onvif.NetworkHost `xml:"tds:NTPManual"`
+	XMLName   string              `xml:"tds:SetNTP"`
+	FromDHCP  xsd.Boolean         `xml:"tds:FromDHCP"`
+	NTPManual []onvif.NetworkHost `xml:"tds:NTPManual"`
 }
 
 type SetNTPResponse struct {
@@ -439,8 +433,8 @@ type GetDynamicDNSResponse struct {
 type SetDynamicDNS struct {
 	XMLName string               `xml:"tds:SetDynamicDNS"`
 	Type    onvif.DynamicDNSType `xml:"tds:Type"`
-	Name    onvif.DNSName        `xml:"tds:Name"`
-	TTL     xsd.Duration         `xml:"tds:TTL"`
+	Name    onvif.DNSName        `xml:"tds:Name,omitempty"`
+	TTL     xsd.Duration         `xml:"tds:TTL,omitempty"`
 }
 
 type SetDynamicDNSResponse struct {
@@ -451,7 +445,7 @@ type GetNetworkInterfaces struct {
 }
 
 type GetNetworkInterfacesResponse struct {
-	NetworkInterfaces onvif.NetworkInterface
+	NetworkInterfaces []onvif.NetworkInterface
 }
 
 type SetNetworkInterfaces struct {
@@ -469,12 +463,12 @@ type GetNetworkProtocols struct {
 }
 
 type GetNetworkProtocolsResponse struct {
-	NetworkProtocols onvif.NetworkProtocol
+	NetworkProtocols []onvif.NetworkProtocol
 }
 
 type SetNetworkProtocols struct {
-	XMLName          string                `xml:"tds:SetNetworkProtocols"`
-	NetworkProtocols onvif.NetworkProtocol `xml:"tds:NetworkProtocols"`
+	XMLName          string                  `xml:"tds:SetNetworkProtocols"`
+	NetworkProtocols []onvif.NetworkProtocol `xml:"tds:NetworkProtocols"`
 }
 
 type SetNetworkProtocolsResponse struct {
@@ -489,9 +483,9 @@ type GetNetworkDefaultGatewayResponse struct {
 }
 
 type SetNetworkDefaultGateway struct {
-	XMLName     string            `xml:"tds:SetNetworkDefaultGateway"`
-	IPv4Address onvif.IPv4Address `xml:"tds:IPv4Address"`
-	IPv6Address onvif.IPv6Address `xml:"tds:IPv6Address"`
+	XMLName     string              `xml:"tds:SetNetworkDefaultGateway"`
+	IPv4Address []onvif.IPv4Address `xml:"tds:IPv4Address"`
+	IPv6Address []onvif.IPv6Address `xml:"tds:IPv6Address"`
 }
 
 type SetNetworkDefaultGatewayResponse struct {
@@ -545,7 +539,7 @@ type AddIPAddressFilterResponse struct {
 
 type RemoveIPAddressFilter struct {
 	XMLName         string                `xml:"tds:RemoveIPAddressFilter"`
-	IPAddressFilter onvif.IPAddressFilter `xml:"onvif:IPAddressFilter"`
+	IPAddressFilter onvif.IPAddressFilter `xml:"tds:IPAddressFilter"`
 }
 
 type RemoveIPAddressFilterResponse struct {
@@ -584,7 +578,7 @@ type GetCertificates struct {
 }
 
 type GetCertificatesResponse struct {
-	NvtCertificate onvif.Certificate
+	NvtCertificate []onvif.Certificate
 }
 
 type GetCertificatesStatus struct {
@@ -592,21 +586,20 @@ type GetCertificatesStatus struct {
 }
 
 type GetCertificatesStatusResponse struct {
-	CertificateStatus onvif.CertificateStatus
+	CertificateStatus []onvif.CertificateStatus
 }
 
 type SetCertificatesStatus struct {
-	XMLName           string                  `xml:"tds:SetCertificatesStatus"`
-	CertificateStatus onvif.CertificateStatus `xml:"tds:CertificateStatus"`
+	XMLName           string                    `xml:"tds:SetCertificatesStatus"`
+	CertificateStatus []onvif.CertificateStatus `xml:"tds:CertificateStatus"`
 }
 
 type SetCertificatesStatusResponse struct {
 }
 
-//TODO: List of CertificateID
 type DeleteCertificates struct {
-	XMLName       string    `xml:"tds:DeleteCertificates"`
-	CertificateID xsd.Token `xml:"tds:CertificateID"`
+	XMLName       string      `xml:"tds:DeleteCertificates"`
+	CertificateID []xsd.Token `xml:"tds:CertificateID"`
 }
 
 type DeleteCertificatesResponse struct {
@@ -614,20 +607,19 @@ type DeleteCertificatesResponse struct {
 
 //TODO: Откуда onvif:data = cid:21312413412
 type GetPkcs10Request struct {
-	XMLName       string           `xml:"tds:GetPkcs10Request"`
-	CertificateID xsd.Token        `xml:"tds:CertificateID"`
-	Subject       xsd.String       `xml:"tds:Subject"`
-	Attributes    onvif.BinaryData `xml:"tds:Attributes"`
+	XMLName       string            `xml:"tds:GetPkcs10Request"`
+	CertificateID xsd.Token         `xml:"tds:CertificateID"`
+	Subject       xsd.String        `xml:"tds:Subject,omitempty"`
+	Attributes    *onvif.BinaryData `xml:"tds:Attributes,omitempty"`
 }
 
 type GetPkcs10RequestResponse struct {
 	Pkcs10Request onvif.BinaryData
 }
 
-//TODO: one or more NTVCertificate
 type LoadCertificates struct {
-	XMLName        string            `xml:"tds:LoadCertificates"`
-	NVTCertificate onvif.Certificate `xml:"tds:NVTCertificate"`
+	XMLName        string              `xml:"tds:LoadCertificates"`
+	NVTCertificate []onvif.Certificate `xml:"tds:NVTCertificate"`
 }
 
 type LoadCertificatesResponse struct {
@@ -654,7 +646,7 @@ type GetRelayOutputs struct {
 }
 
 type GetRelayOutputsResponse struct {
-	RelayOutputs onvif.RelayOutput
+	RelayOutputs []onvif.RelayOutput
 }
 
 type SetRelayOutputSettings struct {
@@ -689,13 +681,12 @@ type GetCACertificates struct {
 }
 
 type GetCACertificatesResponse struct {
-	CACertificate onvif.Certificate
+	CACertificate []onvif.Certificate
 }
 
-//TODO: one or more CertificateWithPrivateKey
 type LoadCertificateWithPrivateKey struct {
-	XMLName                   string                          `xml:"tds:LoadCertificateWithPrivateKey"`
-	CertificateWithPrivateKey onvif.CertificateWithPrivateKey `xml:"tds:CertificateWithPrivateKey"`
+	XMLName                   string                            `xml:"tds:LoadCertificateWithPrivateKey"`
+	CertificateWithPrivateKey []onvif.CertificateWithPrivateKey `xml:"tds:CertificateWithPrivateKey"`
 }
 
 type LoadCertificateWithPrivateKeyResponse struct {
@@ -711,8 +702,8 @@ type GetCertificateInformationResponse struct {
 }
 
 type LoadCACertificates struct {
-	XMLName       string            `xml:"tds:LoadCACertificates"`
-	CACertificate onvif.Certificate `xml:"tds:CACertificate"`
+	XMLName       string              `xml:"tds:LoadCACertificates"`
+	CACertificate []onvif.Certificate `xml:"tds:CACertificate"`
 }
 
 type LoadCACertificatesResponse struct {
@@ -748,13 +739,12 @@ type GetDot1XConfigurations struct {
 }
 
 type GetDot1XConfigurationsResponse struct {
-	Dot1XConfiguration onvif.Dot1XConfiguration
+	Dot1XConfiguration []onvif.Dot1XConfiguration
 }
 
-//TODO: Zero or more Dot1XConfigurationToken
 type DeleteDot1XConfiguration struct {
-	XMLName                 string               `xml:"tds:DeleteDot1XConfiguration"`
-	Dot1XConfigurationToken onvif.ReferenceToken `xml:"tds:Dot1XConfigurationToken"`
+	XMLName                 string                 `xml:"tds:DeleteDot1XConfiguration"`
+	Dot1XConfigurationToken []onvif.ReferenceToken `xml:"tds:Dot1XConfigurationToken"`
 }
 
 type DeleteDot1XConfigurationResponse struct {
@@ -783,7 +773,7 @@ type ScanAvailableDot11Networks struct {
 }
 
 type ScanAvailableDot11NetworksResponse struct {
-	Networks onvif.Dot11AvailableNetworks
+	Networks []onvif.Dot11AvailableNetworks
 }
 
 type GetSystemUris struct {
@@ -791,7 +781,7 @@ type GetSystemUris struct {
 }
 
 type GetSystemUrisResponse struct {
-	SystemLogUris   onvif.SystemLogUriList
+	SystemLogUris   *onvif.SystemLogUriList `xml:"SystemLogUris,omitempty"`
 	SupportInfoUri  xsd.AnyURI
 	SystemBackupUri xsd.AnyURI
 	Extension       xsd.AnyType
@@ -821,7 +811,7 @@ type GetStorageConfigurations struct {
 }
 
 type GetStorageConfigurationsResponse struct {
-	StorageConfigurations StorageConfiguration
+	StorageConfigurations []StorageConfiguration
 }
 
 type CreateStorageConfiguration struct {
@@ -863,21 +853,20 @@ type GetGeoLocation struct {
 }
 
 type GetGeoLocationResponse struct {
-	Location onvif.LocationEntity
+	Location []onvif.LocationEntity
 }
 
-//TODO: one or more Location
 type SetGeoLocation struct {
-	XMLName  string               `xml:"tds:SetGeoLocation"`
-	Location onvif.LocationEntity `xml:"tds:Location"`
+	XMLName  string                 `xml:"tds:SetGeoLocation"`
+	Location []onvif.LocationEntity `xml:"tds:Location"`
 }
 
 type SetGeoLocationResponse struct {
 }
 
 type DeleteGeoLocation struct {
-	XMLName  string               `xml:"tds:DeleteGeoLocation"`
-	Location onvif.LocationEntity `xml:"tds:Location"`
+	XMLName  string                 `xml:"tds:DeleteGeoLocation"`
+	Location []onvif.LocationEntity `xml:"tds:Location"`
 }
 
 type DeleteGeoLocationResponse struct {
@@ -0,0 +1,401 @@
+// Code generated by gonvif-gen from deviceio.wsdl. DO NOT EDIT.
+
+package deviceio
+
+import (
+	"github.com/sonnt85/gonvif/gosoap"
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Namespace of the deviceio service
+const Namespace = "http://www.onvif.org/ver10/deviceIO/wsdl"
+
+// Prefix of the deviceio service elements
+const Prefix = "tmd"
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tmd:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type Capabilities struct {
+	VideoSources        xsd.Int     `xml:"VideoSources,attr,omitempty"`
+	VideoOutputs        xsd.Int     `xml:"VideoOutputs,attr,omitempty"`
+	AudioSources        xsd.Int     `xml:"AudioSources,attr,omitempty"`
+	AudioOutputs        xsd.Int     `xml:"AudioOutputs,attr,omitempty"`
+	RelayOutputs        xsd.Int     `xml:"RelayOutputs,attr,omitempty"`
+	SerialPorts         xsd.Int     `xml:"SerialPorts,attr,omitempty"`
+	DigitalInputs       xsd.Int     `xml:"DigitalInputs,attr,omitempty"`
+	DigitalInputOptions xsd.Boolean `xml:"DigitalInputOptions,attr,omitempty"`
+}
+
+type GetRelayOutputOptions struct {
+	XMLName          string               `xml:"tmd:GetRelayOutputOptions"`
+	RelayOutputToken onvif.ReferenceToken `xml:"tmd:RelayOutputToken,omitempty"`
+}
+
+type GetRelayOutputOptionsResponse struct {
+	RelayOutputOptions []RelayOutputOptions
+}
+
+type RelayOutputOptions struct {
+	Mode       []onvif.RelayMode            `xml:"tmd:Mode"`
+	DelayTimes DelayTimes                   `xml:"tmd:DelayTimes,omitempty"`
+	Discrete   *xsd.Boolean                 `xml:"tmd:Discrete,omitempty"`
+	Extension  *RelayOutputOptionsExtension `xml:"tmd:Extension,omitempty"`
+	Token      onvif.ReferenceToken         `xml:"token,attr"`
+}
+
+type RelayOutputOptionsExtension struct {
+}
+
+// The value is a space separated list.
+type DelayTimes xsd.String
+
+type Get struct {
+}
+
+type GetResponse struct {
+	Token []onvif.ReferenceToken `xml:"tmd:Token"`
+}
+
+type GetVideoSources struct {
+	XMLName string `xml:"tmd:GetVideoSources"`
+	Get
+}
+
+type GetVideoSourcesResponse struct {
+	GetResponse
+}
+
+type GetAudioSources struct {
+	XMLName string `xml:"tmd:GetAudioSources"`
+	Get
+}
+
+type GetAudioSourcesResponse struct {
+	GetResponse
+}
+
+type GetAudioOutputs struct {
+	XMLName string `xml:"tmd:GetAudioOutputs"`
+	Get
+}
+
+type GetAudioOutputsResponse struct {
+	GetResponse
+}
+
+type GetVideoOutputs struct {
+	XMLName string `xml:"tmd:GetVideoOutputs"`
+}
+
+type GetVideoOutputsResponse struct {
+	VideoOutputs []xsd.AnyType
+}
+
+type GetAudioSourceConfiguration struct {
+	XMLName          string               `xml:"tmd:GetAudioSourceConfiguration"`
+	AudioSourceToken onvif.ReferenceToken `xml:"tmd:AudioSourceToken"`
+}
+
+type GetAudioSourceConfigurationResponse struct {
+	AudioSourceConfiguration onvif.AudioSourceConfiguration
+}
+
+type GetAudioOutputConfiguration struct {
+	XMLName          string               `xml:"tmd:GetAudioOutputConfiguration"`
+	AudioOutputToken onvif.ReferenceToken `xml:"tmd:AudioOutputToken"`
+}
+
+type GetAudioOutputConfigurationResponse struct {
+	AudioOutputConfiguration onvif.AudioOutputConfiguration
+}
+
+type GetVideoSourceConfiguration struct {
+	XMLName          string               `xml:"tmd:GetVideoSourceConfiguration"`
+	VideoSourceToken onvif.ReferenceToken `xml:"tmd:VideoSourceToken"`
+}
+
+type GetVideoSourceConfigurationResponse struct {
+	VideoSourceConfiguration onvif.VideoSourceConfiguration
+}
+
+type GetVideoOutputConfiguration struct {
+	XMLName          string               `xml:"tmd:GetVideoOutputConfiguration"`
+	VideoOutputToken onvif.ReferenceToken `xml:"tmd:VideoOutputToken"`
+}
+
+type GetVideoOutputConfigurationResponse struct {
+	VideoOutputConfiguration xsd.AnyType
+}
+
+type SetAudioSourceConfiguration struct {
+	XMLName          string                         `xml:"tmd:SetAudioSourceConfiguration"`
+	Configuration    onvif.AudioSourceConfiguration `xml:"tmd:Configuration"`
+	ForcePersistence xsd.Boolean                    `xml:"tmd:ForcePersistence"`
+}
+
+type SetAudioSourceConfigurationResponse struct {
+}
+
+type SetAudioOutputConfiguration struct {
+	XMLName          string                         `xml:"tmd:SetAudioOutputConfiguration"`
+	Configuration    onvif.AudioOutputConfiguration `xml:"tmd:Configuration"`
+	ForcePersistence xsd.Boolean                    `xml:"tmd:ForcePersistence"`
+}
+
+type SetAudioOutputConfigurationResponse struct {
+}
+
+type SetVideoSourceConfiguration struct {
+	XMLName          string                         `xml:"tmd:SetVideoSourceConfiguration"`
+	Configuration    onvif.VideoSourceConfiguration `xml:"tmd:Configuration"`
+	ForcePersistence xsd.Boolean                    `xml:"tmd:ForcePersistence"`
+}
+
+type SetVideoSourceConfigurationResponse struct {
+}
+
+type SetVideoOutputConfiguration struct {
+	XMLName          string      `xml:"tmd:SetVideoOutputConfiguration"`
+	Configuration    xsd.AnyType `xml:"tmd:Configuration"`
+	ForcePersistence xsd.Boolean `xml:"tmd:ForcePersistence"`
+}
+
+type SetVideoOutputConfigurationResponse struct {
+}
+
+type GetVideoSourceConfigurationOptions struct {
+	XMLName          string               `xml:"tmd:GetVideoSourceConfigurationOptions"`
+	VideoSourceToken onvif.ReferenceToken `xml:"tmd:VideoSourceToken"`
+}
+
+type GetVideoSourceConfigurationOptionsResponse struct {
+	VideoSourceConfigurationOptions onvif.VideoSourceConfigurationOptions
+}
+
+type GetVideoOutputConfigurationOptions struct {
+	XMLName          string               `xml:"tmd:GetVideoOutputConfigurationOptions"`
+	VideoOutputToken onvif.ReferenceToken `xml:"tmd:VideoOutputToken"`
+}
+
+type GetVideoOutputConfigurationOptionsResponse struct {
+	VideoOutputConfigurationOptions xsd.AnyType
+}
+
+type GetAudioSourceConfigurationOptions struct {
+	XMLName          string               `xml:"tmd:GetAudioSourceConfigurationOptions"`
+	AudioSourceToken onvif.ReferenceToken `xml:"tmd:AudioSourceToken"`
+}
+
+type GetAudioSourceConfigurationOptionsResponse struct {
+	AudioSourceOptions onvif.AudioSourceConfigurationOptions
+}
+
+type GetAudioOutputConfigurationOptions struct {
+	XMLName          string               `xml:"tmd:GetAudioOutputConfigurationOptions"`
+	AudioOutputToken onvif.ReferenceToken `xml:"tmd:AudioOutputToken"`
+}
+
+type GetAudioOutputConfigurationOptionsResponse struct {
+	AudioOutputOptions onvif.AudioOutputConfigurationOptions
+}
+
+type SetRelayOutputSettings struct {
+	XMLName     string            `xml:"tmd:SetRelayOutputSettings"`
+	RelayOutput onvif.RelayOutput `xml:"tmd:RelayOutput"`
+}
+
+type SetRelayOutputSettingsResponse struct {
+}
+
+// GetDigitalInputs get the available digital inputs of a device
+type GetDigitalInputs struct {
+	XMLName string `xml:"tmd:GetDigitalInputs"`
+}
+
+// GetDigitalInputsResponse requested digital inputs
+type GetDigitalInputsResponse struct {
+	DigitalInputs []xsd.AnyType
+}
+
+type DigitalInputConfigurationInputOptions struct {
+	IdleState []xsd.AnyType `xml:"tmd:IdleState"`
+}
+
+type GetDigitalInputConfigurationOptions struct {
+	XMLName string               `xml:"tmd:GetDigitalInputConfigurationOptions"`
+	Token   onvif.ReferenceToken `xml:"tmd:Token,omitempty"`
+}
+
+type GetDigitalInputConfigurationOptionsResponse struct {
+	DigitalInputOptions DigitalInputConfigurationInputOptions
+}
+
+type SetDigitalInputConfigurations struct {
+	XMLName       string        `xml:"tmd:SetDigitalInputConfigurations"`
+	DigitalInputs []xsd.AnyType `xml:"tmd:DigitalInputs"`
+}
+
+type SetDigitalInputConfigurationsResponse struct {
+}
+
+// GetSerialPorts the physical serial port on the device that allows serial data to be read and written
+type GetSerialPorts struct {
+	XMLName string `xml:"tmd:GetSerialPorts"`
+}
+
+// GetSerialPortsResponse requested serial ports
+type GetSerialPortsResponse struct {
+	SerialPort []SerialPort
+}
+
+// GetSerialPortConfiguration gets the configuration that relates to serial port configuration
+type GetSerialPortConfiguration struct {
+	XMLName         string               `xml:"tmd:GetSerialPortConfiguration"`
+	SerialPortToken onvif.ReferenceToken `xml:"tmd:SerialPortToken"`
+}
+
+// GetSerialPortConfigurationResponse requested serial port configuration
+type GetSerialPortConfigurationResponse struct {
+	SerialPortConfiguration SerialPortConfiguration
+}
+
+// SetSerialPortConfiguration sets the configuration that relates to serial port configuration
+type SetSerialPortConfiguration struct {
+	XMLName                 string                  `xml:"tmd:SetSerialPortConfiguration"`
+	SerialPortConfiguration SerialPortConfiguration `xml:"tmd:SerialPortConfiguration"`
+	ForcePersistance        xsd.Boolean             `xml:"tmd:ForcePersistance"`
+}
+
+type SetSerialPortConfigurationResponse struct {
+}
+
+// GetSerialPortConfigurationOptions gets the configuration options that relates to serial port configuration
+type GetSerialPortConfigurationOptions struct {
+	XMLName         string               `xml:"tmd:GetSerialPortConfigurationOptions"`
+	SerialPortToken onvif.ReferenceToken `xml:"tmd:SerialPortToken"`
+}
+
+// GetSerialPortConfigurationOptionsResponse requested serial port configuration options
+type GetSerialPortConfigurationOptionsResponse struct {
+	SerialPortOptions SerialPortConfigurationOptions
+}
+
+// SendReceiveSerialCommand transmitting arbitrary data to the connected serial device and then receiving its response data
+type SendReceiveSerialCommand struct {
+	XMLName    string               `xml:"tmd:SendReceiveSerialCommand"`
+	Token      onvif.ReferenceToken `xml:"tmd:Token,omitempty"`
+	SerialData *SerialData          `xml:"tmd:SerialData,omitempty"`
+	TimeOut    xsd.Duration         `xml:"tmd:TimeOut,omitempty"`
+	DataLength xsd.Integer          `xml:"tmd:DataLength,omitempty"`
+	Delimiter  xsd.String           `xml:"tmd:Delimiter,omitempty"`
+}
+
+// SendReceiveSerialCommandResponse receiving the response data
+type SendReceiveSerialCommandResponse struct {
+	SerialData *SerialData
+}
+
+// SerialData the serial port data
+type SerialData struct {
+	Binary xsd.Base64Binary `xml:"tmd:Binary,omitempty"`
+	String xsd.String       `xml:"tmd:String,omitempty"`
+}
+
+// SerialPort lists all available serial ports of a device
+type SerialPort struct {
+	onvif.DeviceEntity
+}
+
+// SerialPortType the type of serial port.Generic can be signaled as a vendor specific serial port type
+type SerialPortType xsd.String
+
+const (
+	SerialPortTypeRS232           SerialPortType = "RS232"
+	SerialPortTypeRS422HalfDuplex SerialPortType = "RS422HalfDuplex"
+	SerialPortTypeRS422FullDuplex SerialPortType = "RS422FullDuplex"
+	SerialPortTypeRS485HalfDuplex SerialPortType = "RS485HalfDuplex"
+	SerialPortTypeRS485FullDuplex SerialPortType = "RS485FullDuplex"
+	SerialPortTypeGeneric         SerialPortType = "Generic"
+)
+
+// SerialPortConfiguration the parameters for configuring the serial port
+type SerialPortConfiguration struct {
+	BaudRate        xsd.Int              `xml:"tmd:BaudRate"`
+	ParityBit       ParityBit            `xml:"tmd:ParityBit"`
+	CharacterLength xsd.Int              `xml:"tmd:CharacterLength"`
+	StopBit         xsd.Float            `xml:"tmd:StopBit"`
+	Token           onvif.ReferenceToken `xml:"token,attr"`
+	Type            SerialPortType       `xml:"type,attr"`
+}
+
+// ParityBit the parity for the data error detection
+type ParityBit xsd.String
+
+const (
+	ParityBitNone     ParityBit = "None"
+	ParityBitEven     ParityBit = "Even"
+	ParityBitOdd      ParityBit = "Odd"
+	ParityBitMark     ParityBit = "Mark"
+	ParityBitSpace    ParityBit = "Space"
+	ParityBitExtended ParityBit = "Extended"
+)
+
+// SerialPortConfigurationOptions the configuration options that relates to serial port
+type SerialPortConfigurationOptions struct {
+	BaudRateList        onvif.IntList        `xml:"tmd:BaudRateList"`
+	ParityBitList       ParityBitList        `xml:"tmd:ParityBitList"`
+	CharacterLengthList onvif.IntList        `xml:"tmd:CharacterLengthList"`
+	StopBitList         xsd.AnyType          `xml:"tmd:StopBitList"`
+	Token               onvif.ReferenceToken `xml:"token,attr"`
+}
+
+// ParityBitList the list of configurable parity for the data error detection
+type ParityBitList struct {
+	Items []ParityBit `xml:"tmd:Items"`
+}
+
+// Actions maps the request elements of the service to their WS-Addressing action
+var Actions = map[string]string{
+	"tmd:GetServiceCapabilities":              "http://www.onvif.org/ver10/deviceio/wsdl/GetServiceCapabilities",
+	"tmd:GetRelayOutputOptions":               "http://www.onvif.org/ver10/deviceio/wsdl/GetRelayOutputOptions",
+	"tmd:GetAudioSources":                     "http://www.onvif.org/ver10/deviceio/wsdl/GetAudioSources",
+	"tmd:GetAudioOutputs":                     "http://www.onvif.org/ver10/deviceio/wsdl/GetAudioOutputs",
+	"tmd:GetVideoSources":                     "http://www.onvif.org/ver10/deviceio/wsdl/GetVideoSources",
+	"tmd:GetVideoOutputs":                     "http://www.onvif.org/ver10/deviceio/wsdl/GetVideoOutputs",
+	"tmd:GetVideoSourceConfiguration":         "http://www.onvif.org/ver10/deviceio/wsdl/GetVideoSourceConfiguration",
+	"tmd:GetVideoOutputConfiguration":         "http://www.onvif.org/ver10/deviceio/wsdl/GetVideoOutputConfiguration",
+	"tmd:GetAudioSourceConfiguration":         "http://www.onvif.org/ver10/deviceio/wsdl/GetAudioSourceConfiguration",
+	"tmd:GetAudioOutputConfiguration":         "http://www.onvif.org/ver10/deviceio/wsdl/GetAudioOutputConfiguration",
+	"tmd:SetVideoSourceConfiguration":         "http://www.onvif.org/ver10/deviceio/wsdl/SetVideoSourceConfiguration",
+	"tmd:SetVideoOutputConfiguration":         "http://www.onvif.org/ver10/deviceio/wsdl/SetVideoOutputConfiguration",
+	"tmd:SetAudioSourceConfiguration":         "http://www.onvif.org/ver10/deviceio/wsdl/SetAudioSourceConfiguration",
+	"tmd:SetAudioOutputConfiguration":         "http://www.onvif.org/ver10/deviceio/wsdl/SetAudioOutputConfiguration",
+	"tmd:GetVideoSourceConfigurationOptions":  "http://www.onvif.org/ver10/deviceio/wsdl/GetVideoSourceConfigurationOptions",
+	"tmd:GetVideoOutputConfigurationOptions":  "http://www.onvif.org/ver10/deviceio/wsdl/GetVideoOutputConfigurationOptions",
+	"tmd:GetAudioSourceConfigurationOptions":  "http://www.onvif.org/ver10/deviceio/wsdl/GetAudioSourceConfigurationOptions",
+	"tmd:GetAudioOutputConfigurationOptions":  "http://www.onvif.org/ver10/deviceio/wsdl/GetAudioOutputConfigurationOptions",
+	"tmd:GetRelayOutputs":                     "http://www.onvif.org/ver10/deviceio/wsdl/GetRelayOutputs",
+	"tmd:SetRelayOutputSettings":              "http://www.onvif.org/ver10/deviceio/wsdl/SetRelayOutputSettings",
+	"tmd:SetRelayOutputState":                 "http://www.onvif.org/ver10/deviceio/wsdl/SetRelayOutputState",
+	"tmd:GetDigitalInputs":                    "http://www.onvif.org/ver10/deviceio/wsdl/GetDigitalInputs",
+	"tmd:GetDigitalInputConfigurationOptions": "http://www.onvif.org/ver10/deviceio/wsdl/GetDigitalInputConfigurationOptions",
+	"tmd:SetDigitalInputConfigurations":       "http://www.onvif.org/ver10/deviceio/wsdl/SetDigitalInputConfigurations",
+	"tmd:GetSerialPorts":                      "http://www.onvif.org/ver10/deviceio/wsdl/GetSerialPorts",
+	"tmd:GetSerialPortConfiguration":          "http://www.onvif.org/ver10/deviceio/wsdl/GetSerialPortConfigurations",
+	"tmd:SetSerialPortConfiguration":          "http://www.onvif.org/ver10/deviceio/wsdl/SetSerialPortConfiguration",
+	"tmd:GetSerialPortConfigurationOptions":   "http://www.onvif.org/ver10/deviceio/wsdl/GetSerialPortConfigurationOptions",
+	"tmd:SendReceiveSerialCommand":            "http://www.onvif.org/ver10/deviceio/wsdl/SendReceiveSerialCommand",
+}
+
+func init() {
+	for tag, action := range Actions {
+		gosoap.RegisterAction(tag, action)
+	}
+}
@@ -0,0 +1,131 @@
+// Code generated by gonvif-gen from display.wsdl. DO NOT EDIT.
+
+package display
+
+import (
+	"github.com/sonnt85/gonvif/gosoap"
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Namespace of the display service
+const Namespace = "http://www.onvif.org/ver10/display/wsdl"
+
+// Prefix of the display service elements
+const Prefix = "tls"
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tls:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type Capabilities struct {
+	FixedLayout xsd.Boolean `xml:"FixedLayout,attr,omitempty"`
+}
+
+type GetLayout struct {
+	XMLName     string               `xml:"tls:GetLayout"`
+	VideoOutput onvif.ReferenceToken `xml:"tls:VideoOutput"`
+}
+
+type GetLayoutResponse struct {
+	Layout xsd.AnyType
+}
+
+type SetLayout struct {
+	XMLName     string               `xml:"tls:SetLayout"`
+	VideoOutput onvif.ReferenceToken `xml:"tls:VideoOutput"`
+	Layout      xsd.AnyType          `xml:"tls:Layout"`
+}
+
+type SetLayoutResponse struct {
+}
+
+type GetDisplayOptions struct {
+	XMLName     string               `xml:"tls:GetDisplayOptions"`
+	VideoOutput onvif.ReferenceToken `xml:"tls:VideoOutput"`
+}
+
+type GetDisplayOptionsResponse struct {
+	LayoutOptions      xsd.AnyType
+	CodingCapabilities xsd.AnyType
+}
+
+type GetPaneConfigurations struct {
+	XMLName     string               `xml:"tls:GetPaneConfigurations"`
+	VideoOutput onvif.ReferenceToken `xml:"tls:VideoOutput"`
+}
+
+type GetPaneConfigurationsResponse struct {
+	PaneConfiguration []xsd.AnyType
+}
+
+type GetPaneConfiguration struct {
+	XMLName     string               `xml:"tls:GetPaneConfiguration"`
+	VideoOutput onvif.ReferenceToken `xml:"tls:VideoOutput"`
+	Pane        onvif.ReferenceToken `xml:"tls:Pane"`
+}
+
+type GetPaneConfigurationResponse struct {
+	PaneConfiguration xsd.AnyType
+}
+
+type SetPaneConfigurations struct {
+	XMLName           string               `xml:"tls:SetPaneConfigurations"`
+	VideoOutput       onvif.ReferenceToken `xml:"tls:VideoOutput"`
+	PaneConfiguration []xsd.AnyType        `xml:"tls:PaneConfiguration"`
+}
+
+type SetPaneConfigurationsResponse struct {
+}
+
+type SetPaneConfiguration struct {
+	XMLName           string               `xml:"tls:SetPaneConfiguration"`
+	VideoOutput       onvif.ReferenceToken `xml:"tls:VideoOutput"`
+	PaneConfiguration xsd.AnyType          `xml:"tls:PaneConfiguration"`
+}
+
+type SetPaneConfigurationResponse struct {
+}
+
+type CreatePaneConfiguration struct {
+	XMLName           string               `xml:"tls:CreatePaneConfiguration"`
+	VideoOutput       onvif.ReferenceToken `xml:"tls:VideoOutput"`
+	PaneConfiguration xsd.AnyType          `xml:"tls:PaneConfiguration"`
+}
+
+type CreatePaneConfigurationResponse struct {
+	PaneToken onvif.ReferenceToken
+}
+
+type DeletePaneConfiguration struct {
+	XMLName     string               `xml:"tls:DeletePaneConfiguration"`
+	VideoOutput onvif.ReferenceToken `xml:"tls:VideoOutput"`
+	PaneToken   onvif.ReferenceToken `xml:"tls:PaneToken"`
+}
+
+type DeletePaneConfigurationResponse struct {
+}
+
+// Actions maps the request elements of the service to their WS-Addressing action
+var Actions = map[string]string{
+	"tls:GetServiceCapabilities":  "http://www.onvif.org/ver10/display/wsdl/GetServiceCapabilities",
+	"tls:GetLayout":               "http://www.onvif.org/ver10/display/wsdl/GetLayout",
+	"tls:SetLayout":               "http://www.onvif.org/ver10/display/wsdl/SetLayout",
+	"tls:GetDisplayOptions":       "http://www.onvif.org/ver10/display/wsdl/GetDisplayOptions",
+	"tls:GetPaneConfigurations":   "http://www.onvif.org/ver10/display/wsdl/GetPaneConfigurations",
+	"tls:GetPaneConfiguration":    "http://www.onvif.org/ver10/display/wsdl/GetPaneConfiguration",
+	"tls:SetPaneConfigurations":   "http://www.onvif.org/ver10/display/wsdl/SetPaneConfigurations",
+	"tls:SetPaneConfiguration":    "http://www.onvif.org/ver10/display/wsdl/SetPaneConfiguration",
+	"tls:CreatePaneConfiguration": "http://www.onvif.org/ver10/display/wsdl/CreatePaneConfiguration",
+	"tls:DeletePaneConfiguration": "http://www.onvif.org/ver10/display/wsdl/DeletePaneConfiguration",
+}
+
+func init() {
+	for tag, action := range Actions {
+		gosoap.RegisterAction(tag, action)
+	}
+}
@@ -0,0 +1,275 @@
+// Code generated by gonvif-gen from doorcontrol.wsdl. DO NOT EDIT.
+
+package doorcontrol
+
+import (
+	"github.com/sonnt85/gonvif/gosoap"
+	"github.com/sonnt85/gonvif/xsd"
+)
+
+// Namespace of the doorcontrol service
+const Namespace = "http://www.onvif.org/ver10/doorcontrol/wsdl"
+
+// Prefix of the doorcontrol service elements
+const Prefix = "tdc"
+
+// ServiceCapabilities serviceCapabilities structure reflects optional functionality of a service
+type ServiceCapabilities struct {
+	MaxLimit xsd.UnsignedInt `xml:"MaxLimit,attr"`
+}
+
+// DoorInfoBase used as extension base
+type DoorInfoBase struct {
+	xsd.AnyType
+	Name        xsd.AnyType `xml:"tdc:Name"`
+	Description xsd.AnyType `xml:"tdc:Description,omitempty"`
+}
+
+// DoorInfo the DoorInfo type represents the Door as a physical object
+type DoorInfo struct {
+	DoorInfoBase
+	Capabilities DoorCapabilities `xml:"tdc:Capabilities"`
+}
+
+// DoorCapabilities doorCapabilities reflect optional functionality of a particular physical entity
+type DoorCapabilities struct {
+	Access               xsd.Boolean `xml:"Access,attr,omitempty"`
+	AccessTimingOverride xsd.Boolean `xml:"AccessTimingOverride,attr,omitempty"`
+	Lock                 xsd.Boolean `xml:"Lock,attr,omitempty"`
+	Unlock               xsd.Boolean `xml:"Unlock,attr,omitempty"`
+	Block                xsd.Boolean `xml:"Block,attr,omitempty"`
+	DoubleLock           xsd.Boolean `xml:"DoubleLock,attr,omitempty"`
+	LockDown             xsd.Boolean `xml:"LockDown,attr,omitempty"`
+	LockOpen             xsd.Boolean `xml:"LockOpen,attr,omitempty"`
+	DoorMonitor          xsd.Boolean `xml:"DoorMonitor,attr,omitempty"`
+	LockMonitor          xsd.Boolean `xml:"LockMonitor,attr,omitempty"`
+	DoubleLockMonitor    xsd.Boolean `xml:"DoubleLockMonitor,attr,omitempty"`
+	Alarm                xsd.Boolean `xml:"Alarm,attr,omitempty"`
+	Tamper               xsd.Boolean `xml:"Tamper,attr,omitempty"`
+	Fault                xsd.Boolean `xml:"Fault,attr,omitempty"`
+}
+
+// DoorState the DoorState structure contains current aggregate runtime status of Door
+type DoorState struct {
+	DoorPhysicalState       DoorPhysicalState `xml:"tdc:DoorPhysicalState,omitempty"`
+	LockPhysicalState       LockPhysicalState `xml:"tdc:LockPhysicalState,omitempty"`
+	DoubleLockPhysicalState LockPhysicalState `xml:"tdc:DoubleLockPhysicalState,omitempty"`
+	Alarm                   DoorAlarmState    `xml:"tdc:Alarm,omitempty"`
+	Tamper                  *DoorTamper       `xml:"tdc:Tamper,omitempty"`
+	Fault                   *DoorFault        `xml:"tdc:Fault,omitempty"`
+	DoorMode                DoorMode          `xml:"tdc:DoorMode"`
+}
+
+// DoorPhysicalState the physical state of a Door
+type DoorPhysicalState xsd.String
+
+const (
+	DoorPhysicalStateUnknown DoorPhysicalState = "Unknown"
+	DoorPhysicalStateOpen    DoorPhysicalState = "Open"
+	DoorPhysicalStateClosed  DoorPhysicalState = "Closed"
+	DoorPhysicalStateFault   DoorPhysicalState = "Fault"
+)
+
+// LockPhysicalState the physical state of a Lock (including Double Lock)
+type LockPhysicalState xsd.String
+
+const (
+	LockPhysicalStateUnknown  LockPhysicalState = "Unknown"
+	LockPhysicalStateLocked   LockPhysicalState = "Locked"
+	LockPhysicalStateUnlocked LockPhysicalState = "Unlocked"
+	LockPhysicalStateFault    LockPhysicalState = "Fault"
+)
+
+// DoorAlarmState describes the state of a Door with regard to alarms
+type DoorAlarmState xsd.String
+
+const (
+	DoorAlarmStateNormal          DoorAlarmState = "Normal"
+	DoorAlarmStateDoorForcedOpen  DoorAlarmState = "DoorForcedOpen"
+	DoorAlarmStateDoorOpenTooLong DoorAlarmState = "DoorOpenTooLong"
+)
+
+// DoorTamper tampering information for a Door
+type DoorTamper struct {
+	Reason xsd.String      `xml:"tdc:Reason,omitempty"`
+	State  DoorTamperState `xml:"tdc:State"`
+}
+
+// DoorTamperState describes the state of a Tamper detector
+type DoorTamperState xsd.String
+
+const (
+	DoorTamperStateUnknown        DoorTamperState = "Unknown"
+	DoorTamperStateNotInTamper    DoorTamperState = "NotInTamper"
+	DoorTamperStateTamperDetected DoorTamperState = "TamperDetected"
+)
+
+// DoorFault fault information for a Door
+type DoorFault struct {
+	Reason xsd.String     `xml:"tdc:Reason,omitempty"`
+	State  DoorFaultState `xml:"tdc:State"`
+}
+
+// DoorFaultState describes the state of a Door fault
+type DoorFaultState xsd.String
+
+const (
+	DoorFaultStateUnknown       DoorFaultState = "Unknown"
+	DoorFaultStateNotInFault    DoorFaultState = "NotInFault"
+	DoorFaultStateFaultDetected DoorFaultState = "FaultDetected"
+)
+
+// DoorMode doorMode parameters describe current Door mode from a logical perspective
+type DoorMode xsd.String
+
+const (
+	DoorModeUnknown      DoorMode = "Unknown"
+	DoorModeLocked       DoorMode = "Locked"
+	DoorModeUnlocked     DoorMode = "Unlocked"
+	DoorModeAccessed     DoorMode = "Accessed"
+	DoorModeBlocked      DoorMode = "Blocked"
+	DoorModeLockedDown   DoorMode = "LockedDown"
+	DoorModeLockedOpen   DoorMode = "LockedOpen"
+	DoorModeDoubleLocked DoorMode = "DoubleLocked"
+)
+
+// AccessDoorExtension extension for the AccessDoor command
+type AccessDoorExtension struct {
+}
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tdc:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities ServiceCapabilities
+}
+
+type GetDoorInfoList struct {
+	XMLName        string     `xml:"tdc:GetDoorInfoList"`
+	Limit          xsd.Int    `xml:"tdc:Limit,omitempty"`
+	StartReference xsd.String `xml:"tdc:StartReference,omitempty"`
+}
+
+type GetDoorInfoListResponse struct {
+	NextStartReference xsd.String
+	DoorInfo           []DoorInfo
+}
+
+type GetDoorInfo struct {
+	XMLName string        `xml:"tdc:GetDoorInfo"`
+	Token   []xsd.AnyType `xml:"tdc:Token"`
+}
+
+type GetDoorInfoResponse struct {
+	DoorInfo []DoorInfo
+}
+
+type GetDoorState struct {
+	XMLName string      `xml:"tdc:GetDoorState"`
+	Token   xsd.AnyType `xml:"tdc:Token"`
+}
+
+type GetDoorStateResponse struct {
+	DoorState DoorState
+}
+
+type AccessDoor struct {
+	XMLName         string               `xml:"tdc:AccessDoor"`
+	Token           xsd.AnyType          `xml:"tdc:Token"`
+	UseExtendedTime *xsd.Boolean         `xml:"tdc:UseExtendedTime,omitempty"`
+	AccessTime      xsd.Duration         `xml:"tdc:AccessTime,omitempty"`
+	OpenTooLongTime xsd.Duration         `xml:"tdc:OpenTooLongTime,omitempty"`
+	PreAlarmTime    xsd.Duration         `xml:"tdc:PreAlarmTime,omitempty"`
+	Extension       *AccessDoorExtension `xml:"tdc:Extension,omitempty"`
+}
+
+type AccessDoorResponse struct {
+}
+
+type LockDoor struct {
+	XMLName string      `xml:"tdc:LockDoor"`
+	Token   xsd.AnyType `xml:"tdc:Token"`
+}
+
+type LockDoorResponse struct {
+}
+
+type UnlockDoor struct {
+	XMLName string      `xml:"tdc:UnlockDoor"`
+	Token   xsd.AnyType `xml:"tdc:Token"`
+}
+
+type UnlockDoorResponse struct {
+}
+
+type BlockDoor struct {
+	XMLName string      `xml:"tdc:BlockDoor"`
+	Token   xsd.AnyType `xml:"tdc:Token"`
+}
+
+type BlockDoorResponse struct {
+}
+
+type LockDownDoor struct {
+	XMLName string      `xml:"tdc:LockDownDoor"`
+	Token   xsd.AnyType `xml:"tdc:Token"`
+}
+
+type LockDownDoorResponse struct {
+}
+
+type LockDownReleaseDoor struct {
+	XMLName string      `xml:"tdc:LockDownReleaseDoor"`
+	Token   xsd.AnyType `xml:"tdc:Token"`
+}
+
+type LockDownReleaseDoorResponse struct {
+}
+
+type LockOpenDoor struct {
+	XMLName string      `xml:"tdc:LockOpenDoor"`
+	Token   xsd.AnyType `xml:"tdc:Token"`
+}
+
+type LockOpenDoorResponse struct {
+}
+
+type LockOpenReleaseDoor struct {
+	XMLName string      `xml:"tdc:LockOpenReleaseDoor"`
+	Token   xsd.AnyType `xml:"tdc:Token"`
+}
+
+type LockOpenReleaseDoorResponse struct {
+}
+
+type DoubleLockDoor struct {
+	XMLName string      `xml:"tdc:DoubleLockDoor"`
+	Token   xsd.AnyType `xml:"tdc:Token"`
+}
+
+type DoubleLockDoorResponse struct {
+}
+
+// Actions maps the request elements of the service to their WS-Addressing action
+var Actions = map[string]string{
+	"tdc:GetServiceCapabilities": "http://www.onvif.org/ver10/doorcontrol/wsdl/GetServiceCapabilities",
+	"tdc:GetDoorInfoList":        "http://www.onvif.org/ver10/doorcontrol/wsdl/GetDoorInfoList",
+	"tdc:GetDoorInfo":            "http://www.onvif.org/ver10/doorcontrol/wsdl/GetDoorInfo",
+	"tdc:GetDoorState":           "http://www.onvif.org/ver10/doorcontrol/wsdl/GetDoorState",
+	"tdc:AccessDoor":             "http://www.onvif.org/ver10/doorcontrol/wsdl/AccessDoor",
+	"tdc:LockDoor":               "http://www.onvif.org/ver10/doorcontrol/wsdl/LockDoor",
+	"tdc:UnlockDoor":             "http://www.onvif.org/ver10/doorcontrol/wsdl/UnlockDoor",
+	"tdc:BlockDoor":              "http://www.onvif.org/ver10/doorcontrol/wsdl/BlockDoor",
+	"tdc:LockDownDoor":           "http://www.onvif.org/ver10/doorcontrol/wsdl/LockDownDoor",
+	"tdc:LockDownReleaseDoor":    "http://www.onvif.org/ver10/doorcontrol/wsdl/LockDownReleaseDoor",
+	"tdc:LockOpenDoor":           "http://www.onvif.org/ver10/doorcontrol/wsdl/LockOpenDoor",
+	"tdc:LockOpenReleaseDoor":    "http://www.onvif.org/ver10/doorcontrol/wsdl/LockOpenReleaseDoor",
+	"tdc:DoubleLockDoor":         "http://www.onvif.org/ver10/doorcontrol/wsdl/DoubleLockDoor",
+}
+
+func init() {
+	for tag, action := range Actions {
+		gosoap.RegisterAction(tag, action)
+	}
+}
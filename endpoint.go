@@ -0,0 +1,108 @@
+package gonvif
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// EndpointPolicy decides how the service addresses advertised by a device
+// (GetCapabilities XAddr) are turned into the URLs gonvif actually calls
+type EndpointPolicy int
+
+const (
+	// EndpointAuto replaces the advertised host and port with Xaddr, except for
+	// https services that keep their advertised port
+	EndpointAuto EndpointPolicy = iota
+	// EndpointKeepAdvertised uses the addresses advertised by the device as is
+	EndpointKeepAdvertised
+	// EndpointForceHost replaces the advertised host and keeps the advertised
+	// port, for devices exposing every service on a different port
+	EndpointForceHost
+	// EndpointForceHostPort replaces the advertised host and port, for devices
+	// behind NAT or a port forward exposing all services on one port
+	EndpointForceHostPort
+)
+
+// EndpointMapper maps the address advertised for service (lower case, e.g.
+// "media") to the address to call. It takes precedence over the policy and
+// the path prefix, returning an empty string falls back to them
+type EndpointMapper func(service, advertised string) string
+
+// String returns the name of the policy
+func (policy EndpointPolicy) String() string {
+	switch policy {
+	case EndpointAuto:
+		return "Auto"
+	case EndpointKeepAdvertised:
+		return "KeepAdvertised"
+	case EndpointForceHost:
+		return "ForceHost"
+	case EndpointForceHostPort:
+		return "ForceHostPort"
+	}
+	return "EndpointPolicy(" + strconv.Itoa(int(policy)) + ")"
+}
+
+// rewriteEndpoint applies the endpoint mapper, policy and path prefix of the
+// device params to the address advertised for service
+func (dev *Device) rewriteEndpoint(service, advertised string) string {
+	if dev.params.EndpointMapper != nil {
+		if mapped := dev.params.EndpointMapper(service, advertised); mapped != "" {
+			return mapped
+		}
+	}
+
+	u, err := url.Parse(advertised)
+	if err != nil {
+		return advertised
+	}
+
+	host := dev.params.EndpointHost
+	if host == "" {
+		host = dev.params.Xaddr
+	}
+	switch dev.params.EndpointPolicy {
+	case EndpointKeepAdvertised:
+	case EndpointForceHost:
+		u.Host = joinHostPort(xaddrHostname(host), u.Port())
+	case EndpointForceHostPort:
+		u.Host = host
+	default:
+		if u.Scheme == "https" {
+			u.Host = joinHostPort(xaddrHostname(host), u.Port())
+		} else {
+			u.Host = host
+		}
+	}
+
+	if prefix := strings.TrimSuffix(dev.params.EndpointPathPrefix, "/"); prefix != "" {
+		if !strings.HasPrefix(prefix, "/") {
+			prefix = "/" + prefix
+		}
+		if u.Path != prefix && !strings.HasPrefix(u.Path, prefix+"/") {
+			u.Path = prefix + u.Path
+		}
+	}
+	return u.String()
+}
+
+// joinHostPort builds a URL host from a hostname and an optional port
+func joinHostPort(host, port string) string {
+	if port != "" {
+		return net.JoinHostPort(host, port)
+	}
+	if strings.Contains(host, ":") {
+		return "[" + host + "]"
+	}
+	return host
+}
+
+// xaddrHostname returns the host part of a host[:port] address
+func xaddrHostname(xaddr string) string {
+	if host, _, err := net.SplitHostPort(xaddr); err == nil {
+		return host
+	}
+	return strings.Trim(xaddr, "[]")
+}
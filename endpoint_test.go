@@ -0,0 +1,55 @@
+package gonvif
+
+import "testing"
+
+func TestRewriteEndpoint(t *testing.T) {
+	// mapper sends the events elsewhere and leaves the other services to the policy
+	mapper := func(service, advertised string) string {
+		if service == "events" {
+			return "http://events.local/" + service
+		}
+		return ""
+	}
+	tests := []struct {
+		name       string
+		params     DeviceParams
+		service    string
+		advertised string
+		want       string
+	}{
+		{"auto", DeviceParams{Xaddr: "203.0.113.7:8080"},
+			"media", "http://192.168.1.64/onvif/media_service", "http://203.0.113.7:8080/onvif/media_service"},
+		{"auto https keeps the port", DeviceParams{Xaddr: "203.0.113.7:8080"},
+			"media", "https://192.168.1.64:8443/onvif/media_service", "https://203.0.113.7:8443/onvif/media_service"},
+		{"auto https default port", DeviceParams{Xaddr: "camera.local:80"},
+			"media", "https://192.168.1.64/onvif/media_service", "https://camera.local/onvif/media_service"},
+		{"keep advertised", DeviceParams{Xaddr: "203.0.113.7:8080", EndpointPolicy: EndpointKeepAdvertised},
+			"ptz", "http://192.168.1.64:2020/onvif/ptz_service", "http://192.168.1.64:2020/onvif/ptz_service"},
+		{"force host", DeviceParams{Xaddr: "203.0.113.7:8080", EndpointPolicy: EndpointForceHost},
+			"ptz", "http://192.168.1.64:2020/onvif/ptz_service", "http://203.0.113.7:2020/onvif/ptz_service"},
+		{"force host without port", DeviceParams{Xaddr: "203.0.113.7:8080", EndpointPolicy: EndpointForceHost},
+			"ptz", "http://192.168.1.64/onvif/ptz_service", "http://203.0.113.7/onvif/ptz_service"},
+		{"force host IPv6", DeviceParams{Xaddr: "[2001:db8::7]:8080", EndpointPolicy: EndpointForceHost},
+			"ptz", "http://192.168.1.64:2020/onvif/ptz_service", "http://[2001:db8::7]:2020/onvif/ptz_service"},
+		{"force host port", DeviceParams{Xaddr: "203.0.113.7:8080", EndpointPolicy: EndpointForceHostPort},
+			"events", "https://192.168.1.64:8443/onvif/events", "https://203.0.113.7:8080/onvif/events"},
+		{"endpoint host", DeviceParams{Xaddr: "203.0.113.7:8080", EndpointHost: "proxy:9000", EndpointPolicy: EndpointForceHostPort},
+			"events", "http://192.168.1.64/onvif/events", "http://proxy:9000/onvif/events"},
+		{"path prefix", DeviceParams{Xaddr: "proxy", EndpointPathPrefix: "cam1/"},
+			"media", "http://192.168.1.64/onvif/media_service", "http://proxy/cam1/onvif/media_service"},
+		{"path prefix applied once", DeviceParams{Xaddr: "proxy", EndpointPathPrefix: "/cam1"},
+			"media", "http://192.168.1.64/cam1/onvif/media_service", "http://proxy/cam1/onvif/media_service"},
+		{"mapper", DeviceParams{Xaddr: "proxy", EndpointPathPrefix: "/cam1", EndpointMapper: mapper},
+			"events", "http://192.168.1.64/onvif/events", "http://events.local/events"},
+		{"mapper fallback", DeviceParams{Xaddr: "proxy", EndpointPathPrefix: "/cam1", EndpointMapper: mapper},
+			"media", "http://192.168.1.64/onvif/media_service", "http://proxy/cam1/onvif/media_service"},
+		{"invalid address", DeviceParams{Xaddr: "proxy"},
+			"media", "http://[::1/onvif", "http://[::1/onvif"},
+	}
+	for _, test := range tests {
+		dev := &Device{params: test.params}
+		if got := dev.rewriteEndpoint(test.service, test.advertised); got != test.want {
+			t.Errorf("%s: rewriteEndpoint(%s) = %s, want %s", test.name, test.advertised, got, test.want)
+		}
+	}
+}
@@ -59,10 +59,10 @@ type UnsubscribeResponse struct { //http://docs.oasis-open.org/wsn/b-2.xsd
 //CreatePullPointSubscription action
 //BUG(r) Bad AbsoluteOrRelativeTimeType type
 type CreatePullPointSubscription struct {
-	XMLName                string                     `xml:"tev:CreatePullPointSubscription"`
-	Filter                 FilterType                 `xml:"tev:Filter"`
-	InitialTerminationTime AbsoluteOrRelativeTimeType `xml:"wsnt:InitialTerminationTime"`
-	SubscriptionPolicy     SubscriptionPolicy         `xml:"wsnt:sSubscriptionPolicy"`
+	XMLName                string                      `xml:"tev:CreatePullPointSubscription"`
+	Filter                 *FilterType                 `xml:"tev:Filter,omitempty"`
+	InitialTerminationTime *AbsoluteOrRelativeTimeType `xml:"tev:InitialTerminationTime,omitempty"`
+	SubscriptionPolicy     *SubscriptionPolicy         `xml:"tev:SubscriptionPolicy,omitempty"`
 }
 
 //CreatePullPointSubscriptionResponse action
@@ -79,13 +79,13 @@ type GetEventProperties struct {
 
 //GetEventPropertiesResponse action
 type GetEventPropertiesResponse struct {
-	TopicNamespaceLocation          xsd.AnyURI
+	TopicNamespaceLocation          []xsd.AnyURI
 	FixedTopicSet                   FixedTopicSet
 	TopicSet                        TopicSet
-	TopicExpressionDialect          TopicExpressionDialect
-	MessageContentFilterDialect     xsd.AnyURI
-	ProducerPropertiesFilterDialect xsd.AnyURI
-	MessageContentSchemaLocation    xsd.AnyURI
+	TopicExpressionDialect          []TopicExpressionDialect
+	MessageContentFilterDialect     []xsd.AnyURI
+	ProducerPropertiesFilterDialect []xsd.AnyURI
+	MessageContentSchemaLocation    []xsd.AnyURI
 }
 
 //Port type PullPointSubscription
@@ -101,7 +101,7 @@ type PullMessages struct {
 type PullMessagesResponse struct {
 	CurrentTime         CurrentTime
 	TerminationTime     TerminationTime
-	NotificationMessage NotificationMessage
+	NotificationMessage []NotificationMessage
 }
 
 //PullMessagesFaultResponse response type
@@ -114,7 +114,7 @@ type PullMessagesFaultResponse struct {
 type Seek struct {
 	XMLName string       `xml:"tev:Seek"`
 	UtcTime xsd.DateTime `xml:"tev:UtcTime"`
-	Reverse xsd.Boolean  `xml:"tev:Reverse"`
+	Reverse *xsd.Boolean `xml:"tev:Reverse,omitempty"`
 }
 
 //SeekResponse action
@@ -39,12 +39,12 @@ func main() {
 
 	//Preparing commands
 	systemDateAndTyme := device.GetSystemDateAndTime{}
-	getCapabilities := device.GetCapabilities{Category: "All"}
-	createUser := device.CreateUsers{User: onvif.User{
+	getCapabilities := device.GetCapabilities{Category: []onvif.CapabilityCategory{"All"}}
+	createUser := device.CreateUsers{User: []onvif.User{{
 		Username:  "TestUser",
 		Password:  "TestPassword",
 		UserLevel: "User",
-	},
+	}},
 	}
 
 	//Commands execution
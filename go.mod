@@ -3,7 +3,6 @@ module github.com/sonnt85/gonvif
 go 1.18
 
 require (
-	github.com/aler9/gortsplib v1.0.1
 	github.com/antchfx/xmlquery v1.3.18
 	github.com/beevik/etree v1.3.0
 	github.com/elgs/gostrgen v0.0.0-20220325073726-0c3e00d082f6
@@ -37,10 +36,6 @@ require (
 	github.com/miekg/dns v1.1.27 // indirect
 	github.com/mitchellh/mapstructure v1.5.0 // indirect
 	github.com/pelletier/go-toml/v2 v2.2.0 // indirect
-	github.com/pion/randutil v0.1.0 // indirect
-	github.com/pion/rtcp v1.2.9 // indirect
-	github.com/pion/rtp v1.7.13 // indirect
-	github.com/pion/sdp/v3 v3.0.5 // indirect
 	github.com/rs/xid v1.5.0 // indirect
 	github.com/sagikazarmark/locafero v0.4.0 // indirect
 	github.com/sagikazarmark/slog-shim v0.1.0 // indirect
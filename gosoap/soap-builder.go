@@ -12,7 +12,12 @@ type SoapMessage string
 
 // NewEmptySOAP return new SoapMessage
 func NewEmptySOAP() SoapMessage {
-	doc := buildSoapRoot()
+	return NewEmptySOAPVersion(SOAP12)
+}
+
+// NewEmptySOAPVersion return new SoapMessage with the envelope of the given SOAP version
+func NewEmptySOAPVersion(version SoapVersion) SoapMessage {
+	doc := buildSoapRoot(version)
 	//doc.IndentTabs()
 
 	res, _ := doc.WriteToString()
@@ -22,7 +27,7 @@ func NewEmptySOAP() SoapMessage {
 
 //NewSOAP Get a new soap message
 func NewSOAP(headContent []*etree.Element, bodyContent []*etree.Element, namespaces map[string]string) SoapMessage {
-	doc := buildSoapRoot()
+	doc := buildSoapRoot(SOAP12)
 	//doc.IndentTabs()
 
 	res, _ := doc.WriteToString()
@@ -234,7 +239,7 @@ func (msg *SoapMessage) AddRootNamespaces(namespaces map[string]string) {
 		*msg = SoapMessage(res)*/
 }
 
-func buildSoapRoot() *etree.Document {
+func buildSoapRoot(version SoapVersion) *etree.Document {
 	doc := etree.NewDocument()
 
 	doc.CreateProcInst("xml", `version="1.0" encoding="UTF-8"`)
@@ -243,8 +248,8 @@ func buildSoapRoot() *etree.Document {
 	env.CreateElement("soap-env:Header")
 	env.CreateElement("soap-env:Body")
 
-	env.CreateAttr("xmlns:soap-env", "http://www.w3.org/2003/05/soap-envelope")
-	env.CreateAttr("xmlns:soap-enc", "http://www.w3.org/2003/05/soap-encoding")
+	env.CreateAttr("xmlns:soap-env", version.EnvelopeNamespace())
+	env.CreateAttr("xmlns:soap-enc", version.EncodingNamespace())
 
 	return doc
 }
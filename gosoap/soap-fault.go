@@ -0,0 +1,76 @@
+package gosoap
+
+import (
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// Fault is the SOAP 1.1 or SOAP 1.2 fault returned in the envelope body
+type Fault struct {
+	Code    string
+	Subcode string
+	Reason  string
+	Detail  string
+}
+
+func (fault *Fault) Error() string {
+	msg := "soap fault " + fault.Code
+	if fault.Subcode != "" {
+		msg += " (" + fault.Subcode + ")"
+	}
+	if fault.Reason != "" {
+		msg += ": " + fault.Reason
+	}
+	return msg
+}
+
+// IsVersionMismatch reports whether the device rejected the envelope namespace
+func (fault *Fault) IsVersionMismatch() bool {
+	return localName(fault.Code) == "VersionMismatch"
+}
+
+// ParseFault returns the fault carried by a SOAP envelope, nil if there is none
+func ParseFault(data []byte) *Fault {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		return nil
+	}
+	element := doc.FindElement("./Envelope/Body/Fault")
+	if element == nil {
+		return nil
+	}
+
+	fault := new(Fault)
+	//SOAP 1.2
+	if code := element.FindElement("./Code/Value"); code != nil {
+		fault.Code = strings.TrimSpace(code.Text())
+	}
+	if subcode := element.FindElement("./Code/Subcode/Value"); subcode != nil {
+		fault.Subcode = strings.TrimSpace(subcode.Text())
+	}
+	if reason := element.FindElement("./Reason/Text"); reason != nil {
+		fault.Reason = strings.TrimSpace(reason.Text())
+	}
+	if detail := element.FindElement("./Detail"); detail != nil {
+		fault.Detail = strings.TrimSpace(detail.Text())
+	}
+	//SOAP 1.1
+	if code := element.FindElement("./faultcode"); code != nil {
+		fault.Code = strings.TrimSpace(code.Text())
+	}
+	if reason := element.FindElement("./faultstring"); reason != nil {
+		fault.Reason = strings.TrimSpace(reason.Text())
+	}
+	if detail := element.FindElement("./detail"); detail != nil {
+		fault.Detail = strings.TrimSpace(detail.Text())
+	}
+	return fault
+}
+
+func localName(name string) string {
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
@@ -0,0 +1,64 @@
+package gosoap
+
+import "testing"
+
+func TestParseFault(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		fault    *Fault
+		mismatch bool
+	}{
+		{
+			name: "SOAP 1.1 version mismatch",
+			body: `<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body><s:Fault>
+				<faultcode>s:VersionMismatch</faultcode><faultstring>Wrong envelope namespace</faultstring>
+			</s:Fault></s:Body></s:Envelope>`,
+			fault:    &Fault{Code: "s:VersionMismatch", Reason: "Wrong envelope namespace"},
+			mismatch: true,
+		},
+		{
+			name: "SOAP 1.2 version mismatch",
+			body: `<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope"><env:Body><env:Fault>
+				<env:Code><env:Value>env:VersionMismatch</env:Value></env:Code>
+				<env:Reason><env:Text xml:lang="en">Version mismatch</env:Text></env:Reason>
+			</env:Fault></env:Body></env:Envelope>`,
+			fault:    &Fault{Code: "env:VersionMismatch", Reason: "Version mismatch"},
+			mismatch: true,
+		},
+		{
+			name: "SOAP 1.2 sender fault",
+			body: `<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope"><env:Body><env:Fault>
+				<env:Code><env:Value>env:Sender</env:Value><env:Subcode><env:Value>ter:NotAuthorized</env:Value></env:Subcode></env:Code>
+				<env:Reason><env:Text xml:lang="en">Sender not authorized</env:Text></env:Reason>
+			</env:Fault></env:Body></env:Envelope>`,
+			fault: &Fault{Code: "env:Sender", Subcode: "ter:NotAuthorized", Reason: "Sender not authorized"},
+		},
+		{
+			name: "response without fault",
+			body: `<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope"><env:Body>
+				<tds:GetCapabilitiesResponse xmlns:tds="http://www.onvif.org/ver10/device/wsdl"/>
+			</env:Body></env:Envelope>`,
+		},
+		{
+			name: "not XML",
+			body: `<html><body>Unsupported Media Type`,
+		},
+	}
+	for _, test := range tests {
+		fault := ParseFault([]byte(test.body))
+		if test.fault == nil {
+			if fault != nil {
+				t.Errorf("%s: unexpected fault %+v", test.name, fault)
+			}
+			continue
+		}
+		if fault == nil || *fault != *test.fault {
+			t.Errorf("%s: ParseFault = %+v, want %+v", test.name, fault, test.fault)
+			continue
+		}
+		if fault.IsVersionMismatch() != test.mismatch {
+			t.Errorf("%s: IsVersionMismatch = %v", test.name, !test.mismatch)
+		}
+	}
+}
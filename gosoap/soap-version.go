@@ -0,0 +1,51 @@
+package gosoap
+
+import "fmt"
+
+// SoapVersion selects the envelope namespace and HTTP binding of a SOAP message
+type SoapVersion int
+
+// Supported SOAP versions. SOAP 1.2 is the ONVIF default, SOAP 1.1 is kept for legacy devices
+const (
+	SOAP12 SoapVersion = iota
+	SOAP11
+)
+
+func (version SoapVersion) String() string {
+	switch version {
+	case SOAP12:
+		return "1.2"
+	case SOAP11:
+		return "1.1"
+	default:
+		return fmt.Sprintf("SoapVersion(%d)", int(version))
+	}
+}
+
+// EnvelopeNamespace returns the namespace of the Envelope element
+func (version SoapVersion) EnvelopeNamespace() string {
+	if version == SOAP11 {
+		return "http://schemas.xmlsoap.org/soap/envelope/"
+	}
+	return "http://www.w3.org/2003/05/soap-envelope"
+}
+
+// EncodingNamespace returns the namespace of the SOAP encoding rules
+func (version SoapVersion) EncodingNamespace() string {
+	if version == SOAP11 {
+		return "http://schemas.xmlsoap.org/soap/encoding/"
+	}
+	return "http://www.w3.org/2003/05/soap-encoding"
+}
+
+// ContentType returns the HTTP Content-Type of a message carrying the given action.
+// SOAP 1.1 sends the action in the SOAPAction header instead, see networking.SendSoapVersion
+func (version SoapVersion) ContentType(action string) string {
+	if version == SOAP11 {
+		return "text/xml; charset=utf-8"
+	}
+	if action == "" {
+		return "application/soap+xml; charset=utf-8"
+	}
+	return `application/soap+xml; charset=utf-8; action="` + action + `"`
+}
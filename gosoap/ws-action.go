@@ -2,8 +2,13 @@ package gosoap
 
 import (
 	"encoding/xml"
+	"strings"
+	"sync"
 )
 
+// actionsMu guards actionHeaders against RegisterAction
+var actionsMu sync.RWMutex
+
 //Xlmns XML Scheam
 var actionHeaders = map[string]string{
 	"wsnt:Subscribe":     "http://docs.oasis-open.org/wsn/bw-2/NotificationProducer/SubscribeRequest",
@@ -41,3 +46,34 @@ func NewAction(key, value string) Action {
 
 	return auth
 }
+
+// RegisterAction sets the action URI sent for an operation element tag
+// (prefix:Operation), generated service packages register their WSDL actions
+// from init
+func RegisterAction(tag, action string) {
+	actionsMu.Lock()
+	defer actionsMu.Unlock()
+	actionHeaders[tag] = action
+}
+
+// SoapAction returns the action URI of an operation element tag (prefix:Operation).
+// WS-Notification operations use their fixed actions, ONVIF operations are
+// addressed as <service namespace>/<Operation>
+func SoapAction(tag string, namespaces map[string]string) string {
+	actionsMu.RLock()
+	defer actionsMu.RUnlock()
+	if action, ok := actionHeaders[tag]; ok {
+		return action
+	}
+	prefix, operation := "", tag
+	if i := strings.Index(tag, ":"); i >= 0 {
+		prefix, operation = tag[:i], tag[i+1:]
+	}
+	if action, ok := actionHeaders[operation]; ok {
+		return action
+	}
+	if namespace, ok := namespaces[prefix]; ok && prefix != "" {
+		return namespace + "/" + operation
+	}
+	return ""
+}
@@ -0,0 +1,32 @@
+package gosoap
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestRegisterActionConcurrent(t *testing.T) {
+	namespaces := map[string]string{"tds": "http://www.onvif.org/ver10/device/wsdl"}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		tag := fmt.Sprintf("tds:Operation%d", i)
+		go func() {
+			defer wg.Done()
+			RegisterAction(tag, "urn:test:"+tag)
+		}()
+		go func() {
+			defer wg.Done()
+			SoapAction("tds:GetProfiles", namespaces)
+		}()
+	}
+	wg.Wait()
+
+	if got := SoapAction("tds:Operation3", namespaces); got != "urn:test:tds:Operation3" {
+		t.Errorf("registered action = %q", got)
+	}
+	if got := SoapAction("tds:GetDeviceInformation", namespaces); got != "http://www.onvif.org/ver10/device/wsdl/GetDeviceInformation" {
+		t.Errorf("default action = %q", got)
+	}
+}
@@ -0,0 +1,14 @@
+package gonvif
+
+import (
+	imaging "github.com/sonnt85/gonvif/Imaging"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Device reads and changes imaging settings with imaging.NewClient
+var _ imaging.Caller = Device{}
+
+// Imaging returns the imaging.Client of the video source videoSourceToken
+func (dev Device) Imaging(videoSourceToken onvif.ReferenceToken) *imaging.Client {
+	return imaging.NewClient(dev, videoSourceToken)
+}
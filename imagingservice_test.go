@@ -0,0 +1,55 @@
+package gonvif
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	imaging "github.com/sonnt85/gonvif/Imaging"
+)
+
+const imagingEnvelope = `<?xml version="1.0" encoding="UTF-8"?>
+<s:Envelope xmlns:s="http://www.w3.org/2003/05/soap-envelope" xmlns:tt="http://www.onvif.org/ver10/schema"
+ xmlns:timg="http://www.onvif.org/ver20/imaging/wsdl"><s:Body>%s</s:Body></s:Envelope>`
+
+func TestImagingResponses(t *testing.T) {
+	var options imaging.GetOptionsResponse
+	err := UnmarshalResponse([]byte(strings.Replace(imagingEnvelope, "%s", `<timg:GetOptionsResponse><timg:ImagingOptions>
+		<tt:Brightness><tt:Min>0</tt:Min><tt:Max>100</tt:Max></tt:Brightness>
+		<tt:Exposure><tt:Mode>AUTO</tt:Mode><tt:Mode>MANUAL</tt:Mode>
+			<tt:ExposureTime><tt:Min>10</tt:Min><tt:Max>40000</tt:Max></tt:ExposureTime></tt:Exposure>
+		<tt:IrCutFilterModes>ON</tt:IrCutFilterModes><tt:IrCutFilterModes>OFF</tt:IrCutFilterModes><tt:IrCutFilterModes>AUTO</tt:IrCutFilterModes>
+		<tt:WhiteBalance><tt:Mode>AUTO</tt:Mode></tt:WhiteBalance>
+	</timg:ImagingOptions></timg:GetOptionsResponse>`, 1)), &options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := options.ImagingOptions
+	if o.Brightness == nil || o.Brightness.Max != 100 || len(o.Exposure.Mode) != 2 || o.Exposure.ExposureTime.Max != 40000 ||
+		len(o.IrCutFilterModes) != 3 || o.Contrast != nil || o.Focus != nil {
+		t.Errorf("unexpected options %+v", o)
+	}
+
+	var settings imaging.GetImagingSettingsResponse
+	err = UnmarshalResponse([]byte(strings.Replace(imagingEnvelope, "%s", `<timg:GetImagingSettingsResponse><timg:ImagingSettings>
+		<tt:Brightness>50</tt:Brightness>
+		<tt:Exposure><tt:Mode>MANUAL</tt:Mode><tt:ExposureTime>20000</tt:ExposureTime></tt:Exposure>
+		<tt:IrCutFilter>AUTO</tt:IrCutFilter>
+	</timg:ImagingSettings></timg:GetImagingSettingsResponse>`, 1)), &settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := settings.ImagingSettings
+	if *s.Brightness != 50 || *s.Exposure.ExposureTime != 20000 || s.IrCutFilter != "AUTO" || s.Contrast != nil {
+		t.Errorf("unexpected settings %+v", s)
+	}
+	if err := imaging.Validate(s, o); err != nil {
+		t.Errorf("device settings refused: %v", err)
+	}
+
+	s.IrCutFilter = "NIGHT"
+	var optionErr *imaging.OptionError
+	if err := imaging.Validate(s, o); !errors.As(err, &optionErr) || optionErr.Field != "IrCutFilter" {
+		t.Errorf("unsupported IrCutFilter: %v", err)
+	}
+}
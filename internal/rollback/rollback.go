@@ -0,0 +1,17 @@
+// Package rollback bounds the calls that undo a failed multi-step operation
+package rollback
+
+import (
+	"context"
+	"time"
+)
+
+// Timeout bounds a rollback
+const Timeout = 10 * time.Second
+
+// Context returns the context of a rollback. It does not derive from the
+// context of the operation, which may be the reason of the failure, and is
+// bounded by Timeout instead
+func Context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), Timeout)
+}
@@ -0,0 +1,351 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// EncoderSettings are the values requested by TuneVideoEncoder, zero values
+// keep the current setting of the encoder
+type EncoderSettings struct {
+	// Encoding switches the codec: JPEG, MPEG4 or H264
+	Encoding onvif.VideoEncoding
+	// Width and Height are matched to the closest available resolution
+	Width  int
+	Height int
+	// FrameRate, GovLength, Bitrate (kbit/s) and Quality are clamped to the option ranges
+	FrameRate int
+	GovLength int
+	Bitrate   int
+	Quality   float64
+	// H264Profile must be one of the supported profiles
+	H264Profile onvif.H264Profile
+	// ForcePersistence asks the device to keep the change across reboots
+	ForcePersistence bool
+}
+
+// EncoderFieldDiff is the value of an encoder setting along the tuning
+type EncoderFieldDiff struct {
+	Field string
+	// Before is the value read before the change
+	Before string
+	// Requested is the value of EncoderSettings, empty when not requested
+	Requested string
+	// Applied is the value sent after validation against the options
+	Applied string
+	// Stored is the value read back from the device
+	Stored string
+}
+
+// Clamped reports whether the requested value was adjusted to the options
+func (diff EncoderFieldDiff) Clamped() bool {
+	return diff.Requested != "" && diff.Requested != diff.Applied
+}
+
+// Ignored reports whether the device stored another value than the one sent
+func (diff EncoderFieldDiff) Ignored() bool {
+	return diff.Applied != diff.Stored
+}
+
+// EncoderTuning is the result of TuneVideoEncoder
+type EncoderTuning struct {
+	Before  onvif.VideoEncoderConfiguration
+	Applied onvif.VideoEncoderConfiguration
+	Stored  onvif.VideoEncoderConfiguration
+	// Diff lists the settings that were requested or changed
+	Diff []EncoderFieldDiff
+}
+
+// EncoderOptionError is returned when a requested setting is not supported
+// and has no closest value, like an encoding or a profile
+type EncoderOptionError struct {
+	Field     string
+	Value     string
+	Supported []string
+}
+
+func (err *EncoderOptionError) Error() string {
+	return fmt.Sprintf("%s %s is not supported, supported: %v", err.Field, err.Value, err.Supported)
+}
+
+// TuneVideoEncoder changes the video encoder configuration configToken:
+// it fetches GetVideoEncoderConfigurationOptions (for profileToken when not
+// empty), validates and clamps settings against them, applies the change
+// with SetVideoEncoderConfiguration and reads the configuration back
+func TuneVideoEncoder(ctx context.Context, caller Caller, configToken, profileToken onvif.ReferenceToken, settings EncoderSettings) (*EncoderTuning, error) {
+	var current GetVideoEncoderConfigurationResponse
+	if err := caller.CallMethodUnmarshal(ctx, GetVideoEncoderConfiguration{ConfigurationToken: configToken}, &current); err != nil {
+		return nil, err
+	}
+	var options GetVideoEncoderConfigurationOptionsResponse
+	request := GetVideoEncoderConfigurationOptions{ConfigurationToken: configToken, ProfileToken: profileToken}
+	if err := caller.CallMethodUnmarshal(ctx, request, &options); err != nil {
+		return nil, err
+	}
+
+	tuning := &EncoderTuning{Before: current.Configuration}
+	applied, err := applyEncoderSettings(current.Configuration, options.Options, settings)
+	if err != nil {
+		return nil, err
+	}
+	tuning.Applied = applied
+
+	set := SetVideoEncoderConfiguration{Configuration: applied, ForcePersistence: xsd.Boolean(settings.ForcePersistence)}
+	if err := caller.CallMethodUnmarshal(ctx, set, &SetVideoEncoderConfigurationResponse{}); err != nil {
+		return tuning, err
+	}
+
+	var stored GetVideoEncoderConfigurationResponse
+	if err := caller.CallMethodUnmarshal(ctx, GetVideoEncoderConfiguration{ConfigurationToken: configToken}, &stored); err != nil {
+		return tuning, err
+	}
+	tuning.Stored = stored.Configuration
+	tuning.Diff = encoderDiff(tuning.Before, tuning.Applied, tuning.Stored, settings)
+	return tuning, nil
+}
+
+// encoderOptions are the options of one encoding
+type encoderOptions struct {
+	resolutions []onvif.VideoResolution
+	frameRate   onvif.IntRange
+	govLength   onvif.IntRange
+	bitrate     onvif.IntRange
+	profiles    []string
+}
+
+func optionsOf(options onvif.VideoEncoderConfigurationOptions, encoding onvif.VideoEncoding) (encoderOptions, bool) {
+	switch encoding {
+	case "JPEG":
+		return encoderOptions{
+			resolutions: options.JPEG.ResolutionsAvailable,
+			frameRate:   options.JPEG.FrameRateRange,
+			bitrate:     options.Extension.JPEG.BitrateRange,
+		}, len(options.JPEG.ResolutionsAvailable) != 0
+	case "MPEG4":
+		profiles := make([]string, len(options.MPEG4.Mpeg4ProfilesSupported))
+		for i, profile := range options.MPEG4.Mpeg4ProfilesSupported {
+			profiles[i] = string(profile)
+		}
+		return encoderOptions{
+			resolutions: options.MPEG4.ResolutionsAvailable,
+			frameRate:   options.MPEG4.FrameRateRange,
+			govLength:   options.MPEG4.GovLengthRange,
+			bitrate:     options.Extension.MPEG4.BitrateRange,
+			profiles:    profiles,
+		}, len(options.MPEG4.ResolutionsAvailable) != 0
+	case "H264":
+		profiles := make([]string, len(options.H264.H264ProfilesSupported))
+		for i, profile := range options.H264.H264ProfilesSupported {
+			profiles[i] = string(profile)
+		}
+		return encoderOptions{
+			resolutions: options.H264.ResolutionsAvailable,
+			frameRate:   options.H264.FrameRateRange,
+			govLength:   options.H264.GovLengthRange,
+			bitrate:     options.Extension.H264.BitrateRange,
+			profiles:    profiles,
+		}, len(options.H264.ResolutionsAvailable) != 0
+	}
+	return encoderOptions{}, false
+}
+
+// applyEncoderSettings returns config changed by settings within options
+func applyEncoderSettings(config onvif.VideoEncoderConfiguration, options onvif.VideoEncoderConfigurationOptions, settings EncoderSettings) (onvif.VideoEncoderConfiguration, error) {
+	if settings.Encoding != "" {
+		config.Encoding = settings.Encoding
+	}
+	opts, ok := optionsOf(options, config.Encoding)
+	if !ok {
+		supported := []string{}
+		for _, encoding := range []onvif.VideoEncoding{"JPEG", "MPEG4", "H264"} {
+			if _, ok := optionsOf(options, encoding); ok {
+				supported = append(supported, string(encoding))
+			}
+		}
+		return config, &EncoderOptionError{Field: "Encoding", Value: string(config.Encoding), Supported: supported}
+	}
+
+	width, height := int(config.Resolution.Width), int(config.Resolution.Height)
+	if settings.Width != 0 {
+		width = settings.Width
+	}
+	if settings.Height != 0 {
+		height = settings.Height
+	}
+	config.Resolution = closestResolution(opts.resolutions, width, height)
+
+	frameRate := int(config.RateControl.FrameRateLimit)
+	if settings.FrameRate != 0 {
+		frameRate = settings.FrameRate
+	}
+	config.RateControl.FrameRateLimit = xsd.Int(clamp(frameRate, opts.frameRate))
+
+	if settings.Bitrate != 0 {
+		config.RateControl.BitrateLimit = xsd.Int(clamp(settings.Bitrate, opts.bitrate))
+	}
+	if settings.Quality != 0 {
+		quality := settings.Quality
+		if options.QualityRange.Max > options.QualityRange.Min {
+			if quality < float64(options.QualityRange.Min) {
+				quality = float64(options.QualityRange.Min)
+			} else if quality > float64(options.QualityRange.Max) {
+				quality = float64(options.QualityRange.Max)
+			}
+		}
+		config.Quality = quality
+	}
+
+	switch config.Encoding {
+	case "H264":
+		h264 := onvif.H264Configuration{}
+		if config.H264 != nil {
+			h264 = *config.H264
+		}
+		if settings.GovLength != 0 {
+			h264.GovLength = xsd.Int(settings.GovLength)
+		}
+		h264.GovLength = xsd.Int(clamp(int(h264.GovLength), opts.govLength))
+		if settings.H264Profile != "" {
+			h264.H264Profile = settings.H264Profile
+		}
+		if h264.H264Profile == "" && len(opts.profiles) != 0 {
+			h264.H264Profile = onvif.H264Profile(opts.profiles[0])
+		}
+		if len(opts.profiles) != 0 && !containsString(opts.profiles, string(h264.H264Profile)) {
+			return config, &EncoderOptionError{Field: "H264Profile", Value: string(h264.H264Profile), Supported: opts.profiles}
+		}
+		config.H264, config.MPEG4 = &h264, nil
+	case "MPEG4":
+		mpeg4 := onvif.Mpeg4Configuration{}
+		if config.MPEG4 != nil {
+			mpeg4 = *config.MPEG4
+		}
+		if settings.GovLength != 0 {
+			mpeg4.GovLength = xsd.Int(settings.GovLength)
+		}
+		mpeg4.GovLength = xsd.Int(clamp(int(mpeg4.GovLength), opts.govLength))
+		if mpeg4.Mpeg4Profile == "" && len(opts.profiles) != 0 {
+			mpeg4.Mpeg4Profile = onvif.Mpeg4Profile(opts.profiles[0])
+		}
+		config.H264, config.MPEG4 = nil, &mpeg4
+	default:
+		config.H264, config.MPEG4 = nil, nil
+	}
+	return config, nil
+}
+
+// closestResolution returns the available resolution with the closest number
+// of pixels to width x height, the smaller one on a tie
+func closestResolution(available []onvif.VideoResolution, width, height int) onvif.VideoResolution {
+	best := onvif.VideoResolution{Width: xsd.Int(width), Height: xsd.Int(height)}
+	bestDistance := -1
+	for _, resolution := range available {
+		if int(resolution.Width) == width && int(resolution.Height) == height {
+			return resolution
+		}
+		pixels := int(resolution.Width) * int(resolution.Height)
+		distance := pixels - width*height
+		if distance < 0 {
+			distance = -distance
+		}
+		if bestDistance < 0 || distance < bestDistance ||
+			distance == bestDistance && pixels < int(best.Width)*int(best.Height) {
+			best, bestDistance = resolution, distance
+		}
+	}
+	return best
+}
+
+// clamp bounds value to a range, ranges with Max 0 are not bounded
+func clamp(value int, bounds onvif.IntRange) int {
+	if bounds.Max == 0 && bounds.Min == 0 {
+		return value
+	}
+	if value < bounds.Min {
+		return bounds.Min
+	}
+	if bounds.Max > 0 && value > bounds.Max {
+		return bounds.Max
+	}
+	return value
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// encoderFields returns the compared settings of a configuration
+func encoderFields(config onvif.VideoEncoderConfiguration) map[string]string {
+	fields := map[string]string{
+		"Encoding":   string(config.Encoding),
+		"Resolution": fmt.Sprintf("%dx%d", config.Resolution.Width, config.Resolution.Height),
+		"FrameRate":  strconv.Itoa(int(config.RateControl.FrameRateLimit)),
+		"Bitrate":    strconv.Itoa(int(config.RateControl.BitrateLimit)),
+		"Quality":    strconv.FormatFloat(config.Quality, 'g', -1, 64),
+	}
+	switch {
+	case config.H264 != nil:
+		fields["GovLength"] = strconv.Itoa(int(config.H264.GovLength))
+		fields["H264Profile"] = string(config.H264.H264Profile)
+	case config.MPEG4 != nil:
+		fields["GovLength"] = strconv.Itoa(int(config.MPEG4.GovLength))
+	}
+	return fields
+}
+
+// requestedFields returns the settings asked for, as encoderFields formats them
+func requestedFields(settings EncoderSettings, before onvif.VideoEncoderConfiguration) map[string]string {
+	fields := map[string]string{}
+	if settings.Encoding != "" {
+		fields["Encoding"] = string(settings.Encoding)
+	}
+	if settings.Width != 0 || settings.Height != 0 {
+		width, height := settings.Width, settings.Height
+		if width == 0 {
+			width = int(before.Resolution.Width)
+		}
+		if height == 0 {
+			height = int(before.Resolution.Height)
+		}
+		fields["Resolution"] = fmt.Sprintf("%dx%d", width, height)
+	}
+	if settings.FrameRate != 0 {
+		fields["FrameRate"] = strconv.Itoa(settings.FrameRate)
+	}
+	if settings.Bitrate != 0 {
+		fields["Bitrate"] = strconv.Itoa(settings.Bitrate)
+	}
+	if settings.Quality != 0 {
+		fields["Quality"] = strconv.FormatFloat(settings.Quality, 'g', -1, 64)
+	}
+	if settings.GovLength != 0 {
+		fields["GovLength"] = strconv.Itoa(settings.GovLength)
+	}
+	if settings.H264Profile != "" {
+		fields["H264Profile"] = string(settings.H264Profile)
+	}
+	return fields
+}
+
+// encoderDiff lists the settings requested or differing between the stages
+func encoderDiff(before, applied, stored onvif.VideoEncoderConfiguration, settings EncoderSettings) []EncoderFieldDiff {
+	b, a, s := encoderFields(before), encoderFields(applied), encoderFields(stored)
+	requested := requestedFields(settings, before)
+	var diff []EncoderFieldDiff
+	for _, field := range []string{"Encoding", "Resolution", "FrameRate", "Bitrate", "Quality", "GovLength", "H264Profile"} {
+		d := EncoderFieldDiff{Field: field, Before: b[field], Requested: requested[field], Applied: a[field], Stored: s[field]}
+		if d.Requested != "" || d.Before != d.Applied || d.Applied != d.Stored {
+			diff = append(diff, d)
+		}
+	}
+	return diff
+}
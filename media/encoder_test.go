@@ -0,0 +1,62 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+func TestTuneVideoEncoder(t *testing.T) {
+	caller := &mediaCamera{config: onvif.VideoEncoderConfiguration{
+		ConfigurationEntity: onvif.ConfigurationEntity{Token: "enc"},
+		Encoding:            "H264",
+		Resolution:          onvif.VideoResolution{Width: 640, Height: 360},
+		Quality:             4,
+		RateControl:         onvif.VideoRateControl{FrameRateLimit: 15, BitrateLimit: 1024},
+		H264:                &onvif.H264Configuration{GovLength: 30, H264Profile: "Main"},
+	}}
+	tuning, err := TuneVideoEncoder(context.Background(), caller, "enc", "", EncoderSettings{
+		Width: 1280, Height: 800, FrameRate: 30, Bitrate: 6000, GovLength: 50,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if caller.sets != 1 {
+		t.Errorf("got %d SetVideoEncoderConfiguration, want 1", caller.sets)
+	}
+	want := map[string]EncoderFieldDiff{
+		"Resolution": {Field: "Resolution", Before: "640x360", Requested: "1280x800", Applied: "1280x720", Stored: "1280x720"},
+		"FrameRate":  {Field: "FrameRate", Before: "15", Requested: "30", Applied: "25", Stored: "25"},
+		"Bitrate":    {Field: "Bitrate", Before: "1024", Requested: "6000", Applied: "6000", Stored: "4000"},
+		"GovLength":  {Field: "GovLength", Before: "30", Requested: "50", Applied: "50", Stored: "50"},
+	}
+	if len(tuning.Diff) != len(want) {
+		t.Fatalf("diff %+v", tuning.Diff)
+	}
+	for _, diff := range tuning.Diff {
+		if diff != want[diff.Field] {
+			t.Errorf("diff %+v, want %+v", diff, want[diff.Field])
+		}
+		if clamped := diff.Field == "Resolution" || diff.Field == "FrameRate"; diff.Clamped() != clamped {
+			t.Errorf("%s clamped %v", diff.Field, diff.Clamped())
+		}
+		if diff.Ignored() != (diff.Field == "Bitrate") {
+			t.Errorf("%s ignored %v", diff.Field, diff.Ignored())
+		}
+	}
+
+	_, err = TuneVideoEncoder(context.Background(), caller, "enc", "", EncoderSettings{H264Profile: "High"})
+	var optionErr *EncoderOptionError
+	if !errors.As(err, &optionErr) || optionErr.Field != "H264Profile" {
+		t.Errorf("unsupported profile: got %v", err)
+	}
+	_, err = TuneVideoEncoder(context.Background(), caller, "enc", "", EncoderSettings{Encoding: "JPEG"})
+	if !errors.As(err, &optionErr) || optionErr.Field != "Encoding" {
+		t.Errorf("unsupported encoding: got %v", err)
+	}
+	if caller.sets != 1 {
+		t.Errorf("invalid settings were applied")
+	}
+}
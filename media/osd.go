@@ -0,0 +1,348 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// OSD types, the text ones are the TextString types of a Text OSD
+const (
+	OSDPlain       = "Plain"
+	OSDDate        = "Date"
+	OSDTime        = "Time"
+	OSDDateAndTime = "DateAndTime"
+	OSDImage       = "Image"
+)
+
+// OSD position presets, OSDCustom places the OSD at OSDPosition.X and Y
+const (
+	OSDUpperLeft  = "UpperLeft"
+	OSDUpperRight = "UpperRight"
+	OSDLowerLeft  = "LowerLeft"
+	OSDLowerRight = "LowerRight"
+	OSDCustom     = "Custom"
+)
+
+// OSDPosition is a preset or, with OSDCustom, a point of the normalized
+// [-1, 1] video coordinates
+type OSDPosition struct {
+	Preset string
+	X, Y   float64
+}
+
+// OSD is a text, date/time or image overlay of a video source configuration
+type OSD struct {
+	Token                         onvif.ReferenceToken
+	VideoSourceConfigurationToken onvif.ReferenceToken
+	// Type is OSDPlain, OSDDate, OSDTime, OSDDateAndTime or OSDImage
+	Type     string
+	Position OSDPosition
+	// Text, DateFormat, TimeFormat, FontSize and the colors apply to text types,
+	// zero values leave the device defaults
+	Text            string
+	DateFormat      string
+	TimeFormat      string
+	FontSize        int
+	FontColor       *onvif.OSDColor
+	BackgroundColor *onvif.OSDColor
+	// ImagePath applies to OSDImage
+	ImagePath string
+}
+
+// NewOSD returns the OSD of an OSD configuration
+func NewOSD(config onvif.OSDConfiguration) OSD {
+	osd := OSD{
+		Token:                         config.Token,
+		VideoSourceConfigurationToken: onvif.ReferenceToken(config.VideoSourceConfigurationToken),
+		Type:                          string(config.Type),
+		Position:                      OSDPosition{Preset: config.Position.Type},
+	}
+	if config.Position.Pos != nil && config.Position.Type == OSDCustom {
+		osd.Position.X, osd.Position.Y = config.Position.Pos.X, config.Position.Pos.Y
+	}
+	if text := config.TextString; text != nil {
+		osd.Type = string(text.Type)
+		osd.Text = string(text.PlainText)
+		osd.DateFormat, osd.TimeFormat = string(text.DateFormat), string(text.TimeFormat)
+		osd.FontSize = int(text.FontSize)
+		osd.FontColor, osd.BackgroundColor = text.FontColor, text.BackgroundColor
+	}
+	if config.Image != nil {
+		osd.ImagePath = string(config.Image.ImgPath)
+	}
+	return osd
+}
+
+// Configuration returns the OSD configuration sent to the device
+func (osd OSD) Configuration() onvif.OSDConfiguration {
+	config := onvif.OSDConfiguration{
+		VideoSourceConfigurationToken: onvif.OSDReference(osd.VideoSourceConfigurationToken),
+		Position:                      onvif.OSDPosConfiguration{Type: osd.Position.Preset},
+	}
+	config.Token = osd.Token
+	if osd.Position.Preset == OSDCustom {
+		config.Position.Pos = &onvif.Vector{X: osd.Position.X, Y: osd.Position.Y}
+	}
+	if osd.Type == OSDImage {
+		config.Type = "Image"
+		config.Image = &onvif.OSDImgConfiguration{ImgPath: xsd.AnyURI(osd.ImagePath)}
+		return config
+	}
+	config.Type = "Text"
+	config.TextString = &onvif.OSDTextConfiguration{
+		Type:            xsd.String(osd.Type),
+		FontSize:        xsd.Int(osd.FontSize),
+		FontColor:       osd.FontColor,
+		BackgroundColor: osd.BackgroundColor,
+	}
+	switch osd.Type {
+	case OSDPlain:
+		config.TextString.PlainText = xsd.String(osd.Text)
+	case OSDDate:
+		config.TextString.DateFormat = xsd.String(osd.DateFormat)
+	case OSDTime:
+		config.TextString.TimeFormat = xsd.String(osd.TimeFormat)
+	case OSDDateAndTime:
+		config.TextString.DateFormat = xsd.String(osd.DateFormat)
+		config.TextString.TimeFormat = xsd.String(osd.TimeFormat)
+	}
+	return config
+}
+
+// OSDOptionError is returned when an OSD setting is not in GetOSDOptions
+type OSDOptionError struct {
+	Field     string
+	Value     string
+	Supported []string
+}
+
+func (err *OSDOptionError) Error() string {
+	return fmt.Sprintf("OSD %s %s is not supported, supported: %v", err.Field, err.Value, err.Supported)
+}
+
+// OSDLimitError is returned when adding an OSD exceeds MaximumNumberOfOSDs
+type OSDLimitError struct {
+	// Type is the OSD type limited, empty for the total
+	Type string
+	Max  int
+}
+
+func (err *OSDLimitError) Error() string {
+	if err.Type == "" {
+		return fmt.Sprintf("maximum number of OSDs reached (%d)", err.Max)
+	}
+	return fmt.Sprintf("maximum number of %s OSDs reached (%d)", err.Type, err.Max)
+}
+
+// ListOSDs returns the OSDs of a video source configuration, all the OSDs
+// of the device when configToken is empty
+func ListOSDs(ctx context.Context, caller Caller, configToken onvif.ReferenceToken) ([]OSD, error) {
+	var response GetOSDsResponse
+	if err := caller.CallMethodUnmarshal(ctx, GetOSDs{ConfigurationToken: configToken}, &response); err != nil {
+		return nil, err
+	}
+	osds := make([]OSD, len(response.OSDs))
+	for i, config := range response.OSDs {
+		osds[i] = NewOSD(config)
+	}
+	return osds, nil
+}
+
+// AddOSD validates osd against the options and the OSDs of its video source
+// configuration then creates it, returning the token of the new OSD
+func AddOSD(ctx context.Context, caller Caller, osd OSD) (onvif.ReferenceToken, error) {
+	osd.Token = ""
+	if err := checkOSD(ctx, caller, osd); err != nil {
+		return "", err
+	}
+	var response CreateOSDResponse
+	if err := caller.CallMethodUnmarshal(ctx, CreateOSD{OSD: osd.Configuration()}, &response); err != nil {
+		return "", err
+	}
+	return response.OSDToken, nil
+}
+
+// UpdateOSD validates osd against the options then replaces the OSD osd.Token
+func UpdateOSD(ctx context.Context, caller Caller, osd OSD) error {
+	if osd.Token == "" {
+		return fmt.Errorf("OSD token is required")
+	}
+	if err := checkOSD(ctx, caller, osd); err != nil {
+		return err
+	}
+	return caller.CallMethodUnmarshal(ctx, SetOSD{OSD: osd.Configuration()}, &SetOSDResponse{})
+}
+
+// RemoveOSD deletes the OSD token
+func RemoveOSD(ctx context.Context, caller Caller, token onvif.ReferenceToken) error {
+	return caller.CallMethodUnmarshal(ctx, DeleteOSD{OSDToken: token}, &DeleteOSDResponse{})
+}
+
+// SetTextOSD shows text at position on the video source configuration,
+// updating the plain text OSD already at this position or adding one
+func SetTextOSD(ctx context.Context, caller Caller, configToken onvif.ReferenceToken, text string, position OSDPosition) (onvif.ReferenceToken, error) {
+	osds, err := ListOSDs(ctx, caller, configToken)
+	if err != nil {
+		return "", err
+	}
+	for _, osd := range osds {
+		if osd.Type == OSDPlain && osd.VideoSourceConfigurationToken == configToken && osd.Position == position {
+			osd.Text = text
+			return osd.Token, UpdateOSD(ctx, caller, osd)
+		}
+	}
+	return AddOSD(ctx, caller, OSD{VideoSourceConfigurationToken: configToken, Type: OSDPlain, Position: position, Text: text})
+}
+
+// checkOSD fetches the options and the OSDs of the video source
+// configuration of osd and validates it
+func checkOSD(ctx context.Context, caller Caller, osd OSD) error {
+	var options GetOSDOptionsResponse
+	if err := caller.CallMethodUnmarshal(ctx, GetOSDOptions{ConfigurationToken: osd.VideoSourceConfigurationToken}, &options); err != nil {
+		return err
+	}
+	existing, err := ListOSDs(ctx, caller, osd.VideoSourceConfigurationToken)
+	if err != nil {
+		return err
+	}
+	return ValidateOSD(osd, options.OSDOptions, existing)
+}
+
+// ValidateOSD checks osd against the options of its video source
+// configuration, existing are the OSDs already configured on it
+func ValidateOSD(osd OSD, options onvif.OSDConfigurationOptions, existing []OSD) error {
+	osdType := "Text"
+	if osd.Type == OSDImage {
+		osdType = "Image"
+	}
+	if len(options.Type) != 0 {
+		supported := make([]string, len(options.Type))
+		for i, t := range options.Type {
+			supported[i] = string(t)
+		}
+		if !containsString(supported, osdType) {
+			return &OSDOptionError{Field: "Type", Value: osdType, Supported: supported}
+		}
+	}
+	if err := checkOSDCount(osd, options.MaximumNumberOfOSDs, existing); err != nil {
+		return err
+	}
+
+	if len(options.PositionOption) != 0 && !containsString(options.PositionOption, osd.Position.Preset) {
+		return &OSDOptionError{Field: "Position", Value: osd.Position.Preset, Supported: options.PositionOption}
+	}
+	if osd.Position.Preset == OSDCustom && (math.Abs(osd.Position.X) > 1 || math.Abs(osd.Position.Y) > 1) {
+		return fmt.Errorf("OSD position %g,%g is out of the [-1, 1] range", osd.Position.X, osd.Position.Y)
+	}
+
+	if osd.Type == OSDImage {
+		if len(options.ImageOption.ImagePath) != 0 {
+			supported := make([]string, len(options.ImageOption.ImagePath))
+			for i, path := range options.ImageOption.ImagePath {
+				supported[i] = string(path)
+			}
+			if !containsString(supported, osd.ImagePath) {
+				return &OSDOptionError{Field: "ImagePath", Value: osd.ImagePath, Supported: supported}
+			}
+		}
+		return nil
+	}
+
+	text := options.TextOption
+	if len(text.Type) != 0 && !containsString(text.Type, osd.Type) {
+		return &OSDOptionError{Field: "TextType", Value: osd.Type, Supported: text.Type}
+	}
+	if osd.DateFormat != "" && len(text.DateFormat) != 0 && !containsString(text.DateFormat, osd.DateFormat) {
+		return &OSDOptionError{Field: "DateFormat", Value: osd.DateFormat, Supported: text.DateFormat}
+	}
+	if osd.TimeFormat != "" && len(text.TimeFormat) != 0 && !containsString(text.TimeFormat, osd.TimeFormat) {
+		return &OSDOptionError{Field: "TimeFormat", Value: osd.TimeFormat, Supported: text.TimeFormat}
+	}
+	if size := text.FontSizeRange; osd.FontSize != 0 && size.Max > 0 && (osd.FontSize < size.Min || osd.FontSize > size.Max) {
+		return &OSDOptionError{Field: "FontSize", Value: strconv.Itoa(osd.FontSize),
+			Supported: []string{fmt.Sprintf("%d-%d", size.Min, size.Max)}}
+	}
+	if err := checkOSDColor("FontColor", osd.FontColor, text.FontColor); err != nil {
+		return err
+	}
+	return checkOSDColor("BackgroundColor", osd.BackgroundColor, text.BackgroundColor)
+}
+
+// checkOSDCount checks the total and per type limits, not counting the OSD
+// being updated
+func checkOSDCount(osd OSD, max onvif.MaximumNumberOfOSDs, existing []OSD) error {
+	limits := map[string]int{
+		OSDImage:       max.Image,
+		OSDPlain:       max.PlainText,
+		OSDDate:        max.Date,
+		OSDTime:        max.Time,
+		OSDDateAndTime: max.DateAndTime,
+	}
+	total, ofType := 0, 0
+	for _, other := range existing {
+		if osd.Token != "" && other.Token == osd.Token {
+			continue
+		}
+		total++
+		if other.Type == osd.Type {
+			ofType++
+		}
+	}
+	if max.Total > 0 && total >= max.Total {
+		return &OSDLimitError{Max: max.Total}
+	}
+	if limit := limits[osd.Type]; limit > 0 && ofType >= limit {
+		return &OSDLimitError{Type: osd.Type, Max: limit}
+	}
+	return nil
+}
+
+// checkOSDColor checks a color against the color list or colorspace ranges
+// and the transparency range of the options
+func checkOSDColor(field string, color *onvif.OSDColor, options onvif.OSDColorOptions) error {
+	if color == nil {
+		return nil
+	}
+	if t := options.Transparent; t.Max > 0 && (color.Transparent < t.Min || color.Transparent > t.Max) {
+		return &OSDOptionError{Field: field + ".Transparent", Value: strconv.Itoa(color.Transparent),
+			Supported: []string{fmt.Sprintf("%d-%d", t.Min, t.Max)}}
+	}
+	list, ranges := options.Color.ColorList, options.Color.ColorspaceRange
+	if len(list) == 0 && len(ranges) == 0 {
+		return nil
+	}
+	c := color.Color
+	const epsilon = 1e-6
+	supported := []string{}
+	for _, option := range list {
+		if math.Abs(option.X-c.X) < epsilon && math.Abs(option.Y-c.Y) < epsilon && math.Abs(option.Z-c.Z) < epsilon &&
+			(c.Colorspace == "" || option.Colorspace == "" || option.Colorspace == c.Colorspace) {
+			return nil
+		}
+		supported = append(supported, formatColor(option))
+	}
+	inRange := func(v float64, r onvif.FloatRange) bool {
+		return v >= r.Min-epsilon && v <= r.Max+epsilon
+	}
+	for _, r := range ranges {
+		if inRange(c.X, r.X) && inRange(c.Y, r.Y) && inRange(c.Z, r.Z) &&
+			(c.Colorspace == "" || r.Colorspace == "" || r.Colorspace == c.Colorspace) {
+			return nil
+		}
+		supported = append(supported, fmt.Sprintf("X %g-%g Y %g-%g Z %g-%g %s", r.X.Min, r.X.Max, r.Y.Min, r.Y.Max, r.Z.Min, r.Z.Max, r.Colorspace))
+	}
+	return &OSDOptionError{Field: field, Value: formatColor(c), Supported: supported}
+}
+
+func formatColor(c onvif.Color) string {
+	s := fmt.Sprintf("%g,%g,%g", c.X, c.Y, c.Z)
+	if c.Colorspace != "" {
+		s += " " + string(c.Colorspace)
+	}
+	return s
+}
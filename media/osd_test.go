@@ -0,0 +1,77 @@
+package media
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+func TestOSD(t *testing.T) {
+	ctx := context.Background()
+	caller := &mediaCamera{}
+	caller.osds = append(caller.osds, OSD{Token: "osd-image", VideoSourceConfigurationToken: "vsc", Type: OSDImage,
+		Position: OSDPosition{Preset: OSDCustom, X: -0.5, Y: 0.5}, ImagePath: "logo.png"}.Configuration())
+
+	token, err := SetTextOSD(ctx, caller, "vsc", "Site A", OSDPosition{Preset: OSDUpperLeft})
+	if err != nil || token != "osd-new" {
+		t.Fatalf("SetTextOSD = %s, %v", token, err)
+	}
+	output, err := xml.Marshal(caller.sent[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, unwanted := range []string{"Image", "FontSize", "Pos ", "Extension"} {
+		if strings.Contains(string(output), unwanted) {
+			t.Errorf("CreateOSD contains %s: %s", unwanted, output)
+		}
+	}
+	if token, err := SetTextOSD(ctx, caller, "vsc", "Site B", OSDPosition{Preset: OSDUpperLeft}); err != nil || token != "osd-new" {
+		t.Fatalf("SetTextOSD update = %s, %v", token, err)
+	}
+	if _, ok := caller.sent[1].(SetOSD); !ok || len(caller.osds) != 2 || caller.osds[1].TextString.PlainText != "Site B" {
+		t.Errorf("text OSD not updated: %+v", caller.osds)
+	}
+
+	white := &onvif.OSDColor{Color: onvif.Color{X: 255, Y: 255, Z: 255}}
+	red := &onvif.OSDColor{Color: onvif.Color{X: 255}}
+	clock := OSD{VideoSourceConfigurationToken: "vsc", Type: OSDDateAndTime, Position: OSDPosition{Preset: OSDLowerRight},
+		DateFormat: "yyyy-MM-dd", TimeFormat: "HH:mm:ss", FontSize: 24, FontColor: white}
+	invalid := []struct {
+		change func(osd *OSD)
+		field  string
+	}{
+		{func(osd *OSD) { osd.FontSize = 8 }, "FontSize"},
+		{func(osd *OSD) { osd.FontColor = red }, "FontColor"},
+		{func(osd *OSD) { osd.Position.Preset = OSDLowerLeft }, "Position"},
+		{func(osd *OSD) { osd.Type = OSDTime }, "TextType"},
+		{func(osd *OSD) { osd.TimeFormat = "hh:mm a" }, "TimeFormat"},
+	}
+	for _, test := range invalid {
+		osd := clock
+		test.change(&osd)
+		_, err := AddOSD(ctx, caller, osd)
+		var optionErr *OSDOptionError
+		if !errors.As(err, &optionErr) || optionErr.Field != test.field {
+			t.Errorf("%s: got %v", test.field, err)
+		}
+	}
+
+	image := OSD{VideoSourceConfigurationToken: "vsc", Type: OSDImage, Position: OSDPosition{Preset: OSDUpperLeft}, ImagePath: "logo.png"}
+	var limitErr *OSDLimitError
+	if _, err := AddOSD(ctx, caller, image); !errors.As(err, &limitErr) || limitErr.Type != OSDImage {
+		t.Errorf("second image: got %v", err)
+	}
+	if _, err := AddOSD(ctx, caller, clock); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := AddOSD(ctx, caller, clock); !errors.As(err, &limitErr) || limitErr.Type != "" || limitErr.Max != 3 {
+		t.Errorf("fourth OSD: got %v", err)
+	}
+	if len(caller.sent) != 3 {
+		t.Errorf("got %d changes, want 3", len(caller.sent))
+	}
+}
@@ -0,0 +1,356 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sonnt85/gonvif/internal/rollback"
+	"github.com/sonnt85/gonvif/ptz"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// ConfigurationKind names a configuration that can be attached to a media profile
+type ConfigurationKind string
+
+// Configuration kinds in the order ProfileBuilder attaches them, sources first
+// since the encoders compatible with a profile depend on its source
+const (
+	VideoSourceConfiguration    ConfigurationKind = "VideoSource"
+	VideoEncoderConfiguration   ConfigurationKind = "VideoEncoder"
+	AudioSourceConfiguration    ConfigurationKind = "AudioSource"
+	AudioEncoderConfiguration   ConfigurationKind = "AudioEncoder"
+	PTZConfiguration            ConfigurationKind = "PTZ"
+	VideoAnalyticsConfiguration ConfigurationKind = "VideoAnalytics"
+	MetadataConfiguration       ConfigurationKind = "Metadata"
+	AudioOutputConfiguration    ConfigurationKind = "AudioOutput"
+	AudioDecoderConfiguration   ConfigurationKind = "AudioDecoder"
+)
+
+var configurationOrder = []ConfigurationKind{
+	VideoSourceConfiguration,
+	VideoEncoderConfiguration,
+	AudioSourceConfiguration,
+	AudioEncoderConfiguration,
+	PTZConfiguration,
+	VideoAnalyticsConfiguration,
+	MetadataConfiguration,
+	AudioOutputConfiguration,
+	AudioDecoderConfiguration,
+}
+
+// IncompatibleConfigurationError is returned when a requested configuration
+// is not among those the device reports compatible with the profile
+type IncompatibleConfigurationError struct {
+	Kind       ConfigurationKind
+	Token      onvif.ReferenceToken
+	Compatible []onvif.ReferenceToken
+}
+
+func (err *IncompatibleConfigurationError) Error() string {
+	if err.Token == "" {
+		return fmt.Sprintf("no %s configuration compatible with the profile", err.Kind)
+	}
+	compatible := make([]string, len(err.Compatible))
+	for i, token := range err.Compatible {
+		compatible[i] = string(token)
+	}
+	return fmt.Sprintf("%s configuration %s is not compatible with the profile, compatible: [%s]",
+		err.Kind, err.Token, strings.Join(compatible, " "))
+}
+
+// ProfileBuildError reports the step of ProfileBuilder.Build that failed and
+// the outcome of the rollback
+type ProfileBuildError struct {
+	// Step is "CreateProfile" or the kind of the configuration being attached
+	Step string
+	Err  error
+	// RollbackErr is the error of DeleteProfile, nil when the profile was removed
+	RollbackErr error
+}
+
+func (err *ProfileBuildError) Error() string {
+	msg := err.Step + ": " + err.Err.Error()
+	if err.RollbackErr != nil {
+		msg += " (rollback failed: " + err.RollbackErr.Error() + ")"
+	}
+	return msg
+}
+
+func (err *ProfileBuildError) Unwrap() error {
+	return err.Err
+}
+
+// ProfileBuilder composes a media profile: it creates the profile, attaches
+// the configurations checked against the GetCompatible* calls and deletes the
+// profile again when any step fails
+type ProfileBuilder struct {
+	caller         Caller
+	name           onvif.Name
+	token          onvif.ReferenceToken
+	configurations map[ConfigurationKind]onvif.ReferenceToken
+}
+
+// NewProfileBuilder returns a builder of a profile called name
+func NewProfileBuilder(caller Caller, name string) *ProfileBuilder {
+	return &ProfileBuilder{
+		caller:         caller,
+		name:           onvif.Name(name),
+		configurations: make(map[ConfigurationKind]onvif.ReferenceToken),
+	}
+}
+
+// WithToken asks the device to create the profile with token
+func (builder *ProfileBuilder) WithToken(token string) *ProfileBuilder {
+	builder.token = onvif.ReferenceToken(token)
+	return builder
+}
+
+// With attaches the configuration token of kind. An empty token attaches the
+// first configuration compatible with the profile
+func (builder *ProfileBuilder) With(kind ConfigurationKind, token string) *ProfileBuilder {
+	builder.configurations[kind] = onvif.ReferenceToken(token)
+	return builder
+}
+
+// VideoSource attaches a video source configuration, see With
+func (builder *ProfileBuilder) VideoSource(token string) *ProfileBuilder {
+	return builder.With(VideoSourceConfiguration, token)
+}
+
+// VideoEncoder attaches a video encoder configuration, see With
+func (builder *ProfileBuilder) VideoEncoder(token string) *ProfileBuilder {
+	return builder.With(VideoEncoderConfiguration, token)
+}
+
+// AudioSource attaches an audio source configuration, see With
+func (builder *ProfileBuilder) AudioSource(token string) *ProfileBuilder {
+	return builder.With(AudioSourceConfiguration, token)
+}
+
+// AudioEncoder attaches an audio encoder configuration, see With
+func (builder *ProfileBuilder) AudioEncoder(token string) *ProfileBuilder {
+	return builder.With(AudioEncoderConfiguration, token)
+}
+
+// PTZ attaches a PTZ configuration, checked with the PTZ service, see With
+func (builder *ProfileBuilder) PTZ(token string) *ProfileBuilder {
+	return builder.With(PTZConfiguration, token)
+}
+
+// Metadata attaches a metadata configuration, see With
+func (builder *ProfileBuilder) Metadata(token string) *ProfileBuilder {
+	return builder.With(MetadataConfiguration, token)
+}
+
+// Build creates the profile and attaches the configurations. On failure the
+// profile is deleted and a *ProfileBuildError is returned
+func (builder *ProfileBuilder) Build(ctx context.Context) (onvif.ReferenceToken, error) {
+	var created CreateProfileResponse
+	if err := builder.caller.CallMethodUnmarshal(ctx, CreateProfile{Name: builder.name, Token: builder.token}, &created); err != nil {
+		return "", &ProfileBuildError{Step: "CreateProfile", Err: err}
+	}
+	profile := created.Profile.Token
+	if profile == "" {
+		profile = builder.token
+	}
+
+	for _, kind := range configurationOrder {
+		token, ok := builder.configurations[kind]
+		if !ok {
+			continue
+		}
+		if err := builder.attach(ctx, profile, kind, token); err != nil {
+			buildErr := &ProfileBuildError{Step: string(kind), Err: err}
+			rollbackCtx, cancel := rollback.Context()
+			buildErr.RollbackErr = builder.caller.CallMethodUnmarshal(rollbackCtx, DeleteProfile{ProfileToken: profile}, &DeleteProfileResponse{})
+			cancel()
+			return "", buildErr
+		}
+	}
+	return profile, nil
+}
+
+// attach checks that token is compatible with the profile and adds it
+func (builder *ProfileBuilder) attach(ctx context.Context, profile onvif.ReferenceToken, kind ConfigurationKind, token onvif.ReferenceToken) error {
+	compatible, err := CompatibleConfigurations(ctx, builder.caller, profile, kind)
+	if err != nil {
+		return err
+	}
+	if token == "" {
+		if len(compatible) == 0 {
+			return &IncompatibleConfigurationError{Kind: kind}
+		}
+		token = compatible[0]
+	} else if !containsToken(compatible, token) {
+		return &IncompatibleConfigurationError{Kind: kind, Token: token, Compatible: compatible}
+	}
+	return builder.caller.CallMethodUnmarshal(ctx, addConfiguration(kind, profile, token), &struct{}{})
+}
+
+// CompatibleConfigurations returns the tokens of the configurations of kind
+// that the device accepts in a profile
+func CompatibleConfigurations(ctx context.Context, caller Caller, profile onvif.ReferenceToken, kind ConfigurationKind) ([]onvif.ReferenceToken, error) {
+	var tokens []onvif.ReferenceToken
+	var err error
+	switch kind {
+	case VideoSourceConfiguration:
+		var resp GetCompatibleVideoSourceConfigurationsResponse
+		if err = caller.CallMethodUnmarshal(ctx, GetCompatibleVideoSourceConfigurations{ProfileToken: profile}, &resp); err == nil {
+			for _, config := range resp.Configurations {
+				tokens = append(tokens, config.Token)
+			}
+		}
+	case VideoEncoderConfiguration:
+		var resp GetCompatibleVideoEncoderConfigurationsResponse
+		if err = caller.CallMethodUnmarshal(ctx, GetCompatibleVideoEncoderConfigurations{ProfileToken: profile}, &resp); err == nil {
+			for _, config := range resp.Configurations {
+				tokens = append(tokens, config.Token)
+			}
+		}
+	case AudioSourceConfiguration:
+		var resp GetCompatibleAudioSourceConfigurationsResponse
+		if err = caller.CallMethodUnmarshal(ctx, GetCompatibleAudioSourceConfigurations{ProfileToken: profile}, &resp); err == nil {
+			for _, config := range resp.Configurations {
+				tokens = append(tokens, config.Token)
+			}
+		}
+	case AudioEncoderConfiguration:
+		var resp GetCompatibleAudioEncoderConfigurationsResponse
+		if err = caller.CallMethodUnmarshal(ctx, GetCompatibleAudioEncoderConfigurations{ProfileToken: profile}, &resp); err == nil {
+			for _, config := range resp.Configurations {
+				tokens = append(tokens, config.Token)
+			}
+		}
+	case PTZConfiguration:
+		var resp ptz.GetCompatibleConfigurationsResponse
+		if err = caller.CallMethodUnmarshal(ctx, ptz.GetCompatibleConfigurations{ProfileToken: profile}, &resp); err == nil {
+			for _, config := range resp.PTZConfiguration {
+				tokens = append(tokens, config.Token)
+			}
+		}
+	case VideoAnalyticsConfiguration:
+		var resp GetCompatibleVideoAnalyticsConfigurationsResponse
+		if err = caller.CallMethodUnmarshal(ctx, GetCompatibleVideoAnalyticsConfigurations{ProfileToken: profile}, &resp); err == nil {
+			for _, config := range resp.Configurations {
+				tokens = append(tokens, config.Token)
+			}
+		}
+	case MetadataConfiguration:
+		var resp GetCompatibleMetadataConfigurationsResponse
+		if err = caller.CallMethodUnmarshal(ctx, GetCompatibleMetadataConfigurations{ProfileToken: profile}, &resp); err == nil {
+			for _, config := range resp.Configurations {
+				tokens = append(tokens, config.Token)
+			}
+		}
+	case AudioOutputConfiguration:
+		var resp GetCompatibleAudioOutputConfigurationsResponse
+		if err = caller.CallMethodUnmarshal(ctx, GetCompatibleAudioOutputConfigurations{ProfileToken: profile}, &resp); err == nil {
+			for _, config := range resp.Configurations {
+				tokens = append(tokens, config.Token)
+			}
+		}
+	case AudioDecoderConfiguration:
+		var resp GetCompatibleAudioDecoderConfigurationsResponse
+		if err = caller.CallMethodUnmarshal(ctx, GetCompatibleAudioDecoderConfigurations{ProfileToken: profile}, &resp); err == nil {
+			for _, config := range resp.Configurations {
+				tokens = append(tokens, config.Token)
+			}
+		}
+	default:
+		err = fmt.Errorf("unknown configuration kind %q", kind)
+	}
+	return tokens, err
+}
+
+// addConfiguration returns the Add*Configuration request of kind
+func addConfiguration(kind ConfigurationKind, profile, token onvif.ReferenceToken) interface{} {
+	switch kind {
+	case VideoSourceConfiguration:
+		return AddVideoSourceConfiguration{ProfileToken: profile, ConfigurationToken: token}
+	case VideoEncoderConfiguration:
+		return AddVideoEncoderConfiguration{ProfileToken: profile, ConfigurationToken: token}
+	case AudioSourceConfiguration:
+		return AddAudioSourceConfiguration{ProfileToken: profile, ConfigurationToken: token}
+	case AudioEncoderConfiguration:
+		return AddAudioEncoderConfiguration{ProfileToken: profile, ConfigurationToken: token}
+	case PTZConfiguration:
+		return AddPTZConfiguration{ProfileToken: profile, ConfigurationToken: token}
+	case VideoAnalyticsConfiguration:
+		return AddVideoAnalyticsConfiguration{ProfileToken: profile, ConfigurationToken: token}
+	case MetadataConfiguration:
+		return AddMetadataConfiguration{ProfileToken: profile, ConfigurationToken: token}
+	case AudioOutputConfiguration:
+		return AddAudioOutputConfiguration{ProfileToken: profile, ConfigurationToken: token}
+	default:
+		return AddAudioDecoderConfiguration{ProfileToken: profile, ConfigurationToken: token}
+	}
+}
+
+// removeConfiguration returns the Remove*Configuration request of kind
+func removeConfiguration(kind ConfigurationKind, profile onvif.ReferenceToken) interface{} {
+	switch kind {
+	case VideoSourceConfiguration:
+		return RemoveVideoSourceConfiguration{ProfileToken: profile}
+	case VideoEncoderConfiguration:
+		return RemoveVideoEncoderConfiguration{ProfileToken: profile}
+	case AudioSourceConfiguration:
+		return RemoveAudioSourceConfiguration{ProfileToken: profile}
+	case AudioEncoderConfiguration:
+		return RemoveAudioEncoderConfiguration{ProfileToken: profile}
+	case PTZConfiguration:
+		return RemovePTZConfiguration{ProfileToken: profile}
+	case VideoAnalyticsConfiguration:
+		return RemoveVideoAnalyticsConfiguration{ProfileToken: profile}
+	case MetadataConfiguration:
+		return RemoveMetadataConfiguration{ProfileToken: profile}
+	case AudioOutputConfiguration:
+		return RemoveAudioOutputConfiguration{ProfileToken: profile}
+	default:
+		return RemoveAudioDecoderConfiguration{ProfileToken: profile}
+	}
+}
+
+// TearDownProfile detaches the configurations of a profile, the dependent ones
+// first, and deletes it. Fixed profiles are refused by the device
+func TearDownProfile(ctx context.Context, caller Caller, profile onvif.ReferenceToken) error {
+	var resp GetProfileResponse
+	if err := caller.CallMethodUnmarshal(ctx, GetProfile{ProfileToken: profile}, &resp); err != nil {
+		return err
+	}
+	attached := attachedConfigurations(resp.Profile)
+	for i := len(configurationOrder) - 1; i >= 0; i-- {
+		kind := configurationOrder[i]
+		if !attached[kind] {
+			continue
+		}
+		if err := caller.CallMethodUnmarshal(ctx, removeConfiguration(kind, profile), &struct{}{}); err != nil {
+			return fmt.Errorf("remove %s configuration: %v", kind, err)
+		}
+	}
+	return caller.CallMethodUnmarshal(ctx, DeleteProfile{ProfileToken: profile}, &DeleteProfileResponse{})
+}
+
+// attachedConfigurations returns the kinds of the configurations of a profile
+func attachedConfigurations(profile onvif.Profile) map[ConfigurationKind]bool {
+	return map[ConfigurationKind]bool{
+		VideoSourceConfiguration:    profile.VideoSourceConfiguration.Token != "",
+		VideoEncoderConfiguration:   profile.VideoEncoderConfiguration.Token != "",
+		AudioSourceConfiguration:    profile.AudioSourceConfiguration.Token != "",
+		AudioEncoderConfiguration:   profile.AudioEncoderConfiguration.Token != "",
+		PTZConfiguration:            profile.PTZConfiguration.Token != "",
+		VideoAnalyticsConfiguration: profile.VideoAnalyticsConfiguration.Token != "",
+		MetadataConfiguration:       profile.MetadataConfiguration.Token != "",
+		AudioOutputConfiguration:    profile.Extension.AudioOutputConfiguration.Token != "",
+		AudioDecoderConfiguration:   profile.Extension.AudioDecoderConfiguration.Token != "",
+	}
+}
+
+func containsToken(tokens []onvif.ReferenceToken, token onvif.ReferenceToken) bool {
+	for _, t := range tokens {
+		if t == token {
+			return true
+		}
+	}
+	return false
+}
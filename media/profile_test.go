@@ -0,0 +1,54 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+func TestProfileBuilder(t *testing.T) {
+	caller := &mediaCamera{}
+	token, err := NewProfileBuilder(caller, "sub").VideoEncoder("enc-sub").VideoSource("").Build(context.Background())
+	if err != nil || token != "p1" {
+		t.Fatalf("Build = %s, %v", token, err)
+	}
+	want := []string{"CreateProfile",
+		"GetCompatibleVideoSourceConfigurations", "AddVideoSourceConfiguration",
+		"GetCompatibleVideoEncoderConfigurations", "AddVideoEncoderConfiguration"}
+	if !reflect.DeepEqual(caller.calls, want) {
+		t.Errorf("calls %v, want %v", caller.calls, want)
+	}
+
+	caller = &mediaCamera{}
+	_, err = NewProfileBuilder(caller, "bad").VideoSource("vsc").VideoEncoder("enc-4k").Build(context.Background())
+	var buildErr *ProfileBuildError
+	var incompatible *IncompatibleConfigurationError
+	if !errors.As(err, &buildErr) || buildErr.Step != "VideoEncoder" || buildErr.RollbackErr != nil || !errors.As(err, &incompatible) ||
+		!reflect.DeepEqual(incompatible.Compatible, []onvif.ReferenceToken{"enc-main", "enc-sub"}) {
+		t.Errorf("incompatible encoder: %v", err)
+	}
+	if last := caller.calls[len(caller.calls)-1]; last != "DeleteProfile" {
+		t.Errorf("no rollback, last call %s", last)
+	}
+
+	caller = &mediaCamera{}
+	if _, err = NewProfileBuilder(caller, "ptz").VideoSource("").PTZ("ptz0").Build(context.Background()); err == nil {
+		t.Error("failing AddPTZConfiguration did not fail the build")
+	} else if last := caller.calls[len(caller.calls)-1]; last != "DeleteProfile" {
+		t.Errorf("no rollback, last call %s", last)
+	}
+}
+
+func TestTearDownProfile(t *testing.T) {
+	caller := &mediaCamera{}
+	if err := TearDownProfile(context.Background(), caller, "p1"); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"GetProfile", "RemoveVideoEncoderConfiguration", "RemoveVideoSourceConfiguration", "DeleteProfile"}
+	if !reflect.DeepEqual(caller.calls, want) {
+		t.Errorf("calls %v, want %v", caller.calls, want)
+	}
+}
@@ -0,0 +1,212 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Caller sends a request to the media service of a device and decodes the
+// answer into response. gonvif.Device implements it with CallMethodUnmarshal
+type Caller interface {
+	CallMethodUnmarshal(ctx context.Context, method, response interface{}) error
+}
+
+// Stream describes the video stream of a media profile
+type Stream struct {
+	ProfileToken onvif.ReferenceToken
+	ProfileName  onvif.Name
+	EncoderToken onvif.ReferenceToken
+	// Codec is the video encoding, e.g. "H264", "MPEG4" or "JPEG"
+	Codec     string
+	Width     int
+	Height    int
+	FrameRate float64
+	// Bitrate is the bitrate limit in kbit/s
+	Bitrate   int
+	GovLength int
+	// RTSP, HTTP and Multicast are the RTP over RTSP, RTSP over HTTP and
+	// multicast stream URIs, empty when the device refused them
+	RTSP      string
+	HTTP      string
+	Multicast string
+	Snapshot  string
+}
+
+// Pixels returns the number of pixels of a frame
+func (stream Stream) Pixels() int {
+	return stream.Width * stream.Height
+}
+
+// StreamOrder sorts the streams matching StreamCriteria, the first one is selected
+type StreamOrder int
+
+const (
+	// HighestResolution prefers the largest frames, then the highest bitrate
+	HighestResolution StreamOrder = iota
+	// LowestResolution prefers the smallest frames, then the lowest bitrate
+	LowestResolution
+	// HighestBitrate prefers the highest bitrate, then the largest frames
+	HighestBitrate
+	// LowestBitrate prefers the lowest bitrate, then the smallest frames. It
+	// selects the sub-stream of most cameras
+	LowestBitrate
+)
+
+// StreamCriteria selects a stream among the profiles of a device
+type StreamCriteria struct {
+	// Codec of the stream, any codec when empty
+	Codec string
+	// MaxWidth and MaxHeight bound the resolution, unbounded when 0
+	MaxWidth  int
+	MaxHeight int
+	// Multicast only keeps streams with a multicast URI
+	Multicast bool
+	Order     StreamOrder
+}
+
+// ErrNoStream is returned by Select when no stream matches the criteria
+var ErrNoStream = errors.New("no stream matches the criteria")
+
+// StreamResolver resolves the profiles of a device into stream descriptors
+type StreamResolver struct {
+	caller Caller
+	// SkipHTTP, SkipMulticast and SkipSnapshot avoid the GetStreamUri and
+	// GetSnapshotUri calls of the URIs that are not needed
+	SkipHTTP      bool
+	SkipMulticast bool
+	SkipSnapshot  bool
+}
+
+// NewStreamResolver returns a resolver of the streams of the device behind caller
+func NewStreamResolver(caller Caller) *StreamResolver {
+	return &StreamResolver{caller: caller}
+}
+
+// Streams returns a descriptor for every profile with a video encoder
+func (resolver *StreamResolver) Streams(ctx context.Context) ([]Stream, error) {
+	var profiles GetProfilesResponse
+	if err := resolver.caller.CallMethodUnmarshal(ctx, GetProfiles{}, &profiles); err != nil {
+		return nil, err
+	}
+
+	streams := make([]Stream, 0, len(profiles.Profiles))
+	for _, profile := range profiles.Profiles {
+		encoder := profile.VideoEncoderConfiguration
+		if encoder.Token == "" && encoder.Encoding == "" {
+			continue
+		}
+		stream := Stream{
+			ProfileToken: profile.Token,
+			ProfileName:  profile.Name,
+			EncoderToken: encoder.Token,
+			Codec:        strings.ToUpper(string(encoder.Encoding)),
+			Width:        int(encoder.Resolution.Width),
+			Height:       int(encoder.Resolution.Height),
+			FrameRate:    float64(encoder.RateControl.FrameRateLimit),
+			Bitrate:      int(encoder.RateControl.BitrateLimit),
+		}
+		switch {
+		case stream.Codec == "H264" && encoder.H264 != nil:
+			stream.GovLength = int(encoder.H264.GovLength)
+		case stream.Codec == "MPEG4" && encoder.MPEG4 != nil:
+			stream.GovLength = int(encoder.MPEG4.GovLength)
+		}
+
+		var err error
+		stream.RTSP, err = resolver.streamUri(ctx, profile.Token, "RTP-Unicast", "RTSP")
+		if err != nil && ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if !resolver.SkipHTTP {
+			stream.HTTP, _ = resolver.streamUri(ctx, profile.Token, "RTP-Unicast", "HTTP")
+		}
+		if !resolver.SkipMulticast {
+			stream.Multicast, _ = resolver.streamUri(ctx, profile.Token, "RTP-Multicast", "UDP")
+		}
+		if !resolver.SkipSnapshot {
+			var snapshot GetSnapshotUriResponse
+			if resolver.caller.CallMethodUnmarshal(ctx, GetSnapshotUri{ProfileToken: profile.Token}, &snapshot) == nil {
+				stream.Snapshot = string(snapshot.MediaUri.Uri)
+			}
+		}
+		streams = append(streams, stream)
+	}
+	return streams, nil
+}
+
+// Select resolves the streams of the device and returns the best one for criteria
+func (resolver *StreamResolver) Select(ctx context.Context, criteria StreamCriteria) (Stream, error) {
+	streams, err := resolver.Streams(ctx)
+	if err != nil {
+		return Stream{}, err
+	}
+	return SelectStream(streams, criteria)
+}
+
+// SelectStream returns the best stream of streams for criteria
+func SelectStream(streams []Stream, criteria StreamCriteria) (Stream, error) {
+	var matching []Stream
+	for _, stream := range streams {
+		if criteria.Codec != "" && !strings.EqualFold(stream.Codec, criteria.Codec) {
+			continue
+		}
+		if criteria.MaxWidth > 0 && stream.Width > criteria.MaxWidth ||
+			criteria.MaxHeight > 0 && stream.Height > criteria.MaxHeight {
+			continue
+		}
+		if criteria.Multicast && stream.Multicast == "" {
+			continue
+		}
+		matching = append(matching, stream)
+	}
+	if len(matching) == 0 {
+		return Stream{}, ErrNoStream
+	}
+
+	sort.SliceStable(matching, func(i, j int) bool {
+		a, b := matching[i], matching[j]
+		switch criteria.Order {
+		case LowestResolution:
+			if a.Pixels() != b.Pixels() {
+				return a.Pixels() < b.Pixels()
+			}
+			return a.Bitrate < b.Bitrate
+		case HighestBitrate:
+			if a.Bitrate != b.Bitrate {
+				return a.Bitrate > b.Bitrate
+			}
+			return a.Pixels() > b.Pixels()
+		case LowestBitrate:
+			if a.Bitrate != b.Bitrate {
+				return a.Bitrate < b.Bitrate
+			}
+			return a.Pixels() < b.Pixels()
+		default:
+			if a.Pixels() != b.Pixels() {
+				return a.Pixels() > b.Pixels()
+			}
+			return a.Bitrate > b.Bitrate
+		}
+	})
+	return matching[0], nil
+}
+
+// streamUri returns the URI of a profile for a stream type and transport protocol
+func (resolver *StreamResolver) streamUri(ctx context.Context, profileToken onvif.ReferenceToken, stream, protocol string) (string, error) {
+	request := GetStreamUri{
+		StreamSetup: onvif.StreamSetup{
+			Stream:    onvif.StreamType(stream),
+			Transport: onvif.Transport{Protocol: onvif.TransportProtocol(protocol)},
+		},
+		ProfileToken: profileToken,
+	}
+	var resp GetStreamUriResponse
+	if err := resolver.caller.CallMethodUnmarshal(ctx, request, &resp); err != nil {
+		return "", err
+	}
+	return string(resp.MediaUri.Uri), nil
+}
@@ -0,0 +1,163 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/sonnt85/gonvif/ptz"
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// mediaCamera is a media service holding profiles, the URIs of their
+// streams and snapshots derive from the profile token. Its configurations
+// are one source, two encoders and a PTZ configuration, it records the
+// operations sent. Its encoder configuration stores at most 4000 kbit/s
+// whatever the options say. Its video source configuration vsc accepts 3
+// OSDs of which 1 image
+type mediaCamera struct {
+	profiles []onvif.Profile
+	calls    []string
+	config   onvif.VideoEncoderConfiguration
+	sets     int
+	osds     []onvif.OSDConfiguration
+	// sent records the OSD changes
+	sent []interface{}
+}
+
+func (camera *mediaCamera) CallMethodUnmarshal(ctx context.Context, method, response interface{}) error {
+	camera.calls = append(camera.calls, reflect.TypeOf(method).Name())
+	entity := func(token string) onvif.ConfigurationEntity {
+		return onvif.ConfigurationEntity{Token: onvif.ReferenceToken(token)}
+	}
+	switch method := method.(type) {
+	case GetProfiles:
+		response.(*GetProfilesResponse).Profiles = camera.profiles
+	case GetStreamUri:
+		if method.StreamSetup.Stream == "RTP-Multicast" {
+			return errors.New("multicast not supported")
+		}
+		uri := "rtsp://camera/" + string(method.ProfileToken)
+		if method.StreamSetup.Transport.Protocol == "HTTP" {
+			uri = "http://camera/" + string(method.ProfileToken)
+		}
+		response.(*GetStreamUriResponse).MediaUri.Uri = xsd.AnyURI(uri)
+	case GetSnapshotUri:
+		response.(*GetSnapshotUriResponse).MediaUri.Uri = xsd.AnyURI("http://camera/snap/" + string(method.ProfileToken))
+	case CreateProfile:
+		response.(*CreateProfileResponse).Profile.Token = "p1"
+	case GetCompatibleVideoSourceConfigurations:
+		response.(*GetCompatibleVideoSourceConfigurationsResponse).Configurations = []onvif.VideoSourceConfiguration{{ConfigurationEntity: entity("vsc")}}
+	case GetCompatibleVideoEncoderConfigurations:
+		response.(*GetCompatibleVideoEncoderConfigurationsResponse).Configurations = []onvif.VideoEncoderConfiguration{
+			{ConfigurationEntity: entity("enc-main")}, {ConfigurationEntity: entity("enc-sub")}}
+	case ptz.GetCompatibleConfigurations:
+		response.(*ptz.GetCompatibleConfigurationsResponse).PTZConfiguration = []onvif.PTZConfiguration{{ConfigurationEntity: entity("ptz0")}}
+	case AddPTZConfiguration:
+		return errors.New("PTZ busy")
+	case GetProfile:
+		profile := &response.(*GetProfileResponse).Profile
+		profile.Token = method.ProfileToken
+		profile.VideoSourceConfiguration.Token = "vsc"
+		profile.VideoEncoderConfiguration.Token = "enc-sub"
+	case GetVideoEncoderConfiguration:
+		response.(*GetVideoEncoderConfigurationResponse).Configuration = camera.config
+	case GetVideoEncoderConfigurationOptions:
+		options := &response.(*GetVideoEncoderConfigurationOptionsResponse).Options
+		options.QualityRange = onvif.IntRange{Min: 1, Max: 6}
+		options.H264.ResolutionsAvailable = []onvif.VideoResolution{{Width: 1920, Height: 1080}, {Width: 1280, Height: 720}, {Width: 640, Height: 360}}
+		options.H264.FrameRateRange = onvif.IntRange{Min: 1, Max: 25}
+		options.H264.GovLengthRange = onvif.IntRange{Min: 1, Max: 150}
+		options.H264.H264ProfilesSupported = []onvif.H264Profile{"Baseline", "Main"}
+		options.Extension.H264.BitrateRange = onvif.IntRange{Min: 64, Max: 8192}
+	case SetVideoEncoderConfiguration:
+		camera.sets++
+		camera.config = method.Configuration
+		if camera.config.RateControl.BitrateLimit > 4000 {
+			camera.config.RateControl.BitrateLimit = 4000
+		}
+	case GetOSDOptions:
+		options := &response.(*GetOSDOptionsResponse).OSDOptions
+		options.MaximumNumberOfOSDs = onvif.MaximumNumberOfOSDs{Total: 3, Image: 1}
+		options.Type = []onvif.OSDType{"Text", "Image"}
+		options.PositionOption = []string{OSDUpperLeft, OSDLowerRight, OSDCustom}
+		options.TextOption.Type = []string{OSDPlain, OSDDateAndTime}
+		options.TextOption.FontSizeRange = onvif.IntRange{Min: 16, Max: 64}
+		options.TextOption.DateFormat = []string{"yyyy-MM-dd"}
+		options.TextOption.TimeFormat = []string{"HH:mm:ss"}
+		options.TextOption.FontColor.Color.ColorList = []onvif.Color{{X: 255, Y: 255, Z: 255}, {X: 0, Y: 0, Z: 0}}
+		options.ImageOption.ImagePath = []xsd.AnyURI{"logo.png"}
+	case GetOSDs:
+		response.(*GetOSDsResponse).OSDs = camera.osds
+	case CreateOSD:
+		camera.sent = append(camera.sent, method)
+		method.OSD.Token = "osd-new"
+		camera.osds = append(camera.osds, method.OSD)
+		response.(*CreateOSDResponse).OSDToken = "osd-new"
+	case SetOSD:
+		camera.sent = append(camera.sent, method)
+		for i, osd := range camera.osds {
+			if osd.Token == method.OSD.Token {
+				camera.osds[i] = method.OSD
+			}
+		}
+	case AddVideoSourceConfiguration, AddVideoEncoderConfiguration, RemoveVideoSourceConfiguration, RemoveVideoEncoderConfiguration, DeleteProfile:
+	default:
+		return errors.New("unexpected method")
+	}
+	return nil
+}
+
+func profile(token, encoding string, width, height, bitrate int) onvif.Profile {
+	var p onvif.Profile
+	p.Token = onvif.ReferenceToken(token)
+	p.VideoEncoderConfiguration.Token = onvif.ReferenceToken("enc-" + token)
+	p.VideoEncoderConfiguration.Encoding = onvif.VideoEncoding(encoding)
+	p.VideoEncoderConfiguration.Resolution.Width = xsd.Int(width)
+	p.VideoEncoderConfiguration.Resolution.Height = xsd.Int(height)
+	p.VideoEncoderConfiguration.RateControl.BitrateLimit = xsd.Int(bitrate)
+	p.VideoEncoderConfiguration.H264 = &onvif.H264Configuration{GovLength: 50}
+	return p
+}
+
+func TestStreamResolver(t *testing.T) {
+	resolver := NewStreamResolver(&mediaCamera{profiles: []onvif.Profile{
+		profile("main", "H264", 1920, 1080, 4096),
+		profile("sub", "H264", 640, 360, 512),
+		profile("mjpeg", "JPEG", 1280, 720, 2048),
+		{Token: "audio"},
+	}})
+
+	streams, err := resolver.Streams(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(streams) != 3 {
+		t.Fatalf("got %d streams, want 3", len(streams))
+	}
+	main := streams[0]
+	if main.EncoderToken != "enc-main" || main.Codec != "H264" || main.GovLength != 50 || main.RTSP != "rtsp://camera/main" ||
+		main.HTTP != "http://camera/main" || main.Multicast != "" || main.Snapshot != "http://camera/snap/main" {
+		t.Errorf("unexpected main stream %+v", main)
+	}
+
+	for _, test := range []struct {
+		criteria StreamCriteria
+		want     onvif.ReferenceToken
+	}{
+		{StreamCriteria{Codec: "h264", Order: HighestResolution}, "main"},
+		{StreamCriteria{Order: LowestBitrate}, "sub"},
+		{StreamCriteria{Codec: "JPEG"}, "mjpeg"},
+		{StreamCriteria{MaxWidth: 1280, Order: HighestBitrate}, "mjpeg"},
+	} {
+		stream, err := SelectStream(streams, test.criteria)
+		if err != nil || stream.ProfileToken != test.want {
+			t.Errorf("SelectStream(%+v) = %s, %v, want %s", test.criteria, stream.ProfileToken, err, test.want)
+		}
+	}
+	if _, err := SelectStream(streams, StreamCriteria{Multicast: true}); err != ErrNoStream {
+		t.Errorf("multicast criteria: got %v, want ErrNoStream", err)
+	}
+}
@@ -43,7 +43,7 @@ type GetVideoSources struct {
 }
 
 type GetVideoSourcesResponse struct {
-	VideoSources onvif.VideoSource
+	VideoSources []onvif.VideoSource
 }
 
 type GetAudioSources struct {
@@ -51,7 +51,7 @@ type GetAudioSources struct {
 }
 
 type GetAudioSourcesResponse struct {
-	AudioSources onvif.AudioSource
+	AudioSources []onvif.AudioSource
 }
 
 type GetAudioOutputs struct {
@@ -59,13 +59,13 @@ type GetAudioOutputs struct {
 }
 
 type GetAudioOutputsResponse struct {
-	AudioOutputs onvif.AudioOutput
+	AudioOutputs []onvif.AudioOutput
 }
 
 type CreateProfile struct {
 	XMLName string               `xml:"trt:CreateProfile"`
 	Name    onvif.Name           `xml:"trt:Name"`
-	Token   onvif.ReferenceToken `xml:"trt:Token"`
+	Token   onvif.ReferenceToken `xml:"trt:Token,omitempty"`
 }
 
 type CreateProfileResponse struct {
@@ -255,7 +255,7 @@ type GetVideoSourceConfigurations struct {
 }
 
 type GetVideoSourceConfigurationsResponse struct {
-	Configurations onvif.VideoSourceConfiguration
+	Configurations []onvif.VideoSourceConfiguration
 }
 
 type GetVideoEncoderConfigurations struct {
@@ -263,7 +263,7 @@ type GetVideoEncoderConfigurations struct {
 }
 
 type GetVideoEncoderConfigurationsResponse struct {
-	Configurations onvif.VideoEncoderConfiguration
+	Configurations []onvif.VideoEncoderConfiguration
 }
 
 type GetAudioSourceConfigurations struct {
@@ -271,7 +271,7 @@ type GetAudioSourceConfigurations struct {
 }
 
 type GetAudioSourceConfigurationsResponse struct {
-	Configurations onvif.AudioSourceConfiguration
+	Configurations []onvif.AudioSourceConfiguration
 }
 
 type GetAudioEncoderConfigurations struct {
@@ -279,7 +279,7 @@ type GetAudioEncoderConfigurations struct {
 }
 
 type GetAudioEncoderConfigurationsResponse struct {
-	Configurations onvif.AudioEncoderConfiguration
+	Configurations []onvif.AudioEncoderConfiguration
 }
 
 type GetVideoAnalyticsConfigurations struct {
@@ -287,7 +287,7 @@ type GetVideoAnalyticsConfigurations struct {
 }
 
 type GetVideoAnalyticsConfigurationsResponse struct {
-	Configurations onvif.VideoAnalyticsConfiguration
+	Configurations []onvif.VideoAnalyticsConfiguration
 }
 
 type GetMetadataConfigurations struct {
@@ -295,7 +295,7 @@ type GetMetadataConfigurations struct {
 }
 
 type GetMetadataConfigurationsResponse struct {
-	Configurations onvif.MetadataConfiguration
+	Configurations []onvif.MetadataConfiguration
 }
 
 type GetAudioOutputConfigurations struct {
@@ -303,7 +303,7 @@ type GetAudioOutputConfigurations struct {
 }
 
 type GetAudioOutputConfigurationsResponse struct {
-	Configurations onvif.AudioOutputConfiguration
+	Configurations []onvif.AudioOutputConfiguration
 }
 
 type GetAudioDecoderConfigurations struct {
@@ -311,7 +311,7 @@ type GetAudioDecoderConfigurations struct {
 }
 
 type GetAudioDecoderConfigurationsResponse struct {
-	Configurations onvif.AudioDecoderConfiguration
+	Configurations []onvif.AudioDecoderConfiguration
 }
 
 type GetVideoSourceConfiguration struct {
@@ -392,7 +392,7 @@ type GetCompatibleVideoEncoderConfigurations struct {
 }
 
 type GetCompatibleVideoEncoderConfigurationsResponse struct {
-	Configurations onvif.VideoEncoderConfiguration
+	Configurations []onvif.VideoEncoderConfiguration
 }
 
 type GetCompatibleVideoSourceConfigurations struct {
@@ -401,7 +401,7 @@ type GetCompatibleVideoSourceConfigurations struct {
 }
 
 type GetCompatibleVideoSourceConfigurationsResponse struct {
-	Configurations onvif.VideoSourceConfiguration
+	Configurations []onvif.VideoSourceConfiguration
 }
 
 type GetCompatibleAudioEncoderConfigurations struct {
@@ -410,7 +410,7 @@ type GetCompatibleAudioEncoderConfigurations struct {
 }
 
 type GetCompatibleAudioEncoderConfigurationsResponse struct {
-	Configurations onvif.AudioEncoderConfiguration
+	Configurations []onvif.AudioEncoderConfiguration
 }
 
 type GetCompatibleAudioSourceConfigurations struct {
@@ -419,7 +419,7 @@ type GetCompatibleAudioSourceConfigurations struct {
 }
 
 type GetCompatibleAudioSourceConfigurationsResponse struct {
-	Configurations onvif.AudioSourceConfiguration
+	Configurations []onvif.AudioSourceConfiguration
 }
 
 type GetCompatibleVideoAnalyticsConfigurations struct {
@@ -428,7 +428,7 @@ type GetCompatibleVideoAnalyticsConfigurations struct {
 }
 
 type GetCompatibleVideoAnalyticsConfigurationsResponse struct {
-	Configurations onvif.VideoAnalyticsConfiguration
+	Configurations []onvif.VideoAnalyticsConfiguration
 }
 
 type GetCompatibleMetadataConfigurations struct {
@@ -437,7 +437,7 @@ type GetCompatibleMetadataConfigurations struct {
 }
 
 type GetCompatibleMetadataConfigurationsResponse struct {
-	Configurations onvif.MetadataConfiguration
+	Configurations []onvif.MetadataConfiguration
 }
 
 type GetCompatibleAudioOutputConfigurations struct {
@@ -446,7 +446,7 @@ type GetCompatibleAudioOutputConfigurations struct {
 }
 
 type GetCompatibleAudioOutputConfigurationsResponse struct {
-	Configurations onvif.AudioOutputConfiguration
+	Configurations []onvif.AudioOutputConfiguration
 }
 
 type GetCompatibleAudioDecoderConfigurations struct {
@@ -455,7 +455,7 @@ type GetCompatibleAudioDecoderConfigurations struct {
 }
 
 type GetCompatibleAudioDecoderConfigurationsResponse struct {
-	Configurations onvif.AudioDecoderConfiguration
+	Configurations []onvif.AudioDecoderConfiguration
 }
 
 type SetVideoSourceConfiguration struct {
@@ -532,8 +532,8 @@ type SetAudioDecoderConfigurationResponse struct {
 
 type GetVideoSourceConfigurationOptions struct {
 	XMLName            string               `xml:"trt:GetVideoSourceConfigurationOptions"`
-	ProfileToken       onvif.ReferenceToken `xml:"trt:ProfileToken"`
-	ConfigurationToken onvif.ReferenceToken `xml:"trt:ConfigurationToken"`
+	ProfileToken       onvif.ReferenceToken `xml:"trt:ProfileToken,omitempty"`
+	ConfigurationToken onvif.ReferenceToken `xml:"trt:ConfigurationToken,omitempty"`
 }
 
 type GetVideoSourceConfigurationOptionsResponse struct {
@@ -542,8 +542,8 @@ type GetVideoSourceConfigurationOptionsResponse struct {
 
 type GetVideoEncoderConfigurationOptions struct {
 	XMLName            string               `xml:"trt:GetVideoEncoderConfigurationOptions"`
-	ProfileToken       onvif.ReferenceToken `xml:"trt:ProfileToken"`
-	ConfigurationToken onvif.ReferenceToken `xml:"trt:ConfigurationToken"`
+	ProfileToken       onvif.ReferenceToken `xml:"trt:ProfileToken,omitempty"`
+	ConfigurationToken onvif.ReferenceToken `xml:"trt:ConfigurationToken,omitempty"`
 }
 
 type GetVideoEncoderConfigurationOptionsResponse struct {
@@ -552,8 +552,8 @@ type GetVideoEncoderConfigurationOptionsResponse struct {
 
 type GetAudioSourceConfigurationOptions struct {
 	XMLName            string               `xml:"trt:GetAudioSourceConfigurationOptions"`
-	ProfileToken       onvif.ReferenceToken `xml:"trt:ProfileToken"`
-	ConfigurationToken onvif.ReferenceToken `xml:"trt:ConfigurationToken"`
+	ProfileToken       onvif.ReferenceToken `xml:"trt:ProfileToken,omitempty"`
+	ConfigurationToken onvif.ReferenceToken `xml:"trt:ConfigurationToken,omitempty"`
 }
 
 type GetAudioSourceConfigurationOptionsResponse struct {
@@ -562,8 +562,8 @@ type GetAudioSourceConfigurationOptionsResponse struct {
 
 type GetAudioEncoderConfigurationOptions struct {
 	XMLName            string               `xml:"trt:GetAudioEncoderConfigurationOptions"`
-	ProfileToken       onvif.ReferenceToken `xml:"trt:ProfileToken"`
-	ConfigurationToken onvif.ReferenceToken `xml:"trt:ConfigurationToken"`
+	ProfileToken       onvif.ReferenceToken `xml:"trt:ProfileToken,omitempty"`
+	ConfigurationToken onvif.ReferenceToken `xml:"trt:ConfigurationToken,omitempty"`
 }
 
 type GetAudioEncoderConfigurationOptionsResponse struct {
@@ -572,8 +572,8 @@ type GetAudioEncoderConfigurationOptionsResponse struct {
 
 type GetMetadataConfigurationOptions struct {
 	XMLName            string               `xml:"trt:GetMetadataConfigurationOptions"`
-	ProfileToken       onvif.ReferenceToken `xml:"trt:ProfileToken"`
-	ConfigurationToken onvif.ReferenceToken `xml:"trt:ConfigurationToken"`
+	ProfileToken       onvif.ReferenceToken `xml:"trt:ProfileToken,omitempty"`
+	ConfigurationToken onvif.ReferenceToken `xml:"trt:ConfigurationToken,omitempty"`
 }
 
 type GetMetadataConfigurationOptionsResponse struct {
@@ -582,8 +582,8 @@ type GetMetadataConfigurationOptionsResponse struct {
 
 type GetAudioOutputConfigurationOptions struct {
 	XMLName            string               `xml:"trt:GetAudioOutputConfigurationOptions"`
-	ProfileToken       onvif.ReferenceToken `xml:"trt:ProfileToken"`
-	ConfigurationToken onvif.ReferenceToken `xml:"trt:ConfigurationToken"`
+	ProfileToken       onvif.ReferenceToken `xml:"trt:ProfileToken,omitempty"`
+	ConfigurationToken onvif.ReferenceToken `xml:"trt:ConfigurationToken,omitempty"`
 }
 
 type GetAudioOutputConfigurationOptionsResponse struct {
@@ -592,8 +592,8 @@ type GetAudioOutputConfigurationOptionsResponse struct {
 
 type GetAudioDecoderConfigurationOptions struct {
 	XMLName            string               `xml:"trt:GetAudioDecoderConfigurationOptions"`
-	ProfileToken       onvif.ReferenceToken `xml:"trt:ProfileToken"`
-	ConfigurationToken onvif.ReferenceToken `xml:"trt:ConfigurationToken"`
+	ProfileToken       onvif.ReferenceToken `xml:"trt:ProfileToken,omitempty"`
+	ConfigurationToken onvif.ReferenceToken `xml:"trt:ConfigurationToken,omitempty"`
 }
 
 type GetAudioDecoderConfigurationOptionsResponse struct {
@@ -661,7 +661,7 @@ type GetVideoSourceModes struct {
 }
 
 type GetVideoSourceModesResponse struct {
-	VideoSourceModes onvif.VideoSourceMode
+	VideoSourceModes []onvif.VideoSourceMode
 }
 
 type SetVideoSourceMode struct {
@@ -676,11 +676,11 @@ type SetVideoSourceModeResponse struct {
 
 type GetOSDs struct {
 	XMLName            string               `xml:"trt:GetOSDs"`
-	ConfigurationToken onvif.ReferenceToken `xml:"trt:ConfigurationToken"`
+	ConfigurationToken onvif.ReferenceToken `xml:"trt:ConfigurationToken,omitempty"`
 }
 
 type GetOSDsResponse struct {
-	OSDs onvif.OSDConfiguration
+	OSDs []onvif.OSDConfiguration
 }
 
 type GetOSD struct {
@@ -0,0 +1,628 @@
+// Code generated by gonvif-gen from media2.wsdl. DO NOT EDIT.
+
+package media2
+
+import (
+	"github.com/sonnt85/gonvif/gosoap"
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Namespace of the media2 service
+const Namespace = "http://www.onvif.org/ver20/media/wsdl"
+
+// Prefix of the media2 service elements
+const Prefix = "tr2"
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tr2:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities2
+}
+
+type Capabilities2 struct {
+	ProfileCapabilities   ProfileCapabilities   `xml:"tr2:ProfileCapabilities"`
+	StreamingCapabilities StreamingCapabilities `xml:"tr2:StreamingCapabilities"`
+	SnapshotUri           xsd.Boolean           `xml:"SnapshotUri,attr,omitempty"`
+	Rotation              xsd.Boolean           `xml:"Rotation,attr,omitempty"`
+	VideoSourceMode       xsd.Boolean           `xml:"VideoSourceMode,attr,omitempty"`
+	OSD                   xsd.Boolean           `xml:"OSD,attr,omitempty"`
+	TemporaryOSDText      xsd.Boolean           `xml:"TemporaryOSDText,attr,omitempty"`
+	Mask                  xsd.Boolean           `xml:"Mask,attr,omitempty"`
+	SourceMask            xsd.Boolean           `xml:"SourceMask,attr,omitempty"`
+}
+
+type ProfileCapabilities struct {
+	MaximumNumberOfProfiles xsd.Int              `xml:"MaximumNumberOfProfiles,attr,omitempty"`
+	ConfigurationsSupported onvif.StringAttrList `xml:"ConfigurationsSupported,attr,omitempty"`
+}
+
+type StreamingCapabilities struct {
+	RTSPStreaming       xsd.Boolean `xml:"RTSPStreaming,attr,omitempty"`
+	RTPMulticast        xsd.Boolean `xml:"RTPMulticast,attr,omitempty"`
+	RTPRTSPTCP          xsd.Boolean `xml:"RTP_RTSP_TCP,attr,omitempty"`
+	NonAggregateControl xsd.Boolean `xml:"NonAggregateControl,attr,omitempty"`
+	RTSPWebSocketUri    xsd.AnyURI  `xml:"RTSPWebSocketUri,attr,omitempty"`
+	AutoStartMulticast  xsd.Boolean `xml:"AutoStartMulticast,attr,omitempty"`
+}
+
+type ConfigurationEnumeration xsd.String
+
+const (
+	ConfigurationEnumerationAll          ConfigurationEnumeration = "All"
+	ConfigurationEnumerationVideoSource  ConfigurationEnumeration = "VideoSource"
+	ConfigurationEnumerationVideoEncoder ConfigurationEnumeration = "VideoEncoder"
+	ConfigurationEnumerationAudioSource  ConfigurationEnumeration = "AudioSource"
+	ConfigurationEnumerationAudioEncoder ConfigurationEnumeration = "AudioEncoder"
+	ConfigurationEnumerationAudioOutput  ConfigurationEnumeration = "AudioOutput"
+	ConfigurationEnumerationAudioDecoder ConfigurationEnumeration = "AudioDecoder"
+	ConfigurationEnumerationMetadata     ConfigurationEnumeration = "Metadata"
+	ConfigurationEnumerationAnalytics    ConfigurationEnumeration = "Analytics"
+	ConfigurationEnumerationPTZ          ConfigurationEnumeration = "PTZ"
+)
+
+type ConfigurationRef struct {
+	Type  xsd.String           `xml:"tr2:Type"`
+	Token onvif.ReferenceToken `xml:"tr2:Token,omitempty"`
+}
+
+// ConfigurationSet a set of media configurations
+type ConfigurationSet struct {
+	VideoSource  *onvif.VideoSourceConfiguration    `xml:"tr2:VideoSource,omitempty"`
+	AudioSource  *onvif.AudioSourceConfiguration    `xml:"tr2:AudioSource,omitempty"`
+	VideoEncoder *onvif.VideoEncoder2Configuration  `xml:"tr2:VideoEncoder,omitempty"`
+	AudioEncoder *onvif.AudioEncoder2Configuration  `xml:"tr2:AudioEncoder,omitempty"`
+	Analytics    *onvif.VideoAnalyticsConfiguration `xml:"tr2:Analytics,omitempty"`
+	PTZ          *onvif.PTZConfiguration            `xml:"tr2:PTZ,omitempty"`
+	Metadata     *onvif.MetadataConfiguration       `xml:"tr2:Metadata,omitempty"`
+	AudioOutput  *onvif.AudioOutputConfiguration    `xml:"tr2:AudioOutput,omitempty"`
+	AudioDecoder *onvif.AudioDecoderConfiguration   `xml:"tr2:AudioDecoder,omitempty"`
+}
+
+// MediaProfile a media profile consists of a set of media configurations
+type MediaProfile struct {
+	Name           onvif.Name           `xml:"tr2:Name"`
+	Configurations *ConfigurationSet    `xml:"tr2:Configurations,omitempty"`
+	Token          onvif.ReferenceToken `xml:"token,attr"`
+	Fixed          xsd.Boolean          `xml:"fixed,attr,omitempty"`
+}
+
+type CreateProfile struct {
+	XMLName       string             `xml:"tr2:CreateProfile"`
+	Name          onvif.Name         `xml:"tr2:Name"`
+	Configuration []ConfigurationRef `xml:"tr2:Configuration"`
+}
+
+type CreateProfileResponse struct {
+	Token onvif.ReferenceToken
+}
+
+type GetProfiles struct {
+	XMLName string               `xml:"tr2:GetProfiles"`
+	Token   onvif.ReferenceToken `xml:"tr2:Token,omitempty"`
+	Type    []xsd.String         `xml:"tr2:Type"`
+}
+
+type GetProfilesResponse struct {
+	Profiles []MediaProfile
+}
+
+type AddConfiguration struct {
+	XMLName       string               `xml:"tr2:AddConfiguration"`
+	ProfileToken  onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+	Name          onvif.Name           `xml:"tr2:Name,omitempty"`
+	Configuration []ConfigurationRef   `xml:"tr2:Configuration"`
+}
+
+type AddConfigurationResponse struct {
+}
+
+type RemoveConfiguration struct {
+	XMLName       string               `xml:"tr2:RemoveConfiguration"`
+	ProfileToken  onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+	Configuration []ConfigurationRef   `xml:"tr2:Configuration"`
+}
+
+type RemoveConfigurationResponse struct {
+}
+
+type DeleteProfile struct {
+	XMLName string               `xml:"tr2:DeleteProfile"`
+	Token   onvif.ReferenceToken `xml:"tr2:Token"`
+}
+
+type DeleteProfileResponse struct {
+}
+
+type GetConfiguration struct {
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetVideoEncoderConfigurations struct {
+	XMLName string `xml:"tr2:GetVideoEncoderConfigurations"`
+	GetConfiguration
+}
+
+type GetVideoEncoderConfigurationsResponse struct {
+	Configurations []onvif.VideoEncoder2Configuration
+}
+
+type GetVideoSourceConfigurations struct {
+	XMLName string `xml:"tr2:GetVideoSourceConfigurations"`
+	GetConfiguration
+}
+
+type GetVideoSourceConfigurationsResponse struct {
+	Configurations []onvif.VideoSourceConfiguration
+}
+
+type GetAudioEncoderConfigurations struct {
+	XMLName string `xml:"tr2:GetAudioEncoderConfigurations"`
+	GetConfiguration
+}
+
+type GetAudioEncoderConfigurationsResponse struct {
+	Configurations []onvif.AudioEncoder2Configuration
+}
+
+type GetAudioSourceConfigurations struct {
+	XMLName string `xml:"tr2:GetAudioSourceConfigurations"`
+	GetConfiguration
+}
+
+type GetAudioSourceConfigurationsResponse struct {
+	Configurations []onvif.AudioSourceConfiguration
+}
+
+type GetAnalyticsConfigurations struct {
+	XMLName string `xml:"tr2:GetAnalyticsConfigurations"`
+	GetConfiguration
+}
+
+type GetAnalyticsConfigurationsResponse struct {
+	Configurations []onvif.VideoAnalyticsConfiguration
+}
+
+type GetMetadataConfigurations struct {
+	XMLName string `xml:"tr2:GetMetadataConfigurations"`
+	GetConfiguration
+}
+
+type GetMetadataConfigurationsResponse struct {
+	Configurations []onvif.MetadataConfiguration
+}
+
+type GetAudioOutputConfigurations struct {
+	XMLName string `xml:"tr2:GetAudioOutputConfigurations"`
+	GetConfiguration
+}
+
+type GetAudioOutputConfigurationsResponse struct {
+	Configurations []onvif.AudioOutputConfiguration
+}
+
+type GetAudioDecoderConfigurations struct {
+	XMLName string `xml:"tr2:GetAudioDecoderConfigurations"`
+	GetConfiguration
+}
+
+type GetAudioDecoderConfigurationsResponse struct {
+	Configurations []onvif.AudioDecoderConfiguration
+}
+
+type SetVideoEncoderConfiguration struct {
+	XMLName       string                           `xml:"tr2:SetVideoEncoderConfiguration"`
+	Configuration onvif.VideoEncoder2Configuration `xml:"tr2:Configuration"`
+}
+
+type SetConfigurationResponse struct {
+}
+
+type SetVideoEncoderConfigurationResponse struct {
+	SetConfigurationResponse
+}
+
+type SetVideoSourceConfiguration struct {
+	XMLName       string                         `xml:"tr2:SetVideoSourceConfiguration"`
+	Configuration onvif.VideoSourceConfiguration `xml:"tr2:Configuration"`
+}
+
+type SetVideoSourceConfigurationResponse struct {
+	SetConfigurationResponse
+}
+
+type SetAudioEncoderConfiguration struct {
+	XMLName       string                           `xml:"tr2:SetAudioEncoderConfiguration"`
+	Configuration onvif.AudioEncoder2Configuration `xml:"tr2:Configuration"`
+}
+
+type SetAudioEncoderConfigurationResponse struct {
+	SetConfigurationResponse
+}
+
+type SetAudioSourceConfiguration struct {
+	XMLName       string                         `xml:"tr2:SetAudioSourceConfiguration"`
+	Configuration onvif.AudioSourceConfiguration `xml:"tr2:Configuration"`
+}
+
+type SetAudioSourceConfigurationResponse struct {
+	SetConfigurationResponse
+}
+
+type SetMetadataConfiguration struct {
+	XMLName       string                      `xml:"tr2:SetMetadataConfiguration"`
+	Configuration onvif.MetadataConfiguration `xml:"tr2:Configuration"`
+}
+
+type SetMetadataConfigurationResponse struct {
+	SetConfigurationResponse
+}
+
+type SetAudioOutputConfiguration struct {
+	XMLName       string                         `xml:"tr2:SetAudioOutputConfiguration"`
+	Configuration onvif.AudioOutputConfiguration `xml:"tr2:Configuration"`
+}
+
+type SetAudioOutputConfigurationResponse struct {
+	SetConfigurationResponse
+}
+
+type SetAudioDecoderConfiguration struct {
+	XMLName       string                          `xml:"tr2:SetAudioDecoderConfiguration"`
+	Configuration onvif.AudioDecoderConfiguration `xml:"tr2:Configuration"`
+}
+
+type SetAudioDecoderConfigurationResponse struct {
+	SetConfigurationResponse
+}
+
+type GetVideoSourceConfigurationOptions struct {
+	XMLName string `xml:"tr2:GetVideoSourceConfigurationOptions"`
+	GetConfiguration
+}
+
+type GetVideoSourceConfigurationOptionsResponse struct {
+	Options onvif.VideoSourceConfigurationOptions
+}
+
+type GetVideoEncoderConfigurationOptions struct {
+	XMLName string `xml:"tr2:GetVideoEncoderConfigurationOptions"`
+	GetConfiguration
+}
+
+type GetVideoEncoderConfigurationOptionsResponse struct {
+	Options []onvif.VideoEncoder2ConfigurationOptions
+}
+
+type GetAudioSourceConfigurationOptions struct {
+	XMLName string `xml:"tr2:GetAudioSourceConfigurationOptions"`
+	GetConfiguration
+}
+
+type GetAudioSourceConfigurationOptionsResponse struct {
+	Options onvif.AudioSourceConfigurationOptions
+}
+
+type GetAudioEncoderConfigurationOptions struct {
+	XMLName string `xml:"tr2:GetAudioEncoderConfigurationOptions"`
+	GetConfiguration
+}
+
+type GetAudioEncoderConfigurationOptionsResponse struct {
+	Options []onvif.AudioEncoder2ConfigurationOptions
+}
+
+type GetMetadataConfigurationOptions struct {
+	XMLName string `xml:"tr2:GetMetadataConfigurationOptions"`
+	GetConfiguration
+}
+
+type GetMetadataConfigurationOptionsResponse struct {
+	Options onvif.MetadataConfigurationOptions
+}
+
+type GetAudioOutputConfigurationOptions struct {
+	XMLName string `xml:"tr2:GetAudioOutputConfigurationOptions"`
+	GetConfiguration
+}
+
+type GetAudioOutputConfigurationOptionsResponse struct {
+	Options onvif.AudioOutputConfigurationOptions
+}
+
+type GetAudioDecoderConfigurationOptions struct {
+	XMLName string `xml:"tr2:GetAudioDecoderConfigurationOptions"`
+	GetConfiguration
+}
+
+type GetAudioDecoderConfigurationOptionsResponse struct {
+	Options []onvif.AudioEncoder2ConfigurationOptions
+}
+
+type TransportProtocol xsd.String
+
+const (
+	TransportProtocolRtspUnicast   TransportProtocol = "RtspUnicast"
+	TransportProtocolRtspMulticast TransportProtocol = "RtspMulticast"
+	TransportProtocolRTSP          TransportProtocol = "RTSP"
+	TransportProtocolRtspOverHttp  TransportProtocol = "RtspOverHttp"
+)
+
+type GetVideoEncoderInstances struct {
+	XMLName            string               `xml:"tr2:GetVideoEncoderInstances"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken"`
+}
+
+type EncoderInstance struct {
+	Encoding xsd.String `xml:"tr2:Encoding"`
+	Number   xsd.Int    `xml:"tr2:Number"`
+}
+
+type EncoderInstanceInfo struct {
+	Codec []EncoderInstance `xml:"tr2:Codec"`
+	Total xsd.Int           `xml:"tr2:Total"`
+}
+
+type GetVideoEncoderInstancesResponse struct {
+	Info EncoderInstanceInfo
+}
+
+type GetStreamUri struct {
+	XMLName      string               `xml:"tr2:GetStreamUri"`
+	Protocol     xsd.String           `xml:"tr2:Protocol"`
+	ProfileToken onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+}
+
+type GetStreamUriResponse struct {
+	Uri xsd.AnyURI
+}
+
+type SetSynchronizationPoint struct {
+	XMLName      string               `xml:"tr2:SetSynchronizationPoint"`
+	ProfileToken onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+}
+
+type SetSynchronizationPointResponse struct {
+}
+
+type GetSnapshotUri struct {
+	XMLName      string               `xml:"tr2:GetSnapshotUri"`
+	ProfileToken onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+}
+
+type GetSnapshotUriResponse struct {
+	Uri xsd.AnyURI
+}
+
+type StartStopMulticastStreaming struct {
+	ProfileToken onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+}
+
+type StartMulticastStreaming struct {
+	XMLName string `xml:"tr2:StartMulticastStreaming"`
+	StartStopMulticastStreaming
+}
+
+type StartMulticastStreamingResponse struct {
+	SetConfigurationResponse
+}
+
+type StopMulticastStreaming struct {
+	XMLName string `xml:"tr2:StopMulticastStreaming"`
+	StartStopMulticastStreaming
+}
+
+type StopMulticastStreamingResponse struct {
+	SetConfigurationResponse
+}
+
+type GetVideoSourceModes struct {
+	XMLName          string               `xml:"tr2:GetVideoSourceModes"`
+	VideoSourceToken onvif.ReferenceToken `xml:"tr2:VideoSourceToken"`
+}
+
+type GetVideoSourceModesResponse struct {
+	VideoSourceModes []VideoSourceMode
+}
+
+type SetVideoSourceMode struct {
+	XMLName              string               `xml:"tr2:SetVideoSourceMode"`
+	VideoSourceToken     onvif.ReferenceToken `xml:"tr2:VideoSourceToken"`
+	VideoSourceModeToken onvif.ReferenceToken `xml:"tr2:VideoSourceModeToken"`
+}
+
+type SetVideoSourceModeResponse struct {
+	Reboot xsd.Boolean
+}
+
+// EncodingTypes indication which encodings are supported for this video source
+// The value is a space separated list.
+type EncodingTypes xsd.String
+
+type VideoSourceMode struct {
+	MaxFramerate  xsd.Float             `xml:"tr2:MaxFramerate"`
+	MaxResolution onvif.VideoResolution `xml:"tr2:MaxResolution"`
+	Encodings     EncodingTypes         `xml:"tr2:Encodings"`
+	Reboot        xsd.Boolean           `xml:"tr2:Reboot"`
+	Description   *onvif.Description    `xml:"tr2:Description,omitempty"`
+	Token         onvif.ReferenceToken  `xml:"token,attr"`
+	Enabled       xsd.Boolean           `xml:"Enabled,attr,omitempty"`
+}
+
+type GetOSDs struct {
+	XMLName            string               `xml:"tr2:GetOSDs"`
+	OSDToken           onvif.ReferenceToken `xml:"tr2:OSDToken,omitempty"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+}
+
+type GetOSDsResponse struct {
+	OSDs []onvif.OSDConfiguration
+}
+
+type SetOSD struct {
+	XMLName string                 `xml:"tr2:SetOSD"`
+	OSD     onvif.OSDConfiguration `xml:"tr2:OSD"`
+}
+
+type SetOSDResponse struct {
+	SetConfigurationResponse
+}
+
+type GetOSDOptions struct {
+	XMLName            string               `xml:"tr2:GetOSDOptions"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken"`
+}
+
+type GetOSDOptionsResponse struct {
+	OSDOptions onvif.OSDConfigurationOptions
+}
+
+type CreateOSD struct {
+	XMLName string                 `xml:"tr2:CreateOSD"`
+	OSD     onvif.OSDConfiguration `xml:"tr2:OSD"`
+}
+
+type CreateOSDResponse struct {
+	OSDToken onvif.ReferenceToken
+}
+
+type DeleteOSD struct {
+	XMLName  string               `xml:"tr2:DeleteOSD"`
+	OSDToken onvif.ReferenceToken `xml:"tr2:OSDToken"`
+}
+
+type DeleteOSDResponse struct {
+	SetConfigurationResponse
+}
+
+type MaskType xsd.String
+
+const (
+	MaskTypeColor     MaskType = "Color"
+	MaskTypePixelated MaskType = "Pixelated"
+	MaskTypeBlurred   MaskType = "Blurred"
+)
+
+type Mask struct {
+	ConfigurationToken       onvif.ReferenceToken `xml:"tr2:ConfigurationToken"`
+	Polygon                  onvif.Polygon        `xml:"tr2:Polygon"`
+	Type                     xsd.String           `xml:"tr2:Type"`
+	Color                    *onvif.Color         `xml:"tr2:Color,omitempty"`
+	Enabled                  xsd.Boolean          `xml:"tr2:Enabled"`
+	Token                    onvif.ReferenceToken `xml:"token,attr,omitempty"`
+	VideoSourceConfiguration onvif.ReferenceToken `xml:"VideoSourceConfiguration,attr,omitempty"`
+}
+
+type GetMasks struct {
+	XMLName            string               `xml:"tr2:GetMasks"`
+	Token              onvif.ReferenceToken `xml:"tr2:Token,omitempty"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+}
+
+type GetMasksResponse struct {
+	Masks []Mask
+}
+
+type SetMask struct {
+	XMLName string `xml:"tr2:SetMask"`
+	Mask    Mask   `xml:"tr2:Mask"`
+}
+
+type SetMaskResponse struct {
+	SetConfigurationResponse
+}
+
+type GetMaskOptions struct {
+	XMLName            string               `xml:"tr2:GetMaskOptions"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken"`
+}
+
+type MaskOptions struct {
+	MaxMasks        xsd.Int            `xml:"tr2:MaxMasks"`
+	MaxPoints       xsd.Int            `xml:"tr2:MaxPoints"`
+	Types           []xsd.String       `xml:"tr2:Types"`
+	Color           onvif.ColorOptions `xml:"tr2:Color"`
+	RectangleOnly   xsd.Boolean        `xml:"RectangleOnly,attr,omitempty"`
+	SingleColorOnly xsd.Boolean        `xml:"SingleColorOnly,attr,omitempty"`
+}
+
+type GetMaskOptionsResponse struct {
+	Options MaskOptions
+}
+
+type CreateMask struct {
+	XMLName string `xml:"tr2:CreateMask"`
+	Mask    Mask   `xml:"tr2:Mask"`
+}
+
+type CreateMaskResponse struct {
+	Token onvif.ReferenceToken
+}
+
+type DeleteMask struct {
+	XMLName string               `xml:"tr2:DeleteMask"`
+	Token   onvif.ReferenceToken `xml:"tr2:Token"`
+}
+
+type DeleteMaskResponse struct {
+	SetConfigurationResponse
+}
+
+// Actions maps the request elements of the service to their WS-Addressing action
+var Actions = map[string]string{
+	"tr2:GetServiceCapabilities":              "http://www.onvif.org/ver20/media/wsdl/GetServiceCapabilities",
+	"tr2:CreateProfile":                       "http://www.onvif.org/ver20/media/wsdl/CreateProfile",
+	"tr2:GetProfiles":                         "http://www.onvif.org/ver20/media/wsdl/GetProfiles",
+	"tr2:AddConfiguration":                    "http://www.onvif.org/ver20/media/wsdl/AddConfiguration",
+	"tr2:RemoveConfiguration":                 "http://www.onvif.org/ver20/media/wsdl/RemoveConfiguration",
+	"tr2:DeleteProfile":                       "http://www.onvif.org/ver20/media/wsdl/DeleteProfile",
+	"tr2:GetVideoSourceConfigurations":        "http://www.onvif.org/ver20/media/wsdl/GetVideoSourceConfigurations",
+	"tr2:GetVideoEncoderConfigurations":       "http://www.onvif.org/ver20/media/wsdl/GetVideoEncoderConfigurations",
+	"tr2:GetAudioSourceConfigurations":        "http://www.onvif.org/ver20/media/wsdl/GetAudioSourceConfigurations/",
+	"tr2:GetAudioEncoderConfigurations":       "http://www.onvif.org/ver20/media/wsdl/GetAudioEncoderConfigurations",
+	"tr2:GetAnalyticsConfigurations":          "http://www.onvif.org/ver20/media/wsdl/GetAnalyticsConfigurations",
+	"tr2:GetMetadataConfigurations":           "http://www.onvif.org/ver20/media/wsdl/GetMetadataConfigurations",
+	"tr2:GetAudioOutputConfigurations":        "http://www.onvif.org/ver20/media/wsdl/GetAudioOutputConfigurations",
+	"tr2:GetAudioDecoderConfigurations":       "http://www.onvif.org/ver20/media/wsdl/GetAudioDecoderConfigurations",
+	"tr2:SetVideoSourceConfiguration":         "http://www.onvif.org/ver20/media/wsdl/SetVideoSourceConfiguration",
+	"tr2:SetVideoEncoderConfiguration":        "http://www.onvif.org/ver20/media/wsdl/SetVideoEncoderConfiguration",
+	"tr2:SetAudioSourceConfiguration":         "http://www.onvif.org/ver20/media/wsdl/SetAudioSourceConfiguration",
+	"tr2:SetAudioEncoderConfiguration":        "http://www.onvif.org/ver20/media/wsdl/SetAudioEncoderConfiguration",
+	"tr2:SetMetadataConfiguration":            "http://www.onvif.org/ver20/media/wsdl/SetMetadataConfiguration",
+	"tr2:SetAudioOutputConfiguration":         "http://www.onvif.org/ver20/media/wsdl/SetAudioOutputConfiguration",
+	"tr2:SetAudioDecoderConfiguration":        "http://www.onvif.org/ver20/media/wsdl/SetAudioDecoderConfiguration",
+	"tr2:GetVideoSourceConfigurationOptions":  "http://www.onvif.org/ver20/media/wsdl/GetVideoSourceConfigurationOptions/",
+	"tr2:GetVideoEncoderConfigurationOptions": "http://www.onvif.org/ver20/media/wsdl/GetVideoEncoderConfigurationOptions",
+	"tr2:GetAudioSourceConfigurationOptions":  "http://www.onvif.org/ver20/media/wsdl/GetAudioSourceConfigurationOptions",
+	"tr2:GetAudioEncoderConfigurationOptions": "http://www.onvif.org/ver20/media/wsdl/GetAudioEncoderConfigurationOptions",
+	"tr2:GetMetadataConfigurationOptions":     "http://www.onvif.org/ver20/media/wsdl/GetMetadataConfigurationOptions",
+	"tr2:GetAudioOutputConfigurationOptions":  "http://www.onvif.org/ver20/media/wsdl/GetAudioOutputConfigurationOptions",
+	"tr2:GetAudioDecoderConfigurationOptions": "http://www.onvif.org/ver20/media/wsdl/GetAudioDecoderConfigurationOptions",
+	"tr2:GetVideoEncoderInstances":            "http://www.onvif.org/ver20/media/wsdl/GetVideoEncoderInstances",
+	"tr2:GetStreamUri":                        "http://www.onvif.org/ver20/media/wsdl/GetStreamUri",
+	"tr2:StartMulticastStreaming":             "http://www.onvif.org/ver20/media/wsdl/StartMulticastStreaming",
+	"tr2:StopMulticastStreaming":              "http://www.onvif.org/ver20/media/wsdl/StopMulticastStreaming",
+	"tr2:SetSynchronizationPoint":             "http://www.onvif.org/ver20/media/wsdl/SetSynchronizationPoint",
+	"tr2:GetSnapshotUri":                      "http://www.onvif.org/ver20/media/wsdl/GetSnapshotUri",
+	"tr2:GetVideoSourceModes":                 "http://www.onvif.org/ver20/media/wsdl/GetVideoSourceModes",
+	"tr2:SetVideoSourceMode":                  "http://www.onvif.org/ver20/media/wsdl/SetVideoSourceMode",
+	"tr2:GetOSDs":                             "http://www.onvif.org/ver20/media/wsdl/GetOSDs",
+	"tr2:GetOSDOptions":                       "http://www.onvif.org/ver20/media/wsdl/GetOSDOptions",
+	"tr2:SetOSD":                              "http://www.onvif.org/ver20/media/wsdl/SetOSD",
+	"tr2:CreateOSD":                           "http://www.onvif.org/ver20/media/wsdl/CreateOSD",
+	"tr2:DeleteOSD":                           "http://www.onvif.org/ver20/media/wsdl/DeleteOSD",
+	"tr2:GetMasks":                            "http://www.onvif.org/ver20/media/wsdl/GetMasks",
+	"tr2:GetMaskOptions":                      "http://www.onvif.org/ver20/media/wsdl/GetMaskOptions",
+	"tr2:SetMask":                             "http://www.onvif.org/ver20/media/wsdl/SetMask",
+	"tr2:CreateMask":                          "http://www.onvif.org/ver20/media/wsdl/CreateMask",
+	"tr2:DeleteMask":                          "http://www.onvif.org/ver20/media/wsdl/DeleteMask",
+}
+
+func init() {
+	for tag, action := range Actions {
+		gosoap.RegisterAction(tag, action)
+	}
+}
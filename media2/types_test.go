@@ -0,0 +1,60 @@
+package media2
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/beevik/etree"
+)
+
+// TestOperationTypes checks that every operation of media2.wsdl has a request
+// and a response struct and a registered action
+func TestOperationTypes(t *testing.T) {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromFile(filepath.Join("..", "docs", "wsdl", "media2.wsdl")); err != nil {
+		t.Fatal(err)
+	}
+	elements := make(map[string]string)
+	for _, message := range doc.FindElements("./definitions/message") {
+		if part := message.SelectElement("part"); part != nil {
+			element := part.SelectAttrValue("element", "")
+			elements[message.SelectAttrValue("name", "")] = element[strings.LastIndex(element, ":")+1:]
+		}
+	}
+
+	pkgs, err := parser.ParseDir(token.NewFileSet(), ".", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := make(map[string]bool)
+	for _, file := range pkgs["media2"].Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			if spec, ok := node.(*ast.TypeSpec); ok {
+				types[spec.Name.Name] = true
+			}
+			return true
+		})
+	}
+
+	operations := doc.FindElements("./definitions/portType/operation")
+	if len(operations) == 0 {
+		t.Fatal("no operation in media2.wsdl")
+	}
+	for _, op := range operations {
+		name := op.SelectAttrValue("name", "")
+		for _, message := range []string{"input", "output"} {
+			ref := op.SelectElement(message).SelectAttrValue("message", "")
+			element := elements[ref[strings.LastIndex(ref, ":")+1:]]
+			if !types[element] {
+				t.Errorf("%s: no type for the %s element %s", name, message, element)
+			}
+			if message == "input" && Actions[Prefix+":"+element] == "" {
+				t.Errorf("%s: no action for %s", name, element)
+			}
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package gonvif
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sonnt85/gonvif/media"
+	"github.com/sonnt85/gonvif/media2"
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Stream protocols of Media2 GetStreamUri, Media1 devices get the matching StreamSetup
+const (
+	StreamProtocolRtspUnicast   = "RtspUnicast"
+	StreamProtocolRtspMulticast = "RtspMulticast"
+	StreamProtocolRTSP          = "RTSP"
+	StreamProtocolRtspOverHttp  = "RtspOverHttp"
+)
+
+// MediaProfile is a media profile read from Media2 when the device advertises
+// it, from Media1 otherwise. Media1 encoder configurations are converted to
+// their Media2 form
+type MediaProfile struct {
+	Token            onvif.ReferenceToken
+	Name             onvif.Name
+	Fixed            bool
+	VideoSourceToken onvif.ReferenceToken
+	VideoEncoder     *onvif.VideoEncoder2Configuration
+	AudioEncoder     *onvif.AudioEncoder2Configuration
+	PTZ              *onvif.PTZConfiguration
+}
+
+// Device resolves media streams with media.NewStreamResolver
+var _ media.Caller = Device{}
+
+// HasMedia2 reports whether the device advertises the Media2 service
+func (dev *Device) HasMedia2() bool {
+	_, ok := dev.endpoints["media2"]
+	return ok
+}
+
+// GetMediaProfiles returns the media profiles of the device with their
+// configurations, using Media2 when advertised and Media1 otherwise
+func (dev Device) GetMediaProfiles(ctx context.Context) ([]MediaProfile, error) {
+	if dev.HasMedia2() {
+		var resp media2.GetProfilesResponse
+		request := media2.GetProfiles{Type: []xsd.String{xsd.String(media2.ConfigurationEnumerationAll)}}
+		if err := dev.CallMethodUnmarshal(ctx, request, &resp); err != nil {
+			return nil, err
+		}
+		profiles := make([]MediaProfile, 0, len(resp.Profiles))
+		for _, p := range resp.Profiles {
+			profile := MediaProfile{Token: p.Token, Name: p.Name, Fixed: bool(p.Fixed)}
+			if set := p.Configurations; set != nil {
+				if set.VideoSource != nil {
+					profile.VideoSourceToken = set.VideoSource.SourceToken
+				}
+				profile.VideoEncoder = set.VideoEncoder
+				profile.AudioEncoder = set.AudioEncoder
+				profile.PTZ = set.PTZ
+			}
+			profiles = append(profiles, profile)
+		}
+		return profiles, nil
+	}
+
+	var resp media.GetProfilesResponse
+	if err := dev.CallMethodUnmarshal(ctx, media.GetProfiles{}, &resp); err != nil {
+		return nil, err
+	}
+	profiles := make([]MediaProfile, 0, len(resp.Profiles))
+	for _, p := range resp.Profiles {
+		profile := MediaProfile{
+			Token:            p.Token,
+			Name:             p.Name,
+			Fixed:            p.Fixed,
+			VideoSourceToken: p.VideoSourceConfiguration.SourceToken,
+		}
+		if p.VideoEncoderConfiguration.Token != "" {
+			profile.VideoEncoder = videoEncoder2(p.VideoEncoderConfiguration)
+		}
+		if p.AudioEncoderConfiguration.Token != "" {
+			profile.AudioEncoder = audioEncoder2(p.AudioEncoderConfiguration)
+		}
+		if p.PTZConfiguration.Token != "" {
+			ptz := p.PTZConfiguration
+			profile.PTZ = &ptz
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
+
+// GetMediaStreamUri returns the stream URI of a profile for one of the
+// StreamProtocol names, using Media2 when advertised and Media1 otherwise
+func (dev Device) GetMediaStreamUri(ctx context.Context, profileToken onvif.ReferenceToken, protocol string) (string, error) {
+	if dev.HasMedia2() {
+		var resp media2.GetStreamUriResponse
+		request := media2.GetStreamUri{Protocol: xsd.String(protocol), ProfileToken: profileToken}
+		if err := dev.CallMethodUnmarshal(ctx, request, &resp); err != nil {
+			return "", err
+		}
+		return string(resp.Uri), nil
+	}
+
+	setup, err := streamSetup(protocol)
+	if err != nil {
+		return "", err
+	}
+	var resp media.GetStreamUriResponse
+	request := media.GetStreamUri{StreamSetup: setup, ProfileToken: profileToken}
+	if err := dev.CallMethodUnmarshal(ctx, request, &resp); err != nil {
+		return "", err
+	}
+	return string(resp.MediaUri.Uri), nil
+}
+
+// GetMediaSnapshotUri returns the JPEG snapshot URI of a profile, using Media2
+// when advertised and Media1 otherwise
+func (dev Device) GetMediaSnapshotUri(ctx context.Context, profileToken onvif.ReferenceToken) (string, error) {
+	if dev.HasMedia2() {
+		var resp media2.GetSnapshotUriResponse
+		if err := dev.CallMethodUnmarshal(ctx, media2.GetSnapshotUri{ProfileToken: profileToken}, &resp); err != nil {
+			return "", err
+		}
+		return string(resp.Uri), nil
+	}
+
+	var resp media.GetSnapshotUriResponse
+	if err := dev.CallMethodUnmarshal(ctx, media.GetSnapshotUri{ProfileToken: profileToken}, &resp); err != nil {
+		return "", err
+	}
+	return string(resp.MediaUri.Uri), nil
+}
+
+// streamSetup returns the Media1 StreamSetup of a Media2 stream protocol
+func streamSetup(protocol string) (onvif.StreamSetup, error) {
+	setup := onvif.StreamSetup{Stream: "RTP-Unicast"}
+	switch protocol {
+	case StreamProtocolRtspUnicast:
+		setup.Transport.Protocol = "UDP"
+	case StreamProtocolRTSP:
+		setup.Transport.Protocol = "RTSP"
+	case StreamProtocolRtspOverHttp:
+		setup.Transport.Protocol = "HTTP"
+	case StreamProtocolRtspMulticast:
+		setup.Stream = "RTP-Multicast"
+		setup.Transport.Protocol = "UDP"
+	default:
+		return setup, errors.New("unsupported stream protocol " + protocol)
+	}
+	return setup, nil
+}
+
+// videoEncoder2 converts a Media1 video encoder configuration
+func videoEncoder2(config onvif.VideoEncoderConfiguration) *onvif.VideoEncoder2Configuration {
+	encoder := &onvif.VideoEncoder2Configuration{
+		ConfigurationEntity: config.ConfigurationEntity,
+		Encoding:            string(config.Encoding),
+		Resolution:          onvif.VideoResolution2(config.Resolution),
+		RateControl: &onvif.VideoRateControl2{
+			FrameRateLimit: float64(config.RateControl.FrameRateLimit),
+			BitrateLimit:   config.RateControl.BitrateLimit,
+		},
+		Quality: config.Quality,
+	}
+	switch {
+	case config.Encoding == "H264" && config.H264 != nil:
+		encoder.GovLength = int(config.H264.GovLength)
+		encoder.Profile = string(config.H264.H264Profile)
+	case config.Encoding == "MPEG4" && config.MPEG4 != nil:
+		encoder.GovLength = int(config.MPEG4.GovLength)
+		encoder.Profile = string(config.MPEG4.Mpeg4Profile)
+	}
+	if config.Multicast.Port != 0 {
+		multicast := config.Multicast
+		encoder.Multicast = &multicast
+	}
+	return encoder
+}
+
+// audioEncoder2 converts a Media1 audio encoder configuration
+func audioEncoder2(config onvif.AudioEncoderConfiguration) *onvif.AudioEncoder2Configuration {
+	encoder := &onvif.AudioEncoder2Configuration{
+		ConfigurationEntity: config.ConfigurationEntity,
+		Encoding:            string(config.Encoding),
+		Bitrate:             config.Bitrate,
+		SampleRate:          config.SampleRate,
+	}
+	// Media2 names G711 and AAC by their RTP encoding names
+	switch config.Encoding {
+	case "G711":
+		encoder.Encoding = "PCMU"
+	case "AAC":
+		encoder.Encoding = "MP4A-LATM"
+	}
+	if config.Multicast.Port != 0 {
+		multicast := config.Multicast
+		encoder.Multicast = &multicast
+	}
+	return encoder
+}
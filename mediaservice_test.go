@@ -0,0 +1,245 @@
+package gonvif
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const envelope = `<?xml version="1.0" encoding="UTF-8"?>
+<s:Envelope xmlns:s="http://www.w3.org/2003/05/soap-envelope" xmlns:tt="http://www.onvif.org/ver10/schema"
+ xmlns:tds="http://www.onvif.org/ver10/device/wsdl" xmlns:trt="http://www.onvif.org/ver10/media/wsdl"
+ xmlns:tr2="http://www.onvif.org/ver20/media/wsdl"><s:Body>%s</s:Body></s:Envelope>`
+
+// fakeCamera answers the operations of the request body with the matching canned response
+func fakeCamera(t *testing.T, media2 bool) *httptest.Server {
+	return fakeCameraWith(t, media2, nil)
+}
+
+// fakeCameraWith is fakeCamera with its handler wrapped by wrap when not nil
+func fakeCameraWith(t *testing.T, media2 bool, wrap func(http.Handler) http.Handler) *httptest.Server {
+	var server *httptest.Server
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/snapshot.jpg" {
+			if user, pass, ok := r.BasicAuth(); !ok || user != "admin" || pass != "secret" {
+				w.Header().Set("WWW-Authenticate", `Basic realm="camera"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "image/jpeg")
+			w.Write([]byte{0xFF, 0xD8, 0xFF, 0xE0})
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		body := string(data)
+		var answer string
+		switch {
+		case strings.Contains(body, "GetCapabilities"):
+			answer = `<tds:GetCapabilitiesResponse><tds:Capabilities>
+				<tt:Media><tt:XAddr>` + server.URL + `/onvif/media_service</tt:XAddr></tt:Media>
+			</tds:Capabilities></tds:GetCapabilitiesResponse>`
+		case strings.Contains(body, "GetServices"):
+			if !media2 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			answer = `<tds:GetServicesResponse><tds:Service>
+				<tds:Namespace>http://www.onvif.org/ver20/media/wsdl</tds:Namespace>
+				<tds:XAddr>` + server.URL + `/onvif/media2_service</tds:XAddr>
+			</tds:Service></tds:GetServicesResponse>`
+		case strings.Contains(body, "tr2:GetProfiles"):
+			answer = `<tr2:GetProfilesResponse><tr2:Profiles token="main" fixed="true"><tr2:Name>Main</tr2:Name>
+				<tr2:Configurations><tr2:VideoEncoder token="enc0" GovLength="50" Profile="Main">
+					<tt:Name>enc</tt:Name><tt:UseCount>1</tt:UseCount><tt:Encoding>H265</tt:Encoding>
+					<tt:Resolution><tt:Width>3840</tt:Width><tt:Height>2160</tt:Height></tt:Resolution>
+					<tt:RateControl ConstantBitRate="false"><tt:FrameRateLimit>25</tt:FrameRateLimit><tt:BitrateLimit>8192</tt:BitrateLimit></tt:RateControl>
+					<tt:Quality>5</tt:Quality>
+				</tr2:VideoEncoder></tr2:Configurations>
+			</tr2:Profiles></tr2:GetProfilesResponse>`
+		case strings.Contains(body, "trt:GetProfiles"):
+			answer = `<trt:GetProfilesResponse><trt:Profiles token="main" fixed="true"><tt:Name>Main</tt:Name>
+				<tt:VideoSourceConfiguration token="vsc"><tt:Name>src</tt:Name><tt:SourceToken>vs0</tt:SourceToken></tt:VideoSourceConfiguration>
+				<tt:VideoEncoderConfiguration token="enc0"><tt:Name>enc</tt:Name><tt:Encoding>H264</tt:Encoding>
+					<tt:Resolution><tt:Width>1920</tt:Width><tt:Height>1080</tt:Height></tt:Resolution><tt:Quality>4</tt:Quality>
+					<tt:RateControl><tt:FrameRateLimit>30</tt:FrameRateLimit><tt:EncodingInterval>1</tt:EncodingInterval><tt:BitrateLimit>4096</tt:BitrateLimit></tt:RateControl>
+					<tt:H264><tt:GovLength>60</tt:GovLength><tt:H264Profile>High</tt:H264Profile></tt:H264>
+				</tt:VideoEncoderConfiguration>
+			</trt:Profiles></trt:GetProfilesResponse>`
+		case strings.Contains(body, "GetSnapshotUri"):
+			answer = `<trt:GetSnapshotUriResponse><trt:MediaUri><tt:Uri>` + server.URL + `/snapshot.jpg</tt:Uri></trt:MediaUri></trt:GetSnapshotUriResponse>`
+			if media2 {
+				answer = `<tr2:GetSnapshotUriResponse><tr2:Uri>` + server.URL + `/snapshot.jpg</tr2:Uri></tr2:GetSnapshotUriResponse>`
+			}
+		case strings.Contains(body, "tr2:GetStreamUri"):
+			answer = `<tr2:GetStreamUriResponse><tr2:Uri>rtsp://camera/media2</tr2:Uri></tr2:GetStreamUriResponse>`
+		case strings.Contains(body, "trt:GetStreamUri"):
+			if !strings.Contains(body, "RTP-Unicast") || !strings.Contains(body, "<onvif:Protocol>RTSP</onvif:Protocol>") {
+				t.Errorf("unexpected stream setup in %s", body)
+			}
+			answer = `<trt:GetStreamUriResponse><trt:MediaUri><tt:Uri>rtsp://camera/media1</tt:Uri>
+				<tt:InvalidAfterConnect>false</tt:InvalidAfterConnect></trt:MediaUri></trt:GetStreamUriResponse>`
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/soap+xml")
+		io.WriteString(w, strings.Replace(envelope, "%s", answer, 1))
+	})
+	if wrap != nil {
+		handler = wrap(handler)
+	}
+	server = httptest.NewServer(handler)
+	return server
+}
+
+func TestMedia2Selection(t *testing.T) {
+	server := fakeCamera(t, true)
+	defer server.Close()
+
+	dev, err := NewDevice(DeviceParams{Xaddr: strings.TrimPrefix(server.URL, "http://"), EndpointPolicy: EndpointKeepAdvertised})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !dev.HasMedia2() {
+		t.Fatalf("media2 endpoint not discovered: %v", dev.GetServices())
+	}
+
+	profiles, err := dev.GetMediaProfiles(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(profiles) != 1 || profiles[0].VideoEncoder == nil {
+		t.Fatalf("unexpected profiles %+v", profiles)
+	}
+	encoder := profiles[0].VideoEncoder
+	if profiles[0].Token != "main" || !profiles[0].Fixed || encoder.Encoding != "H265" || encoder.Resolution.Width != 3840 ||
+		encoder.GovLength != 50 || encoder.RateControl == nil || encoder.RateControl.BitrateLimit != 8192 {
+		t.Errorf("unexpected profile %+v, encoder %+v", profiles[0], encoder)
+	}
+
+	uri, err := dev.GetMediaStreamUri(context.Background(), "main", StreamProtocolRTSP)
+	if err != nil || uri != "rtsp://camera/media2" {
+		t.Errorf("GetMediaStreamUri = %q, %v", uri, err)
+	}
+}
+
+func TestMedia1Fallback(t *testing.T) {
+	server := fakeCamera(t, false)
+	defer server.Close()
+
+	dev, err := NewDevice(DeviceParams{Xaddr: strings.TrimPrefix(server.URL, "http://"), EndpointPolicy: EndpointKeepAdvertised})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dev.HasMedia2() {
+		t.Fatal("media2 endpoint without GetServices")
+	}
+
+	profiles, err := dev.GetMediaProfiles(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(profiles) != 1 || profiles[0].VideoEncoder == nil {
+		t.Fatalf("unexpected profiles %+v", profiles)
+	}
+	encoder := profiles[0].VideoEncoder
+	if profiles[0].VideoSourceToken != "vs0" || encoder.Encoding != "H264" || encoder.Resolution.Height != 1080 ||
+		encoder.GovLength != 60 || encoder.Profile != "High" || encoder.RateControl.FrameRateLimit != 30 {
+		t.Errorf("unexpected profile %+v, encoder %+v", profiles[0], encoder)
+	}
+
+	uri, err := dev.GetMediaStreamUri(context.Background(), "main", StreamProtocolRTSP)
+	if err != nil || uri != "rtsp://camera/media1" {
+		t.Errorf("GetMediaStreamUri = %q, %v", uri, err)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	server := fakeCamera(t, true)
+	defer server.Close()
+
+	dev, err := NewDevice(DeviceParams{Xaddr: strings.TrimPrefix(server.URL, "http://"), Username: "admin", Password: "secret",
+		EndpointPolicy: EndpointKeepAdvertised})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := SnapshotAll(context.Background(), []SnapshotRequest{{dev, "main"}, {dev, "sub"}, {dev, "third"}}, 2)
+	for _, result := range results {
+		if result.Err != nil {
+			t.Fatalf("%s: %v", result.ProfileToken, result.Err)
+		}
+		snapshot := result.Snapshot
+		if snapshot.ProfileToken != result.ProfileToken || snapshot.Auth != "basic" || len(snapshot.Image) != 4 || snapshot.Taken.IsZero() {
+			t.Errorf("unexpected snapshot %+v", snapshot)
+		}
+	}
+}
+
+func TestSnapshotAll(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	server := fakeCameraWith(t, true, func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/snapshot.jpg" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			mu.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+			// keep the download open so that the requests overlap
+			time.Sleep(50 * time.Millisecond)
+			next.ServeHTTP(w, r)
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		})
+	})
+	defer server.Close()
+
+	xaddr := strings.TrimPrefix(server.URL, "http://")
+	dev, err := NewDevice(DeviceParams{Xaddr: xaddr, Username: "admin", Password: "secret", EndpointPolicy: EndpointKeepAdvertised})
+	if err != nil {
+		t.Fatal(err)
+	}
+	locked, err := NewDevice(DeviceParams{Xaddr: xaddr, Username: "admin", Password: "wrong", EndpointPolicy: EndpointKeepAdvertised})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requests := []SnapshotRequest{{dev, "a"}, {locked, "b"}, {dev, "c"}, {dev, "d"}, {locked, "e"}, {dev, "f"}}
+	results := SnapshotAll(context.Background(), requests, 2)
+	if len(results) != len(requests) {
+		t.Fatalf("%d results for %d requests", len(results), len(requests))
+	}
+	for i, result := range results {
+		if result.Device != requests[i].Device || result.ProfileToken != requests[i].ProfileToken {
+			t.Errorf("result %d is for %s, want %s", i, result.ProfileToken, requests[i].ProfileToken)
+			continue
+		}
+		if result.Device == locked {
+			if result.Err == nil {
+				t.Errorf("%s: snapshot with a wrong password", result.ProfileToken)
+			}
+			continue
+		}
+		if result.Err != nil || result.Snapshot == nil || result.Snapshot.ProfileToken != result.ProfileToken {
+			t.Errorf("%s: snapshot %+v, %v", result.ProfileToken, result.Snapshot, result.Err)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if maxInFlight != 2 {
+		t.Errorf("%d downloads in flight, want 2", maxInFlight)
+	}
+}
@@ -0,0 +1,192 @@
+package metadata
+
+import (
+	"encoding/xml"
+	"strings"
+	"time"
+)
+
+// Document is a tt:MetadataStream document
+type Document struct {
+	XMLName xml.Name `xml:"MetadataStream"`
+	// Frames are the VideoAnalytics frames
+	Frames []Frame `xml:"VideoAnalytics>Frame"`
+	// PTZStatus are the PTZ positions and move status
+	PTZStatus []PTZStatus `xml:"PTZ>PTZStatus"`
+	// Notifications are the events embedded in the stream
+	Notifications []Notification `xml:"Event>NotificationMessage"`
+}
+
+// Frame is the scene description of a video frame
+type Frame struct {
+	UtcTime time.Time `xml:"UtcTime,attr"`
+	// Source is the video source of the frame, when sent
+	Source string `xml:"Source,attr"`
+	// Transformation maps the coordinates of the shapes to the normalized
+	// [-1, 1] video coordinates, nil when they are already normalized
+	Transformation *Transformation `xml:"Transformation"`
+	Objects        []Object        `xml:"Object"`
+}
+
+// Transformation scales then translates a point
+type Transformation struct {
+	Translate *Vector `xml:"Translate"`
+	Scale     *Vector `xml:"Scale"`
+}
+
+// Apply returns v in the normalized video coordinates
+func (t *Transformation) Apply(v Vector) Vector {
+	if t == nil {
+		return v
+	}
+	if t.Scale != nil {
+		v.X, v.Y = v.X*t.Scale.X, v.Y*t.Scale.Y
+	}
+	if t.Translate != nil {
+		v.X, v.Y = v.X+t.Translate.X, v.Y+t.Translate.Y
+	}
+	return v
+}
+
+// Object is an object detected in a frame
+type Object struct {
+	ObjectID int `xml:"ObjectId,attr"`
+	// Parent is the ObjectId of the object containing this one, when sent
+	Parent    *int       `xml:"Parent,attr"`
+	Shape     *Shape     `xml:"Appearance>Shape"`
+	Class     *Class     `xml:"Appearance>Class"`
+	Behaviour *Behaviour `xml:"Behaviour"`
+}
+
+// Removed reports whether the object left the scene
+func (o Object) Removed() bool {
+	return o.Behaviour != nil && o.Behaviour.Removed != nil
+}
+
+// Idle reports whether the object stopped moving
+func (o Object) Idle() bool {
+	return o.Behaviour != nil && o.Behaviour.Idle != nil
+}
+
+// Behaviour flags an object as removed or idle
+type Behaviour struct {
+	Removed *struct{} `xml:"Removed"`
+	Idle    *struct{} `xml:"Idle"`
+}
+
+// Shape locates an object
+type Shape struct {
+	BoundingBox     Rectangle `xml:"BoundingBox"`
+	CenterOfGravity Vector    `xml:"CenterOfGravity"`
+	Polygon         []Vector  `xml:"Polygon>Point"`
+}
+
+// Rectangle is a bounding box
+type Rectangle struct {
+	Left   float64 `xml:"left,attr"`
+	Top    float64 `xml:"top,attr"`
+	Right  float64 `xml:"right,attr"`
+	Bottom float64 `xml:"bottom,attr"`
+}
+
+// Vector is a point, or a pan/tilt position with its space
+type Vector struct {
+	X     float64 `xml:"x,attr"`
+	Y     float64 `xml:"y,attr"`
+	Space string  `xml:"space,attr"`
+}
+
+// Class is the classification of an object, ONVIF 2.x devices send Types
+// and older ones Candidates
+type Class struct {
+	Candidates []ClassCandidate `xml:"ClassCandidate"`
+	Types      []ClassType      `xml:"Type"`
+}
+
+// ClassCandidate is a class and its likelihood
+type ClassCandidate struct {
+	Type       string  `xml:"Type"`
+	Likelihood float64 `xml:"Likelihood"`
+}
+
+// ClassType is a class and its likelihood
+type ClassType struct {
+	Value      string  `xml:",chardata"`
+	Likelihood float64 `xml:"Likelihood,attr"`
+}
+
+// Best returns the most likely class
+func (c *Class) Best() (string, float64) {
+	var class string
+	likelihood := -1.0
+	for _, candidate := range c.Candidates {
+		if candidate.Likelihood > likelihood {
+			class, likelihood = candidate.Type, candidate.Likelihood
+		}
+	}
+	for _, t := range c.Types {
+		if t.Likelihood > likelihood {
+			class, likelihood = strings.TrimSpace(t.Value), t.Likelihood
+		}
+	}
+	if likelihood < 0 {
+		return "", 0
+	}
+	return class, likelihood
+}
+
+// PTZStatus is the position and move status of a PTZ node
+type PTZStatus struct {
+	UtcTime time.Time `xml:"UtcTime"`
+	PanTilt *Vector   `xml:"Position>PanTilt"`
+	Zoom    *Vector   `xml:"Position>Zoom"`
+	// PanTiltStatus and ZoomStatus are IDLE, MOVING or UNKNOWN
+	PanTiltStatus string `xml:"MoveStatus>PanTilt"`
+	ZoomStatus    string `xml:"MoveStatus>Zoom"`
+}
+
+// Notification is an event of the stream
+type Notification struct {
+	Topic   string  `xml:"Topic"`
+	Message Message `xml:"Message>Message"`
+}
+
+// Message is the content of an event
+type Message struct {
+	UtcTime time.Time `xml:"UtcTime,attr"`
+	// PropertyOperation is Initialized, Changed or Deleted for property events
+	PropertyOperation string       `xml:"PropertyOperation,attr"`
+	Source            []SimpleItem `xml:"Source>SimpleItem"`
+	Key               []SimpleItem `xml:"Key>SimpleItem"`
+	Data              []SimpleItem `xml:"Data>SimpleItem"`
+}
+
+// SimpleItem is a name/value pair of a message
+type SimpleItem struct {
+	Name  string `xml:"Name,attr"`
+	Value string `xml:"Value,attr"`
+}
+
+// Item returns the value of the item name of the source, key or data of m
+func (m Message) Item(name string) (string, bool) {
+	for _, items := range [][]SimpleItem{m.Source, m.Key, m.Data} {
+		for _, item := range items {
+			if item.Name == name {
+				return item.Value, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Parse decodes a tt:MetadataStream document
+func Parse(data []byte) (*Document, error) {
+	var document Document
+	if err := xml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	for i := range document.Notifications {
+		document.Notifications[i].Topic = strings.TrimSpace(document.Notifications[i].Topic)
+	}
+	return &document, nil
+}
@@ -0,0 +1,129 @@
+package metadata
+
+import (
+	"encoding/binary"
+	"math"
+	"os"
+	"testing"
+	"time"
+)
+
+// readCapture returns the RTP packets of channel 0 of an RTSP session
+// interleaved over TCP
+func readCapture(t *testing.T, name string) [][]byte {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var packets [][]byte
+	for len(data) >= 4 && data[0] == '$' {
+		size := int(binary.BigEndian.Uint16(data[2:4]))
+		if len(data) < 4+size {
+			t.Fatalf("%s: interleaved frame of %d bytes truncated to %d", name, size, len(data)-4)
+		}
+		if data[1] == 0 {
+			packets = append(packets, data[4:4+size])
+		}
+		data = data[4+size:]
+	}
+	return packets
+}
+
+func reassemble(t *testing.T, packets [][]byte) (*Reassembler, []*Document) {
+	r := NewReassembler()
+	var documents []*Document
+	for _, packet := range packets {
+		data, err := r.Push(packet)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data == nil {
+			continue
+		}
+		document, err := Parse(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		documents = append(documents, document)
+	}
+	return r, documents
+}
+
+// TestCapture reads a synthetic capture: the packets were built by hand after
+// the metadata stream of a camera (fixed SSRC, consecutive sequence numbers)
+// and not recorded from a device
+func TestCapture(t *testing.T) {
+	packets := readCapture(t, "testdata/analytics.rtsp")
+	_, documents := reassemble(t, packets)
+	if len(documents) != 3 {
+		t.Fatalf("got %d documents, want 3", len(documents))
+	}
+
+	frames := documents[0].Frames
+	if len(frames) != 1 || len(frames[0].Objects) != 3 {
+		t.Fatalf("unexpected frames %+v", frames)
+	}
+	frame := frames[0]
+	if !frame.UtcTime.Equal(time.Date(2024, 3, 5, 10, 15, 30, 250e6, time.UTC)) || frame.Source != "VideoSourceConfig_1" {
+		t.Errorf("frame time %v source %s", frame.UtcTime, frame.Source)
+	}
+	human, car, removed := frame.Objects[0], frame.Objects[1], frame.Objects[2]
+	if class, likelihood := human.Class.Best(); human.ObjectID != 12 || class != "Human" || likelihood != 0.87 {
+		t.Errorf("object %d class %s %g", human.ObjectID, class, likelihood)
+	}
+	if box := human.Shape.BoundingBox; box != (Rectangle{Left: 480, Top: 270, Right: 720, Bottom: 810}) || len(human.Shape.Polygon) != 4 {
+		t.Errorf("shape %+v", human.Shape)
+	}
+	center := frame.Transformation.Apply(human.Shape.CenterOfGravity)
+	if math.Abs(center.X+0.375) > 1e-3 || math.Abs(center.Y) > 1e-3 {
+		t.Errorf("normalized center %+v", center)
+	}
+	if class, likelihood := car.Class.Best(); car.Parent == nil || *car.Parent != 12 || class != "Car" || likelihood != 0.92 {
+		t.Errorf("object %+v class %s %g", car, class, likelihood)
+	}
+	if !removed.Removed() || removed.Idle() || removed.Shape != nil {
+		t.Errorf("removed object %+v", removed)
+	}
+
+	status := documents[1].PTZStatus
+	if len(status) != 1 || status[0].PanTilt.X != 0.25 || status[0].PanTilt.Y != -0.5 || status[0].Zoom.X != 0.1 ||
+		status[0].PanTiltStatus != "MOVING" || status[0].ZoomStatus != "IDLE" || status[0].UtcTime.IsZero() {
+		t.Errorf("unexpected PTZ status %+v", status)
+	}
+
+	notifications := documents[2].Notifications
+	if len(notifications) != 1 {
+		t.Fatalf("got %d notifications, want 1", len(notifications))
+	}
+	motion := notifications[0]
+	if motion.Topic != "tns1:RuleEngine/CellMotionDetector/Motion" || motion.Message.PropertyOperation != "Changed" {
+		t.Errorf("unexpected notification %+v", motion)
+	}
+	if value, ok := motion.Message.Item("IsMotion"); !ok || value != "true" {
+		t.Errorf("IsMotion = %s, %v", value, ok)
+	}
+	if value, _ := motion.Message.Item("Rule"); value != "MyMotionDetectorRule" {
+		t.Errorf("Rule = %s", value)
+	}
+}
+
+func TestCaptureLoss(t *testing.T) {
+	packets := readCapture(t, "testdata/analytics.rtsp")
+	// lose the second packet of the analytics document
+	lossy := append([][]byte{packets[0]}, packets[2:]...)
+	r, documents := reassemble(t, lossy)
+	if len(documents) != 2 || len(documents[0].PTZStatus) != 1 || len(documents[1].Notifications) != 1 {
+		t.Fatalf("got %d documents after loss, want PTZ and event", len(documents))
+	}
+	if r.Dropped != 1 {
+		t.Errorf("dropped %d documents, want 1", r.Dropped)
+	}
+
+	r = &Reassembler{MaxSize: 500}
+	if _, err := r.Push(packets[0]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Push(packets[1]); err != ErrDocumentTooLarge {
+		t.Errorf("got %v, want ErrDocumentTooLarge", err)
+	}
+}
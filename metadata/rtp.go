@@ -0,0 +1,133 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// DefaultMaxDocumentSize bounds the documents reassembled by a Reassembler
+const DefaultMaxDocumentSize = 1 << 20
+
+// ErrDocumentTooLarge is returned when a document exceeds MaxSize
+var ErrDocumentTooLarge = errors.New("metadata document too large")
+
+// Reassembler rebuilds the XML documents of an RTP metadata track: the
+// payloads of a document share the RTP timestamp and the marker bit is set on
+// its last packet. Documents missing a packet are dropped
+type Reassembler struct {
+	// MaxSize bounds a document, DefaultMaxDocumentSize when 0
+	MaxSize int
+	// Dropped counts the documents dropped because of lost packets
+	Dropped int
+
+	buf       []byte
+	sequence  uint16
+	timestamp uint32
+	started   bool
+	// syncing discards packets until the start of the next document
+	syncing bool
+}
+
+// NewReassembler returns a Reassembler
+func NewReassembler() *Reassembler {
+	return &Reassembler{}
+}
+
+// Push adds an RTP packet and returns the document it completes, nil when
+// the document continues in the next packets
+func (r *Reassembler) Push(packet []byte) ([]byte, error) {
+	header, payload, err := parseRTP(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	lost := r.started && header.sequence != r.sequence+1
+	r.started, r.sequence = true, header.sequence
+	if len(r.buf) > 0 && (lost || header.timestamp != r.timestamp) {
+		r.buf = r.buf[:0]
+		r.Dropped++
+		r.syncing = true
+	} else if lost {
+		r.syncing = true
+	}
+	if r.syncing {
+		// after a loss only a packet starting a document can be trusted
+		if !isDocumentStart(payload) {
+			if header.marker {
+				r.syncing = false
+			}
+			return nil, nil
+		}
+		r.syncing = false
+	}
+
+	maxSize := r.MaxSize
+	if maxSize == 0 {
+		maxSize = DefaultMaxDocumentSize
+	}
+	if len(r.buf)+len(payload) > maxSize {
+		r.buf = r.buf[:0]
+		r.Dropped++
+		r.syncing = !header.marker
+		return nil, ErrDocumentTooLarge
+	}
+	r.buf = append(r.buf, payload...)
+	r.timestamp = header.timestamp
+	if !header.marker {
+		return nil, nil
+	}
+	document := make([]byte, len(r.buf))
+	copy(document, r.buf)
+	r.buf = r.buf[:0]
+	return document, nil
+}
+
+type rtpHeader struct {
+	marker    bool
+	sequence  uint16
+	timestamp uint32
+}
+
+// parseRTP returns the header and the payload of an RTP packet (RFC 3550)
+func parseRTP(packet []byte) (rtpHeader, []byte, error) {
+	if len(packet) < 12 {
+		return rtpHeader{}, nil, fmt.Errorf("short RTP packet of %d bytes", len(packet))
+	}
+	if packet[0]>>6 != 2 {
+		return rtpHeader{}, nil, fmt.Errorf("unsupported RTP version %d", packet[0]>>6)
+	}
+	header := rtpHeader{
+		marker:    packet[1]&0x80 != 0,
+		sequence:  binary.BigEndian.Uint16(packet[2:4]),
+		timestamp: binary.BigEndian.Uint32(packet[4:8]),
+	}
+	offset := 12 + 4*int(packet[0]&0x0f)
+	if packet[0]&0x10 != 0 {
+		if len(packet) < offset+4 {
+			return header, nil, fmt.Errorf("truncated RTP header extension")
+		}
+		offset += 4 + 4*int(binary.BigEndian.Uint16(packet[offset+2:offset+4]))
+	}
+	end := len(packet)
+	if packet[0]&0x20 != 0 {
+		end -= int(packet[len(packet)-1])
+	}
+	if offset > end {
+		return header, nil, fmt.Errorf("truncated RTP packet")
+	}
+	return header, packet[offset:end], nil
+}
+
+// isDocumentStart reports whether payload starts an XML document
+func isDocumentStart(payload []byte) bool {
+	payload = bytes.TrimLeft(payload, " \t\r\n\xef\xbb\xbf")
+	if bytes.HasPrefix(payload, []byte("<?xml")) {
+		return true
+	}
+	if len(payload) > 64 {
+		payload = payload[:64]
+	}
+	return bytes.HasPrefix(payload, []byte("<")) && bytes.Contains(payload, []byte("MetadataStream"))
+}
@@ -0,0 +1,198 @@
+package gonvif
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// SoapRequest is a single SOAP call as seen by a Middleware.
+// Middlewares may rewrite Envelope before passing the request on
+type SoapRequest struct {
+	Context   context.Context
+	Operation string // operation name without prefix, e.g. GetProfiles
+	Action    string // SOAP action URI
+	Endpoint  string // service endpoint the envelope is posted to
+	Envelope  []byte // full request envelope, including WS-Security header
+}
+
+// SoapHandler sends a SoapRequest and returns the device response
+type SoapHandler func(req *SoapRequest) (*http.Response, error)
+
+// Middleware wraps the transport of every SOAP call made by a Device.
+// It must call next to reach the device and may inspect or replace the
+// response, use PeekBody to read the response body without consuming it
+type Middleware interface {
+	RoundTrip(req *SoapRequest, next SoapHandler) (*http.Response, error)
+}
+
+// MiddlewareFunc adapts an ordinary function to the Middleware interface
+type MiddlewareFunc func(req *SoapRequest, next SoapHandler) (*http.Response, error)
+
+// RoundTrip calls f(req, next)
+func (f MiddlewareFunc) RoundTrip(req *SoapRequest, next SoapHandler) (*http.Response, error) {
+	return f(req, next)
+}
+
+// Use appends middlewares to the chain of the device
+func (dev *Device) Use(middlewares ...Middleware) {
+	dev.params.Middlewares = append(dev.params.Middlewares, middlewares...)
+}
+
+// roundTrip runs req through the middleware chain down to the transport
+func (dev Device) roundTrip(req *SoapRequest) (*http.Response, error) {
+	handler := dev.send
+	for i := len(dev.params.Middlewares) - 1; i >= 0; i-- {
+		middleware, next := dev.params.Middlewares[i], handler
+		handler = func(req *SoapRequest) (*http.Response, error) {
+			return middleware.RoundTrip(req, next)
+		}
+	}
+	return handler(req)
+}
+
+// PeekBody reads the whole response body and puts it back so that the
+// response can still be consumed by the caller
+func PeekBody(resp *http.Response) ([]byte, error) {
+	if resp == nil || resp.Body == nil {
+		return nil, nil
+	}
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	return data, err
+}
+
+var securityRegexp = regexp.MustCompile(`(<(?:[\w.-]+:)?(?:Password|Nonce)\b[^>]*>)[^<]*(</)`)
+
+// RedactSecurity masks WS-Security password digests, nonces and plain
+// passwords (e.g. CreateUsers) of an envelope so it can be logged
+func RedactSecurity(envelope []byte) []byte {
+	return securityRegexp.ReplaceAll(envelope, []byte("${1}***${2}"))
+}
+
+// LoggingMiddleware logs every request and response envelope through logf
+// (e.g. log.Printf) with WS-Security credentials redacted
+func LoggingMiddleware(logf func(format string, args ...interface{})) Middleware {
+	return MiddlewareFunc(func(req *SoapRequest, next SoapHandler) (*http.Response, error) {
+		logf("onvif %s -> %s\n%s", req.Operation, req.Endpoint, RedactSecurity(req.Envelope))
+		resp, err := next(req)
+		if err != nil {
+			logf("onvif %s <- %s: %v", req.Operation, req.Endpoint, err)
+			return resp, err
+		}
+		body, _ := PeekBody(resp)
+		logf("onvif %s <- %s %s\n%s", req.Operation, req.Endpoint, resp.Status, RedactSecurity(body))
+		return resp, err
+	})
+}
+
+// DumpMiddleware writes every request and response envelope to dir, one file
+// per message named <time>-<sequence>-<operation>-{request,response}.xml.
+// Credentials are redacted, write failures are logged and do not fail the call
+func DumpMiddleware(dir string) Middleware {
+	var sequence uint64
+	dump := func(prefix, kind string, data []byte) {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			log.Println(err)
+			return
+		}
+		name := filepath.Join(dir, prefix+"-"+kind+".xml")
+		if err := os.WriteFile(name, RedactSecurity(data), 0o644); err != nil {
+			log.Println(err)
+		}
+	}
+	return MiddlewareFunc(func(req *SoapRequest, next SoapHandler) (*http.Response, error) {
+		prefix := fmt.Sprintf("%s-%06d-%s", time.Now().Format("20060102T150405.000"), atomic.AddUint64(&sequence, 1), req.Operation)
+		dump(prefix, "request", req.Envelope)
+		resp, err := next(req)
+		if err != nil {
+			dump(prefix, "error", []byte(err.Error()))
+			return resp, err
+		}
+		body, _ := PeekBody(resp)
+		dump(prefix, "response", body)
+		return resp, err
+	})
+}
+
+// LatencyStats aggregates the round trip times of one operation
+type LatencyStats struct {
+	Count  int
+	Errors int
+	Total  time.Duration
+	Min    time.Duration
+	Max    time.Duration
+	Last   time.Duration
+}
+
+// Average returns the mean round trip time
+func (stats LatencyStats) Average() time.Duration {
+	if stats.Count == 0 {
+		return 0
+	}
+	return stats.Total / time.Duration(stats.Count)
+}
+
+// LatencyRecorder is a Middleware recording round trip times per operation.
+// Errors count transport failures and non 2xx responses
+type LatencyRecorder struct {
+	mu    sync.Mutex
+	stats map[string]*LatencyStats
+}
+
+// NewLatencyRecorder returns an empty LatencyRecorder
+func NewLatencyRecorder() *LatencyRecorder {
+	return &LatencyRecorder{stats: make(map[string]*LatencyStats)}
+}
+
+// RoundTrip times the call and records it under the operation name
+func (recorder *LatencyRecorder) RoundTrip(req *SoapRequest, next SoapHandler) (*http.Response, error) {
+	start := time.Now()
+	resp, err := next(req)
+	recorder.Record(req.Operation, time.Since(start), err != nil || resp.StatusCode/100 != 2)
+	return resp, err
+}
+
+// Record adds one measurement of operation
+func (recorder *LatencyRecorder) Record(operation string, elapsed time.Duration, failed bool) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	stats, ok := recorder.stats[operation]
+	if !ok {
+		stats = &LatencyStats{Min: elapsed}
+		recorder.stats[operation] = stats
+	}
+	stats.Count++
+	if failed {
+		stats.Errors++
+	}
+	stats.Total += elapsed
+	stats.Last = elapsed
+	if elapsed < stats.Min {
+		stats.Min = elapsed
+	}
+	if elapsed > stats.Max {
+		stats.Max = elapsed
+	}
+}
+
+// Stats returns a snapshot of the recorded operations
+func (recorder *LatencyRecorder) Stats() map[string]LatencyStats {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	snapshot := make(map[string]LatencyStats, len(recorder.stats))
+	for operation, stats := range recorder.stats {
+		snapshot[operation] = *stats
+	}
+	return snapshot
+}
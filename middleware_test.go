@@ -0,0 +1,100 @@
+package gonvif
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sonnt85/gonvif/media"
+)
+
+func TestRedactSecurity(t *testing.T) {
+	tests := []struct {
+		envelope, want string
+	}{
+		{
+			`<wsse:UsernameToken><wsse:Username>admin</wsse:Username>` +
+				`<wsse:Password Type="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordDigest">edBuG+qVavQKLoWuGWQdPab4IBE=</wsse:Password>` +
+				`<wsse:Nonce EncodingType="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-soap-message-security-1.0#Base64Binary">S7wO1ZFTh0KXv2CR7bd2ZXkLAAAAAA==</wsse:Nonce>` +
+				`<wsu:Created>2024-01-01T00:00:00Z</wsu:Created></wsse:UsernameToken>`,
+			`<wsse:UsernameToken><wsse:Username>admin</wsse:Username>` +
+				`<wsse:Password Type="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordDigest">***</wsse:Password>` +
+				`<wsse:Nonce EncodingType="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-soap-message-security-1.0#Base64Binary">***</wsse:Nonce>` +
+				`<wsu:Created>2024-01-01T00:00:00Z</wsu:Created></wsse:UsernameToken>`,
+		},
+		{
+			`<UsernameToken><Password>digest</Password><Nonce>nonce</Nonce></UsernameToken>`,
+			`<UsernameToken><Password>***</Password><Nonce>***</Nonce></UsernameToken>`,
+		},
+		{
+			`<tds:CreateUsers><tds:User><tt:Username>op</tt:Username><tt:Password>s3cret</tt:Password><tt:UserLevel>Operator</tt:UserLevel></tds:User></tds:CreateUsers>`,
+			`<tds:CreateUsers><tds:User><tt:Username>op</tt:Username><tt:Password>***</tt:Password><tt:UserLevel>Operator</tt:UserLevel></tds:User></tds:CreateUsers>`,
+		},
+		{
+			`<tt:PasswordOther>kept</tt:PasswordOther><tt:Nonces>kept</tt:Nonces>`,
+			`<tt:PasswordOther>kept</tt:PasswordOther><tt:Nonces>kept</tt:Nonces>`,
+		},
+	}
+	for _, test := range tests {
+		if got := string(RedactSecurity([]byte(test.envelope))); got != test.want {
+			t.Errorf("RedactSecurity(%s) = %s", test.envelope, got)
+		}
+	}
+}
+
+func TestMiddlewares(t *testing.T) {
+	server := fakeCamera(t, false)
+	defer server.Close()
+
+	dev, err := NewDevice(DeviceParams{Xaddr: strings.TrimPrefix(server.URL, "http://"), Username: "admin", Password: "secret",
+		EndpointPolicy: EndpointKeepAdvertised})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var calls []string
+	var envelope []byte
+	trace := func(name string) Middleware {
+		return MiddlewareFunc(func(req *SoapRequest, next SoapHandler) (*http.Response, error) {
+			calls = append(calls, name+" "+req.Operation)
+			envelope = req.Envelope
+			resp, err := next(req)
+			if body, err := PeekBody(resp); err != nil || !strings.Contains(string(body), "GetProfilesResponse") {
+				t.Errorf("%s peeked %q, %v", name, body, err)
+			}
+			calls = append(calls, name+" "+resp.Status)
+			return resp, err
+		})
+	}
+	dir := t.TempDir()
+	dev.Use(trace("outer"), trace("inner"), DumpMiddleware(dir))
+
+	var response media.GetProfilesResponse
+	if err := dev.CallMethodUnmarshal(context.Background(), media.GetProfiles{}, &response); err != nil {
+		t.Fatal(err)
+	}
+	// the bodies peeked by both middlewares are still decoded
+	if len(response.Profiles) != 1 || response.Profiles[0].Token != "main" {
+		t.Errorf("unexpected response %+v", response)
+	}
+	want := []string{"outer GetProfiles", "inner GetProfiles", "inner 200 OK", "outer 200 OK"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %q, want %q", calls, want)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*-GetProfiles-request.xml"))
+	if len(files) != 1 {
+		t.Fatalf("dumped requests %v", files)
+	}
+	dumped, _ := os.ReadFile(files[0])
+	if !strings.Contains(string(envelope), "PasswordDigest") || !strings.Contains(string(dumped), "PasswordDigest\">***<") ||
+		!strings.Contains(string(dumped), "Base64Binary\">***<") {
+		t.Errorf("credentials not redacted in the dump:\n%s", dumped)
+	}
+	if files, _ := filepath.Glob(filepath.Join(dir, "*-GetProfiles-response.xml")); len(files) != 1 {
+		t.Errorf("dumped responses %v", files)
+	}
+}
@@ -0,0 +1,197 @@
+package networking
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// HTTP authentication schemes reported by GetWithAuth
+const (
+	AuthNone   = "none"
+	AuthBasic  = "basic"
+	AuthDigest = "digest"
+)
+
+// GetWithAuth sends a GET request to rawURL and answers an authentication
+// challenge of the server with username and password, using Digest (MD5,
+// MD5-sess or SHA-256, qop auth) when offered and Basic otherwise. It returns
+// the response and the scheme that was accepted.
+// Credentials embedded in rawURL are used when username is empty
+func GetWithAuth(ctx context.Context, httpClient *http.Client, rawURL, username, password string) (*http.Response, string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, "", err
+	}
+	if username == "" && u.User != nil {
+		username = u.User.Username()
+		password, _ = u.User.Password()
+	}
+	u.User = nil
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || username == "" {
+		return resp, AuthNone, err
+	}
+
+	auth := newAuthenticator(resp.Header.Values("WWW-Authenticate"), username, password)
+	if auth == nil {
+		return resp, AuthNone, nil
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, auth.scheme, err
+	}
+	authorization, err := auth.authorize(http.MethodGet, req.URL.RequestURI())
+	if err != nil {
+		return nil, auth.scheme, err
+	}
+	req.Header.Set("Authorization", authorization)
+	resp, err = httpClient.Do(req)
+	return resp, auth.scheme, err
+}
+
+// authenticator answers the authentication challenge of an HTTP or RTSP server
+type authenticator struct {
+	scheme    string
+	challenge string
+	username  string
+	password  string
+}
+
+// newAuthenticator picks Digest among the WWW-Authenticate challenges when
+// supported, Basic otherwise. It returns nil when no challenge can be answered
+func newAuthenticator(challenges []string, username, password string) *authenticator {
+	var auth *authenticator
+	for _, challenge := range challenges {
+		lower := strings.ToLower(challenge)
+		if strings.HasPrefix(lower, "digest ") {
+			if _, err := digestAuthorization(challenge, "GET", "/", username, password); err == nil {
+				return &authenticator{scheme: AuthDigest, challenge: challenge, username: username, password: password}
+			}
+		} else if strings.HasPrefix(lower, "basic") && auth == nil {
+			auth = &authenticator{scheme: AuthBasic, username: username, password: password}
+		}
+	}
+	return auth
+}
+
+// authorize returns the Authorization header of a request
+func (auth *authenticator) authorize(method, uri string) (string, error) {
+	if auth.scheme == AuthDigest {
+		return digestAuthorization(auth.challenge, method, uri, auth.username, auth.password)
+	}
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(auth.username+":"+auth.password)), nil
+}
+
+// digestAuthorization answers a Digest challenge (RFC 7616)
+func digestAuthorization(challenge, method, uri, username, password string) (string, error) {
+	params := parseChallenge(challenge[len("digest "):])
+	realm, nonce := params["realm"], params["nonce"]
+	if nonce == "" {
+		return "", fmt.Errorf("digest challenge without nonce")
+	}
+
+	algorithm := params["algorithm"]
+	var newHash func() hash.Hash
+	switch strings.ToUpper(algorithm) {
+	case "", "MD5", "MD5-SESS":
+		newHash = md5.New
+	case "SHA-256", "SHA-256-SESS":
+		newHash = sha256.New
+	default:
+		return "", fmt.Errorf("unsupported digest algorithm %s", algorithm)
+	}
+	digest := func(s string) string {
+		h := newHash()
+		io.WriteString(h, s)
+		return hex.EncodeToString(h.Sum(nil))
+	}
+
+	cnonceBytes := make([]byte, 8)
+	if _, err := rand.Read(cnonceBytes); err != nil {
+		return "", err
+	}
+	cnonce := hex.EncodeToString(cnonceBytes)
+	nc := "00000001"
+
+	ha1 := digest(username + ":" + realm + ":" + password)
+	if strings.HasSuffix(strings.ToUpper(algorithm), "-SESS") {
+		ha1 = digest(ha1 + ":" + nonce + ":" + cnonce)
+	}
+	ha2 := digest(method + ":" + uri)
+
+	qop := ""
+	for _, q := range strings.Split(params["qop"], ",") {
+		if strings.TrimSpace(q) == "auth" {
+			qop = "auth"
+		}
+	}
+	var response string
+	if qop != "" {
+		response = digest(ha1 + ":" + nonce + ":" + nc + ":" + cnonce + ":" + qop + ":" + ha2)
+	} else {
+		response = digest(ha1 + ":" + nonce + ":" + ha2)
+	}
+
+	authorization := fmt.Sprintf(`Digest username="%s", realm="%s", nonce="%s", uri="%s", response="%s"`,
+		username, realm, nonce, uri, response)
+	if algorithm != "" {
+		authorization += ", algorithm=" + algorithm
+	}
+	if opaque, ok := params["opaque"]; ok {
+		authorization += fmt.Sprintf(`, opaque="%s"`, opaque)
+	}
+	if qop != "" {
+		authorization += fmt.Sprintf(`, qop=%s, nc=%s, cnonce="%s"`, qop, nc, cnonce)
+	}
+	return authorization, nil
+}
+
+// parseChallenge splits the comma separated key=value parameters of a challenge
+func parseChallenge(s string) map[string]string {
+	params := make(map[string]string)
+	for len(s) > 0 {
+		s = strings.TrimLeft(s, " ,")
+		eq := strings.IndexByte(s, '=')
+		if eq < 0 {
+			break
+		}
+		key := strings.ToLower(strings.TrimSpace(s[:eq]))
+		s = strings.TrimLeft(s[eq+1:], " ")
+		var value string
+		if strings.HasPrefix(s, `"`) {
+			end := strings.IndexByte(s[1:], '"')
+			if end < 0 {
+				value, s = s[1:], ""
+			} else {
+				value, s = s[1:end+1], s[end+2:]
+			}
+		} else {
+			end := strings.IndexByte(s, ',')
+			if end < 0 {
+				value, s = s, ""
+			} else {
+				value, s = s[:end], s[end:]
+			}
+		}
+		params[key] = strings.TrimSpace(value)
+	}
+	return params
+}
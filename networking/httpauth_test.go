@@ -0,0 +1,66 @@
+package networking
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func md5hex(s string) string {
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestGetWithAuthDigest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params := parseChallenge(strings.TrimPrefix(r.Header.Get("Authorization"), "Digest "))
+		if params["nonce"] != "abc" {
+			w.Header().Add("WWW-Authenticate", `Basic realm="cam"`)
+			w.Header().Add("WWW-Authenticate", `Digest realm="cam", nonce="abc", qop="auth,auth-int", opaque="xyz"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		ha1 := md5hex("admin:cam:secret")
+		ha2 := md5hex(r.Method + ":" + params["uri"])
+		want := md5hex(ha1 + ":abc:" + params["nc"] + ":" + params["cnonce"] + ":auth:" + ha2)
+		if params["response"] != want || params["uri"] != "/snap.jpg?ch=1" || params["opaque"] != "xyz" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		io.WriteString(w, "ok")
+	}))
+	defer server.Close()
+
+	resp, scheme, err := GetWithAuth(context.Background(), server.Client(), server.URL+"/snap.jpg?ch=1", "admin", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || scheme != AuthDigest {
+		t.Errorf("got %s with %s, want 200 OK with digest", resp.Status, scheme)
+	}
+}
+
+func TestGetWithAuthBasic(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "admin" || pass != "secret" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="cam"`)
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	resp, scheme, err := GetWithAuth(context.Background(), server.Client(), server.URL, "admin", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || scheme != AuthBasic {
+		t.Errorf("got %s with %s, want 200 OK with basic", resp.Status, scheme)
+	}
+}
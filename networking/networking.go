@@ -2,8 +2,11 @@ package networking
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"time"
+
+	"github.com/sonnt85/gonvif/gosoap"
 )
 
 // SendSoap send soap message
@@ -24,3 +27,21 @@ func SendSoapWithTimeout(httpClient *http.Client, endpoint string, message []byt
 	httpClient.Timeout = timeout
 	return httpClient.Post(endpoint, "application/soap+xml; charset=utf-8", bytes.NewReader(message))
 }
+
+// SendSoapVersion send soap message with the content type and action header of the SOAP version
+func SendSoapVersion(httpClient *http.Client, endpoint string, message string, version gosoap.SoapVersion, action string) (*http.Response, error) {
+	return SendSoapContext(context.Background(), httpClient, endpoint, message, version, action)
+}
+
+// SendSoapContext is SendSoapVersion bound to a context
+func SendSoapContext(ctx context.Context, httpClient *http.Client, endpoint string, message string, version gosoap.SoapVersion, action string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewBufferString(message))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", version.ContentType(action))
+	if version == gosoap.SOAP11 {
+		req.Header.Set("SOAPAction", `"`+action+`"`)
+	}
+	return httpClient.Do(req)
+}
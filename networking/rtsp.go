@@ -0,0 +1,457 @@
+package networking
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RTSPMedia is a media description of the SDP returned by DESCRIBE
+type RTSPMedia struct {
+	// Type is the SDP media type: video, audio or application
+	Type string
+	// Codec is the encoding name of the rtpmap, e.g. H264, H265 or PCMU
+	Codec       string
+	ClockRate   int
+	PayloadType int
+	// Control is the absolute URL used to SETUP the media
+	Control string
+	// FormatParams is the fmtp attribute of the payload type
+	FormatParams string
+}
+
+// RTSPProbe is the result of ProbeRTSP
+type RTSPProbe struct {
+	URL string
+	// Auth is the authentication accepted by the server: none, basic or digest
+	Auth   string
+	Server string
+	Medias []RTSPMedia
+	// Describe is the duration of the DESCRIBE exchange, authentication included
+	Describe time.Duration
+	// FirstPacket is the time between PLAY and the first RTP packet, and
+	// FirstPacketMedia the index in Medias of the media it belongs to
+	FirstPacket      time.Duration
+	FirstPacketMedia int
+}
+
+// RTSPProbeOptions tune ProbeRTSP
+type RTSPProbeOptions struct {
+	// Username and Password authenticate with the server, the credentials of
+	// the URL are used when Username is empty
+	Username string
+	Password string
+	// Timeout bounds the whole probe, 10 seconds when 0. The deadline of the
+	// context applies too
+	Timeout time.Duration
+	// DescribeOnly stops after DESCRIBE, without SETUP/PLAY
+	DescribeOnly bool
+	// TLSConfig is used for rtsps URLs
+	TLSConfig *tls.Config
+}
+
+// RTSPError is returned when the server answers a request with an error status
+type RTSPError struct {
+	Method     string
+	StatusCode int
+	Status     string
+}
+
+func (err *RTSPError) Error() string {
+	return fmt.Sprintf("rtsp %s: %d %s", err.Method, err.StatusCode, err.Status)
+}
+
+// ProbeRTSP checks an RTSP stream natively: it sends DESCRIBE, then SETUP for
+// every media with RTP interleaved over the RTSP connection and PLAY, and
+// waits for the first RTP packet. Basic and Digest authentication are answered
+func ProbeRTSP(ctx context.Context, rawURL string, options RTSPProbeOptions) (*RTSPProbe, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if options.Username == "" && u.User != nil {
+		options.Username = u.User.Username()
+		options.Password, _ = u.User.Password()
+	}
+	u.User = nil
+
+	timeout := options.Timeout
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn, err := dialRTSP(ctx, u, options.TLSConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	// unblock reads when ctx is cancelled before the deadline
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.SetDeadline(time.Now())
+		case <-stop:
+		}
+	}()
+
+	client := &rtspConn{conn: conn, reader: bufio.NewReader(conn), username: options.Username, password: options.Password}
+	probe := &RTSPProbe{URL: u.String(), Auth: AuthNone}
+
+	start := time.Now()
+	describe, err := client.do("DESCRIBE", u.String(), textproto.MIMEHeader{"Accept": {"application/sdp"}})
+	if err != nil {
+		return nil, ctxErr(ctx, err)
+	}
+	probe.Describe = time.Since(start)
+	if client.auth != nil {
+		probe.Auth = client.auth.scheme
+	}
+	probe.Server = describe.header.Get("Server")
+
+	base := u.String()
+	if contentBase := describe.header.Get("Content-Base"); contentBase != "" {
+		base = contentBase
+	} else if location := describe.header.Get("Content-Location"); location != "" {
+		base = location
+	}
+	sessionControl, medias := parseSDP(string(describe.body), base)
+	probe.Medias = medias
+	if options.DescribeOnly {
+		return probe, nil
+	}
+	if len(medias) == 0 {
+		return probe, errors.New("rtsp DESCRIBE: no media in SDP")
+	}
+
+	var session string
+	for i, media := range medias {
+		header := textproto.MIMEHeader{"Transport": {fmt.Sprintf("RTP/AVP/TCP;unicast;interleaved=%d-%d", 2*i, 2*i+1)}}
+		if session != "" {
+			header.Set("Session", session)
+		}
+		resp, err := client.do("SETUP", media.Control, header)
+		if err != nil {
+			return probe, ctxErr(ctx, err)
+		}
+		if session == "" {
+			session = strings.TrimSpace(strings.Split(resp.header.Get("Session"), ";")[0])
+		}
+	}
+
+	start = time.Now()
+	play := textproto.MIMEHeader{"Range": {"npt=0.000-"}}
+	if session != "" {
+		play.Set("Session", session)
+	}
+	if _, err := client.do("PLAY", sessionControl, play); err != nil {
+		return probe, ctxErr(ctx, err)
+	}
+
+	for {
+		channel, packet, err := client.readFrame()
+		if err != nil {
+			return probe, ctxErr(ctx, err)
+		}
+		// even channels carry RTP, odd ones RTCP
+		if channel%2 == 0 && len(packet) >= 12 && packet[0]>>6 == 2 {
+			probe.FirstPacket = time.Since(start)
+			probe.FirstPacketMedia = channel / 2
+			break
+		}
+	}
+
+	teardown := textproto.MIMEHeader{}
+	if session != "" {
+		teardown.Set("Session", session)
+	}
+	client.write("TEARDOWN", sessionControl, teardown)
+	return probe, nil
+}
+
+// ctxErr reports the context error instead of the i/o error it caused
+func ctxErr(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// dialRTSP connects to the host of an rtsp or rtsps URL
+func dialRTSP(ctx context.Context, u *url.URL, config *tls.Config) (net.Conn, error) {
+	var dialer net.Dialer
+	switch u.Scheme {
+	case "rtsp":
+		return dialer.DialContext(ctx, "tcp", hostWithPort(u, "554"))
+	case "rtsps":
+		if config == nil {
+			config = new(tls.Config)
+		} else {
+			config = config.Clone()
+		}
+		if config.ServerName == "" {
+			config.ServerName = u.Hostname()
+		}
+		conn, err := dialer.DialContext(ctx, "tcp", hostWithPort(u, "322"))
+		if err != nil {
+			return nil, err
+		}
+		tlsConn := tls.Client(conn, config)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return tlsConn, nil
+	}
+	return nil, fmt.Errorf("unsupported scheme %q", u.Scheme)
+}
+
+func hostWithPort(u *url.URL, port string) string {
+	if u.Port() != "" {
+		return u.Host
+	}
+	return net.JoinHostPort(u.Hostname(), port)
+}
+
+// rtspConn sends RTSP requests over a single connection
+type rtspConn struct {
+	conn     net.Conn
+	reader   *bufio.Reader
+	cseq     int
+	username string
+	password string
+	auth     *authenticator
+}
+
+// rtspResponse is a response of the server
+type rtspResponse struct {
+	statusCode int
+	status     string
+	header     textproto.MIMEHeader
+	body       []byte
+}
+
+// do sends a request and reads its response, authenticating once when the
+// server answers 401
+func (client *rtspConn) do(method, uri string, header textproto.MIMEHeader) (*rtspResponse, error) {
+	for attempt := 0; ; attempt++ {
+		if err := client.write(method, uri, header); err != nil {
+			return nil, err
+		}
+		resp, err := client.readResponse()
+		if err != nil {
+			return nil, err
+		}
+		if resp.statusCode == 401 && attempt == 0 && client.username != "" {
+			if client.auth = newAuthenticator(resp.header.Values("WWW-Authenticate"), client.username, client.password); client.auth != nil {
+				continue
+			}
+		}
+		if resp.statusCode != 200 {
+			return resp, &RTSPError{Method: method, StatusCode: resp.statusCode, Status: resp.status}
+		}
+		return resp, nil
+	}
+}
+
+// write sends a request without body
+func (client *rtspConn) write(method, uri string, header textproto.MIMEHeader) error {
+	client.cseq++
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s RTSP/1.0\r\nCSeq: %d\r\nUser-Agent: gonvif\r\n", method, uri, client.cseq)
+	if client.auth != nil {
+		authorization, err := client.auth.authorize(method, uri)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&b, "Authorization: %s\r\n", authorization)
+	}
+	for key, values := range header {
+		for _, value := range values {
+			fmt.Fprintf(&b, "%s: %s\r\n", key, value)
+		}
+	}
+	b.WriteString("\r\n")
+	_, err := io.WriteString(client.conn, b.String())
+	return err
+}
+
+// readResponse reads the next response, skipping interleaved frames
+func (client *rtspConn) readResponse() (*rtspResponse, error) {
+	for {
+		first, err := client.reader.Peek(1)
+		if err != nil {
+			return nil, err
+		}
+		if first[0] != '$' {
+			break
+		}
+		if _, _, err := client.readFrame(); err != nil {
+			return nil, err
+		}
+	}
+
+	reader := textproto.NewReader(client.reader)
+	line, err := reader.ReadLine()
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.SplitN(line, " ", 3)
+	if len(parts) < 2 || !strings.HasPrefix(parts[0], "RTSP/") {
+		return nil, fmt.Errorf("malformed rtsp status line %q", line)
+	}
+	resp := &rtspResponse{}
+	if resp.statusCode, err = strconv.Atoi(parts[1]); err != nil {
+		return nil, fmt.Errorf("malformed rtsp status line %q", line)
+	}
+	if len(parts) == 3 {
+		resp.status = parts[2]
+	}
+	if resp.header, err = reader.ReadMIMEHeader(); err != nil && err != io.EOF {
+		return nil, err
+	}
+	if length, _ := strconv.Atoi(resp.header.Get("Content-Length")); length > 0 {
+		resp.body = make([]byte, length)
+		if _, err := io.ReadFull(client.reader, resp.body); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// readFrame reads an interleaved frame, skipping the responses sent meanwhile
+func (client *rtspConn) readFrame() (int, []byte, error) {
+	for {
+		first, err := client.reader.Peek(1)
+		if err != nil {
+			return 0, nil, err
+		}
+		if first[0] != '$' {
+			if _, err := client.readResponse(); err != nil {
+				return 0, nil, err
+			}
+			continue
+		}
+		var header [4]byte
+		if _, err := io.ReadFull(client.reader, header[:]); err != nil {
+			return 0, nil, err
+		}
+		packet := make([]byte, binary.BigEndian.Uint16(header[2:]))
+		if _, err := io.ReadFull(client.reader, packet); err != nil {
+			return 0, nil, err
+		}
+		return int(header[1]), packet, nil
+	}
+}
+
+// parseSDP returns the session control URL and the media descriptions of an SDP
+func parseSDP(sdp, base string) (string, []RTSPMedia) {
+	sessionControl := base
+	var medias []RTSPMedia
+
+	for _, line := range strings.Split(sdp, "\n") {
+		line = strings.TrimRight(line, "\r")
+		switch {
+		case strings.HasPrefix(line, "m="):
+			fields := strings.Fields(line[2:])
+			media := RTSPMedia{PayloadType: -1}
+			if len(fields) > 0 {
+				media.Type = fields[0]
+			}
+			if len(fields) > 3 {
+				media.PayloadType, _ = strconv.Atoi(fields[3])
+			}
+			medias = append(medias, media)
+		case strings.HasPrefix(line, "a=control:"):
+			control := strings.TrimSpace(line[len("a=control:"):])
+			if len(medias) == 0 {
+				sessionControl = resolveControl(base, control)
+			} else {
+				medias[len(medias)-1].Control = resolveControl(base, control)
+			}
+		case strings.HasPrefix(line, "a=rtpmap:") && len(medias) != 0:
+			pt, value := splitPayloadAttribute(line[len("a=rtpmap:"):])
+			if pt == medias[len(medias)-1].PayloadType {
+				encoding := strings.Split(value, "/")
+				medias[len(medias)-1].Codec = encoding[0]
+				if len(encoding) > 1 {
+					medias[len(medias)-1].ClockRate, _ = strconv.Atoi(encoding[1])
+				}
+			}
+		case strings.HasPrefix(line, "a=fmtp:") && len(medias) != 0:
+			pt, value := splitPayloadAttribute(line[len("a=fmtp:"):])
+			if pt == medias[len(medias)-1].PayloadType {
+				medias[len(medias)-1].FormatParams = value
+			}
+		}
+	}
+	for i := range medias {
+		if medias[i].Control == "" {
+			medias[i].Control = sessionControl
+		}
+		if medias[i].Codec == "" {
+			medias[i].Codec, medias[i].ClockRate = staticPayload(medias[i].PayloadType)
+		}
+	}
+	return sessionControl, medias
+}
+
+// splitPayloadAttribute splits "<payload type> <value>"
+func splitPayloadAttribute(attribute string) (int, string) {
+	fields := strings.SplitN(strings.TrimSpace(attribute), " ", 2)
+	pt, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return -2, ""
+	}
+	if len(fields) == 1 {
+		return pt, ""
+	}
+	return pt, strings.TrimSpace(fields[1])
+}
+
+// staticPayload returns the encoding of the static RTP payload types (RFC 3551)
+func staticPayload(pt int) (string, int) {
+	switch pt {
+	case 0:
+		return "PCMU", 8000
+	case 8:
+		return "PCMA", 8000
+	case 14:
+		return "MPA", 90000
+	case 26:
+		return "JPEG", 90000
+	case 32:
+		return "MPV", 90000
+	}
+	return "", 0
+}
+
+// resolveControl resolves a control attribute against the base URL
+func resolveControl(base, control string) string {
+	switch {
+	case control == "" || control == "*":
+		return base
+	case strings.HasPrefix(control, "rtsp://") || strings.HasPrefix(control, "rtsps://"):
+		return control
+	}
+	if strings.HasSuffix(base, "/") {
+		return base + control
+	}
+	return base + "/" + control
+}
@@ -0,0 +1,116 @@
+package networking
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSDP = "v=0\r\no=- 0 0 IN IP4 127.0.0.1\r\ns=Stream\r\nt=0 0\r\na=control:*\r\n" +
+	"m=video 0 RTP/AVP 96\r\na=rtpmap:96 H264/90000\r\na=fmtp:96 packetization-mode=1\r\na=control:trackID=1\r\n" +
+	"m=audio 0 RTP/AVP 0\r\na=control:trackID=2\r\n"
+
+// serveRTSP answers one connection like a camera requiring Digest authentication
+// and sends an RTCP then an RTP packet on the video channel after PLAY
+func serveRTSP(t *testing.T, conn net.Conn) {
+	defer conn.Close()
+	reader := textproto.NewReader(bufio.NewReader(conn))
+	for {
+		line, err := reader.ReadLine()
+		if err != nil {
+			return
+		}
+		header, err := reader.ReadMIMEHeader()
+		if err != nil {
+			return
+		}
+		method, uri := strings.Fields(line)[0], strings.Fields(line)[1]
+		reply := func(status string, headers ...string) {
+			fmt.Fprintf(conn, "RTSP/1.0 %s\r\nCSeq: %s\r\n", status, header.Get("Cseq"))
+			for _, h := range headers {
+				fmt.Fprintf(conn, "%s\r\n", h)
+			}
+			fmt.Fprint(conn, "\r\n")
+		}
+
+		authorization := header.Get("Authorization")
+		params := parseChallenge(strings.TrimPrefix(authorization, "Digest "))
+		if ha1 := md5hex("admin:cam:secret"); params["response"] != md5hex(ha1+":n0nce:"+md5hex(method+":"+params["uri"])) || params["uri"] != uri {
+			reply("401 Unauthorized", `WWW-Authenticate: Digest realm="cam", nonce="n0nce"`)
+			continue
+		}
+
+		switch method {
+		case "DESCRIBE":
+			fmt.Fprintf(conn, "RTSP/1.0 200 OK\r\nCSeq: %s\r\nServer: test\r\nContent-Base: %s/\r\nContent-Type: application/sdp\r\nContent-Length: %d\r\n\r\n%s",
+				header.Get("Cseq"), uri, len(testSDP), testSDP)
+		case "SETUP":
+			if !strings.HasSuffix(uri, "/trackID=1") && !strings.HasSuffix(uri, "/trackID=2") {
+				t.Errorf("unexpected SETUP url %s", uri)
+			}
+			reply("200 OK", "Session: 12345678;timeout=60", "Transport: "+header.Get("Transport"))
+		case "PLAY":
+			if header.Get("Session") != "12345678" {
+				t.Errorf("PLAY without session: %v", header)
+			}
+			reply("200 OK", "Session: 12345678")
+			time.Sleep(10 * time.Millisecond)
+			rtcp := []byte{0x80, 200, 0, 1, 0, 0, 0, 0}
+			rtp := []byte{0x80, 96, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1, 0x65}
+			conn.Write(append([]byte{'$', 1, 0, byte(len(rtcp))}, rtcp...))
+			conn.Write(append([]byte{'$', 0, 0, byte(len(rtp))}, rtp...))
+		case "TEARDOWN":
+			reply("200 OK")
+			return
+		}
+	}
+}
+
+func TestProbeRTSP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveRTSP(t, conn)
+		}
+	}()
+
+	url := "rtsp://admin:secret@" + listener.Addr().String() + "/live"
+	probe, err := ProbeRTSP(context.Background(), url, RTSPProbeOptions{Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if probe.Auth != AuthDigest || probe.Server != "test" || probe.FirstPacket <= 0 || probe.FirstPacketMedia != 0 {
+		t.Errorf("unexpected probe %+v", probe)
+	}
+	if len(probe.Medias) != 2 {
+		t.Fatalf("got %d medias, want 2", len(probe.Medias))
+	}
+	video, audio := probe.Medias[0], probe.Medias[1]
+	base := "rtsp://" + listener.Addr().String() + "/live/"
+	if video.Type != "video" || video.Codec != "H264" || video.ClockRate != 90000 || video.PayloadType != 96 ||
+		video.Control != base+"trackID=1" || video.FormatParams != "packetization-mode=1" {
+		t.Errorf("unexpected video media %+v", video)
+	}
+	if audio.Codec != "PCMU" || audio.ClockRate != 8000 || audio.Control != base+"trackID=2" {
+		t.Errorf("unexpected audio media %+v", audio)
+	}
+
+	if _, err := ProbeRTSP(context.Background(), "rtsp://admin:wrong@"+listener.Addr().String()+"/live", RTSPProbeOptions{}); err == nil {
+		t.Error("probe with wrong password succeeded")
+	} else if rtspErr, ok := err.(*RTSPError); !ok || rtspErr.StatusCode != 401 {
+		t.Errorf("wrong password: got %v, want 401", err)
+	}
+}
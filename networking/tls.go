@@ -0,0 +1,97 @@
+package networking
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// CertificateFingerprint returns the SHA-256 fingerprint of a certificate as lower case hex
+func CertificateFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeFingerprint accepts fingerprints written as AA:BB:..., aa bb ... or aabb...
+func normalizeFingerprint(fingerprint string) string {
+	fingerprint = strings.ToLower(fingerprint)
+	fingerprint = strings.NewReplacer(":", "", " ", "", "-", "").Replace(fingerprint)
+	return fingerprint
+}
+
+// LoadTLSConfig builds a tls.Config trusting the PEM CA bundle in caFile and
+// presenting the client certificate in certFile/keyFile. Empty file names are skipped
+func LoadTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	config := new(tls.Config)
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in %s", caFile)
+		}
+		config.RootCAs = pool
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+// NewTLSClient returns a copy of httpClient whose transport uses config and,
+// when pins are given, only accepts servers presenting a certificate with one
+// of the SHA-256 fingerprints. Pinning replaces the CA chain verification so
+// that self-signed cameras can be trusted explicitly.
+// httpClient is not modified, its transport must be nil or an *http.Transport
+func NewTLSClient(httpClient *http.Client, config *tls.Config, pins []string) (*http.Client, error) {
+	var transport *http.Transport
+	switch rt := httpClient.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = rt.Clone()
+	default:
+		return nil, fmt.Errorf("TLS options need an *http.Transport, got %T", rt)
+	}
+
+	if config != nil {
+		transport.TLSClientConfig = config.Clone()
+	} else if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = new(tls.Config)
+	}
+
+	if len(pins) != 0 {
+		pinned := make(map[string]bool, len(pins))
+		for _, pin := range pins {
+			pinned[normalizeFingerprint(pin)] = true
+		}
+		transport.TLSClientConfig.InsecureSkipVerify = true
+		transport.TLSClientConfig.VerifyConnection = func(state tls.ConnectionState) error {
+			for _, cert := range state.PeerCertificates {
+				if pinned[CertificateFingerprint(cert)] {
+					return nil
+				}
+			}
+			if len(state.PeerCertificates) == 0 {
+				return errors.New("server presented no certificate")
+			}
+			return fmt.Errorf("certificate %s of %s is not pinned", CertificateFingerprint(state.PeerCertificates[0]), state.ServerName)
+		}
+	}
+
+	client := *httpClient
+	client.Transport = transport
+	return &client, nil
+}
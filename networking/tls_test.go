@@ -0,0 +1,72 @@
+package networking
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewTLSClient(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	// the refused handshakes are expected
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	defer server.Close()
+	fingerprint := CertificateFingerprint(server.Certificate())
+	// AA:BB:... as printed by openssl
+	var colons []string
+	for i := 0; i < len(fingerprint); i += 2 {
+		colons = append(colons, strings.ToUpper(fingerprint[i:i+2]))
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	tests := []struct {
+		name   string
+		config *tls.Config
+		pins   []string
+		err    string
+	}{
+		{name: "pinned", pins: []string{fingerprint}},
+		{name: "pinned with colons", pins: []string{"00", strings.Join(colons, ":")}},
+		{name: "wrong pin", pins: []string{strings.Repeat("ab", 32)}, err: "is not pinned"},
+		{name: "wrong pin with the CA trusted", config: &tls.Config{RootCAs: pool}, pins: []string{strings.Repeat("ab", 32)}, err: "is not pinned"},
+		{name: "untrusted CA", err: "x509"},
+		{name: "trusted CA", config: &tls.Config{RootCAs: pool}},
+	}
+	for _, test := range tests {
+		httpClient := new(http.Client)
+		client, err := NewTLSClient(httpClient, test.config, test.pins)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if httpClient.Transport != nil {
+			t.Errorf("%s: the client given was modified", test.name)
+		}
+		resp, err := client.Get(server.URL)
+		if err == nil {
+			resp.Body.Close()
+		}
+		switch {
+		case test.err == "" && err != nil:
+			t.Errorf("%s: %v", test.name, err)
+		case test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)):
+			t.Errorf("%s: got %v, want an error with %q", test.name, err, test.err)
+		}
+	}
+
+	if _, err := NewTLSClient(&http.Client{Transport: roundTripper(nil)}, nil, []string{fingerprint}); err == nil {
+		t.Error("transport other than *http.Transport accepted")
+	}
+}
+
+type roundTripper func(*http.Request) (*http.Response, error)
+
+func (f roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
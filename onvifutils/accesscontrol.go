@@ -0,0 +1,28 @@
+// Code generated by gonvif-gen from accesscontrol.wsdl. DO NOT EDIT.
+
+package onvifutils
+
+import "github.com/sonnt85/gonvif/accesscontrol"
+
+func init() {
+	GetOnvifStruct["accesscontrol"] = map[string]interface{}{
+		"GetServiceCapabilities":         &accesscontrol.GetServiceCapabilities{},
+		"GetServiceCapabilitiesResponse": &accesscontrol.GetServiceCapabilitiesResponse{},
+		"GetAccessPointInfoList":         &accesscontrol.GetAccessPointInfoList{},
+		"GetAccessPointInfoListResponse": &accesscontrol.GetAccessPointInfoListResponse{},
+		"GetAccessPointInfo":             &accesscontrol.GetAccessPointInfo{},
+		"GetAccessPointInfoResponse":     &accesscontrol.GetAccessPointInfoResponse{},
+		"GetAreaInfoList":                &accesscontrol.GetAreaInfoList{},
+		"GetAreaInfoListResponse":        &accesscontrol.GetAreaInfoListResponse{},
+		"GetAreaInfo":                    &accesscontrol.GetAreaInfo{},
+		"GetAreaInfoResponse":            &accesscontrol.GetAreaInfoResponse{},
+		"GetAccessPointState":            &accesscontrol.GetAccessPointState{},
+		"GetAccessPointStateResponse":    &accesscontrol.GetAccessPointStateResponse{},
+		"EnableAccessPoint":              &accesscontrol.EnableAccessPoint{},
+		"EnableAccessPointResponse":      &accesscontrol.EnableAccessPointResponse{},
+		"DisableAccessPoint":             &accesscontrol.DisableAccessPoint{},
+		"DisableAccessPointResponse":     &accesscontrol.DisableAccessPointResponse{},
+		"ExternalAuthorization":          &accesscontrol.ExternalAuthorization{},
+		"ExternalAuthorizationResponse":  &accesscontrol.ExternalAuthorizationResponse{},
+	}
+}
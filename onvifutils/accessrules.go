@@ -0,0 +1,26 @@
+// Code generated by gonvif-gen from accessrules.wsdl. DO NOT EDIT.
+
+package onvifutils
+
+import "github.com/sonnt85/gonvif/accessrules"
+
+func init() {
+	GetOnvifStruct["accessrules"] = map[string]interface{}{
+		"GetServiceCapabilities":           &accessrules.GetServiceCapabilities{},
+		"GetServiceCapabilitiesResponse":   &accessrules.GetServiceCapabilitiesResponse{},
+		"GetAccessProfileInfo":             &accessrules.GetAccessProfileInfo{},
+		"GetAccessProfileInfoResponse":     &accessrules.GetAccessProfileInfoResponse{},
+		"GetAccessProfileInfoList":         &accessrules.GetAccessProfileInfoList{},
+		"GetAccessProfileInfoListResponse": &accessrules.GetAccessProfileInfoListResponse{},
+		"GetAccessProfiles":                &accessrules.GetAccessProfiles{},
+		"GetAccessProfilesResponse":        &accessrules.GetAccessProfilesResponse{},
+		"GetAccessProfileList":             &accessrules.GetAccessProfileList{},
+		"GetAccessProfileListResponse":     &accessrules.GetAccessProfileListResponse{},
+		"CreateAccessProfile":              &accessrules.CreateAccessProfile{},
+		"CreateAccessProfileResponse":      &accessrules.CreateAccessProfileResponse{},
+		"ModifyAccessProfile":              &accessrules.ModifyAccessProfile{},
+		"ModifyAccessProfileResponse":      &accessrules.ModifyAccessProfileResponse{},
+		"DeleteAccessProfile":              &accessrules.DeleteAccessProfile{},
+		"DeleteAccessProfileResponse":      &accessrules.DeleteAccessProfileResponse{},
+	}
+}
@@ -0,0 +1,30 @@
+// Code generated by gonvif-gen from actionengine.wsdl. DO NOT EDIT.
+
+package onvifutils
+
+import "github.com/sonnt85/gonvif/actionengine"
+
+func init() {
+	GetOnvifStruct["actionengine"] = map[string]interface{}{
+		"GetSupportedActions":            &actionengine.GetSupportedActions{},
+		"GetSupportedActionsResponse":    &actionengine.GetSupportedActionsResponse{},
+		"GetActions":                     &actionengine.GetActions{},
+		"GetActionsResponse":             &actionengine.GetActionsResponse{},
+		"CreateActions":                  &actionengine.CreateActions{},
+		"CreateActionsResponse":          &actionengine.CreateActionsResponse{},
+		"DeleteActions":                  &actionengine.DeleteActions{},
+		"DeleteActionsResponse":          &actionengine.DeleteActionsResponse{},
+		"ModifyActions":                  &actionengine.ModifyActions{},
+		"ModifyActionsResponse":          &actionengine.ModifyActionsResponse{},
+		"GetServiceCapabilities":         &actionengine.GetServiceCapabilities{},
+		"GetServiceCapabilitiesResponse": &actionengine.GetServiceCapabilitiesResponse{},
+		"GetActionTriggers":              &actionengine.GetActionTriggers{},
+		"GetActionTriggersResponse":      &actionengine.GetActionTriggersResponse{},
+		"CreateActionTriggers":           &actionengine.CreateActionTriggers{},
+		"CreateActionTriggersResponse":   &actionengine.CreateActionTriggersResponse{},
+		"DeleteActionTriggers":           &actionengine.DeleteActionTriggers{},
+		"DeleteActionTriggersResponse":   &actionengine.DeleteActionTriggersResponse{},
+		"ModifyActionTriggers":           &actionengine.ModifyActionTriggers{},
+		"ModifyActionTriggersResponse":   &actionengine.ModifyActionTriggersResponse{},
+	}
+}
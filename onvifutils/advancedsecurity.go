@@ -0,0 +1,102 @@
+// Code generated by gonvif-gen from advancedsecurity.wsdl. DO NOT EDIT.
+
+package onvifutils
+
+import "github.com/sonnt85/gonvif/advancedsecurity"
+
+func init() {
+	GetOnvifStruct["advancedsecurity"] = map[string]interface{}{
+		"GetServiceCapabilities":                            &advancedsecurity.GetServiceCapabilities{},
+		"GetServiceCapabilitiesResponse":                    &advancedsecurity.GetServiceCapabilitiesResponse{},
+		"CreateRSAKeyPair":                                  &advancedsecurity.CreateRSAKeyPair{},
+		"CreateRSAKeyPairResponse":                          &advancedsecurity.CreateRSAKeyPairResponse{},
+		"UploadKeyPairInPKCS8":                              &advancedsecurity.UploadKeyPairInPKCS8{},
+		"UploadKeyPairInPKCS8Response":                      &advancedsecurity.UploadKeyPairInPKCS8Response{},
+		"UploadCertificateWithPrivateKeyInPKCS12":           &advancedsecurity.UploadCertificateWithPrivateKeyInPKCS12{},
+		"UploadCertificateWithPrivateKeyInPKCS12Response":   &advancedsecurity.UploadCertificateWithPrivateKeyInPKCS12Response{},
+		"GetKeyStatus":                                      &advancedsecurity.GetKeyStatus{},
+		"GetKeyStatusResponse":                              &advancedsecurity.GetKeyStatusResponse{},
+		"GetPrivateKeyStatus":                               &advancedsecurity.GetPrivateKeyStatus{},
+		"GetPrivateKeyStatusResponse":                       &advancedsecurity.GetPrivateKeyStatusResponse{},
+		"GetAllKeys":                                        &advancedsecurity.GetAllKeys{},
+		"GetAllKeysResponse":                                &advancedsecurity.GetAllKeysResponse{},
+		"DeleteKey":                                         &advancedsecurity.DeleteKey{},
+		"DeleteKeyResponse":                                 &advancedsecurity.DeleteKeyResponse{},
+		"CreatePKCS10CSR":                                   &advancedsecurity.CreatePKCS10CSR{},
+		"CreatePKCS10CSRResponse":                           &advancedsecurity.CreatePKCS10CSRResponse{},
+		"CreateSelfSignedCertificate":                       &advancedsecurity.CreateSelfSignedCertificate{},
+		"CreateSelfSignedCertificateResponse":               &advancedsecurity.CreateSelfSignedCertificateResponse{},
+		"UploadCertificate":                                 &advancedsecurity.UploadCertificate{},
+		"UploadCertificateResponse":                         &advancedsecurity.UploadCertificateResponse{},
+		"GetCertificate":                                    &advancedsecurity.GetCertificate{},
+		"GetCertificateResponse":                            &advancedsecurity.GetCertificateResponse{},
+		"GetAllCertificates":                                &advancedsecurity.GetAllCertificates{},
+		"GetAllCertificatesResponse":                        &advancedsecurity.GetAllCertificatesResponse{},
+		"DeleteCertificate":                                 &advancedsecurity.DeleteCertificate{},
+		"DeleteCertificateResponse":                         &advancedsecurity.DeleteCertificateResponse{},
+		"CreateCertificationPath":                           &advancedsecurity.CreateCertificationPath{},
+		"CreateCertificationPathResponse":                   &advancedsecurity.CreateCertificationPathResponse{},
+		"GetCertificationPath":                              &advancedsecurity.GetCertificationPath{},
+		"GetCertificationPathResponse":                      &advancedsecurity.GetCertificationPathResponse{},
+		"GetAllCertificationPaths":                          &advancedsecurity.GetAllCertificationPaths{},
+		"GetAllCertificationPathsResponse":                  &advancedsecurity.GetAllCertificationPathsResponse{},
+		"DeleteCertificationPath":                           &advancedsecurity.DeleteCertificationPath{},
+		"DeleteCertificationPathResponse":                   &advancedsecurity.DeleteCertificationPathResponse{},
+		"UploadPassphrase":                                  &advancedsecurity.UploadPassphrase{},
+		"UploadPassphraseResponse":                          &advancedsecurity.UploadPassphraseResponse{},
+		"GetAllPassphrases":                                 &advancedsecurity.GetAllPassphrases{},
+		"GetAllPassphrasesResponse":                         &advancedsecurity.GetAllPassphrasesResponse{},
+		"DeletePassphrase":                                  &advancedsecurity.DeletePassphrase{},
+		"DeletePassphraseResponse":                          &advancedsecurity.DeletePassphraseResponse{},
+		"UploadCRL":                                         &advancedsecurity.UploadCRL{},
+		"UploadCRLResponse":                                 &advancedsecurity.UploadCRLResponse{},
+		"GetCRL":                                            &advancedsecurity.GetCRL{},
+		"GetCRLResponse":                                    &advancedsecurity.GetCRLResponse{},
+		"GetAllCRLs":                                        &advancedsecurity.GetAllCRLs{},
+		"GetAllCRLsResponse":                                &advancedsecurity.GetAllCRLsResponse{},
+		"DeleteCRL":                                         &advancedsecurity.DeleteCRL{},
+		"DeleteCRLResponse":                                 &advancedsecurity.DeleteCRLResponse{},
+		"CreateCertPathValidationPolicy":                    &advancedsecurity.CreateCertPathValidationPolicy{},
+		"CreateCertPathValidationPolicyResponse":            &advancedsecurity.CreateCertPathValidationPolicyResponse{},
+		"GetCertPathValidationPolicy":                       &advancedsecurity.GetCertPathValidationPolicy{},
+		"GetCertPathValidationPolicyResponse":               &advancedsecurity.GetCertPathValidationPolicyResponse{},
+		"GetAllCertPathValidationPolicies":                  &advancedsecurity.GetAllCertPathValidationPolicies{},
+		"GetAllCertPathValidationPoliciesResponse":          &advancedsecurity.GetAllCertPathValidationPoliciesResponse{},
+		"DeleteCertPathValidationPolicy":                    &advancedsecurity.DeleteCertPathValidationPolicy{},
+		"DeleteCertPathValidationPolicyResponse":            &advancedsecurity.DeleteCertPathValidationPolicyResponse{},
+		"AddServerCertificateAssignment":                    &advancedsecurity.AddServerCertificateAssignment{},
+		"AddServerCertificateAssignmentResponse":            &advancedsecurity.AddServerCertificateAssignmentResponse{},
+		"RemoveServerCertificateAssignment":                 &advancedsecurity.RemoveServerCertificateAssignment{},
+		"RemoveServerCertificateAssignmentResponse":         &advancedsecurity.RemoveServerCertificateAssignmentResponse{},
+		"ReplaceServerCertificateAssignment":                &advancedsecurity.ReplaceServerCertificateAssignment{},
+		"ReplaceServerCertificateAssignmentResponse":        &advancedsecurity.ReplaceServerCertificateAssignmentResponse{},
+		"GetAssignedServerCertificates":                     &advancedsecurity.GetAssignedServerCertificates{},
+		"GetAssignedServerCertificatesResponse":             &advancedsecurity.GetAssignedServerCertificatesResponse{},
+		"SetClientAuthenticationRequired":                   &advancedsecurity.SetClientAuthenticationRequired{},
+		"SetClientAuthenticationRequiredResponse":           &advancedsecurity.SetClientAuthenticationRequiredResponse{},
+		"GetClientAuthenticationRequired":                   &advancedsecurity.GetClientAuthenticationRequired{},
+		"GetClientAuthenticationRequiredResponse":           &advancedsecurity.GetClientAuthenticationRequiredResponse{},
+		"AddCertPathValidationPolicyAssignment":             &advancedsecurity.AddCertPathValidationPolicyAssignment{},
+		"AddCertPathValidationPolicyAssignmentResponse":     &advancedsecurity.AddCertPathValidationPolicyAssignmentResponse{},
+		"RemoveCertPathValidationPolicyAssignment":          &advancedsecurity.RemoveCertPathValidationPolicyAssignment{},
+		"RemoveCertPathValidationPolicyAssignmentResponse":  &advancedsecurity.RemoveCertPathValidationPolicyAssignmentResponse{},
+		"ReplaceCertPathValidationPolicyAssignment":         &advancedsecurity.ReplaceCertPathValidationPolicyAssignment{},
+		"ReplaceCertPathValidationPolicyAssignmentResponse": &advancedsecurity.ReplaceCertPathValidationPolicyAssignmentResponse{},
+		"GetAssignedCertPathValidationPolicies":             &advancedsecurity.GetAssignedCertPathValidationPolicies{},
+		"GetAssignedCertPathValidationPoliciesResponse":     &advancedsecurity.GetAssignedCertPathValidationPoliciesResponse{},
+		"AddDot1XConfiguration":                             &advancedsecurity.AddDot1XConfiguration{},
+		"AddDot1XConfigurationResponse":                     &advancedsecurity.AddDot1XConfigurationResponse{},
+		"GetAllDot1XConfigurations":                         &advancedsecurity.GetAllDot1XConfigurations{},
+		"GetAllDot1XConfigurationsResponse":                 &advancedsecurity.GetAllDot1XConfigurationsResponse{},
+		"GetDot1XConfiguration":                             &advancedsecurity.GetDot1XConfiguration{},
+		"GetDot1XConfigurationResponse":                     &advancedsecurity.GetDot1XConfigurationResponse{},
+		"DeleteDot1XConfiguration":                          &advancedsecurity.DeleteDot1XConfiguration{},
+		"DeleteDot1XConfigurationResponse":                  &advancedsecurity.DeleteDot1XConfigurationResponse{},
+		"SetNetworkInterfaceDot1XConfiguration":             &advancedsecurity.SetNetworkInterfaceDot1XConfiguration{},
+		"SetNetworkInterfaceDot1XConfigurationResponse":     &advancedsecurity.SetNetworkInterfaceDot1XConfigurationResponse{},
+		"GetNetworkInterfaceDot1XConfiguration":             &advancedsecurity.GetNetworkInterfaceDot1XConfiguration{},
+		"GetNetworkInterfaceDot1XConfigurationResponse":     &advancedsecurity.GetNetworkInterfaceDot1XConfigurationResponse{},
+		"DeleteNetworkInterfaceDot1XConfiguration":          &advancedsecurity.DeleteNetworkInterfaceDot1XConfiguration{},
+		"DeleteNetworkInterfaceDot1XConfigurationResponse":  &advancedsecurity.DeleteNetworkInterfaceDot1XConfigurationResponse{},
+	}
+}
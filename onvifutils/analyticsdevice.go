@@ -0,0 +1,44 @@
+// Code generated by gonvif-gen from analyticsdevice.wsdl. DO NOT EDIT.
+
+package onvifutils
+
+import "github.com/sonnt85/gonvif/analyticsdevice"
+
+func init() {
+	GetOnvifStruct["analyticsdevice"] = map[string]interface{}{
+		"GetServiceCapabilities":                 &analyticsdevice.GetServiceCapabilities{},
+		"GetServiceCapabilitiesResponse":         &analyticsdevice.GetServiceCapabilitiesResponse{},
+		"DeleteAnalyticsEngineControl":           &analyticsdevice.DeleteAnalyticsEngineControl{},
+		"DeleteAnalyticsEngineControlResponse":   &analyticsdevice.DeleteAnalyticsEngineControlResponse{},
+		"CreateAnalyticsEngineControl":           &analyticsdevice.CreateAnalyticsEngineControl{},
+		"CreateAnalyticsEngineControlResponse":   &analyticsdevice.CreateAnalyticsEngineControlResponse{},
+		"SetAnalyticsEngineControl":              &analyticsdevice.SetAnalyticsEngineControl{},
+		"SetAnalyticsEngineControlResponse":      &analyticsdevice.SetAnalyticsEngineControlResponse{},
+		"GetAnalyticsEngineControl":              &analyticsdevice.GetAnalyticsEngineControl{},
+		"GetAnalyticsEngineControlResponse":      &analyticsdevice.GetAnalyticsEngineControlResponse{},
+		"GetAnalyticsEngineControls":             &analyticsdevice.GetAnalyticsEngineControls{},
+		"GetAnalyticsEngineControlsResponse":     &analyticsdevice.GetAnalyticsEngineControlsResponse{},
+		"GetAnalyticsEngine":                     &analyticsdevice.GetAnalyticsEngine{},
+		"GetAnalyticsEngineResponse":             &analyticsdevice.GetAnalyticsEngineResponse{},
+		"GetAnalyticsEngines":                    &analyticsdevice.GetAnalyticsEngines{},
+		"GetAnalyticsEnginesResponse":            &analyticsdevice.GetAnalyticsEnginesResponse{},
+		"SetVideoAnalyticsConfiguration":         &analyticsdevice.SetVideoAnalyticsConfiguration{},
+		"SetVideoAnalyticsConfigurationResponse": &analyticsdevice.SetVideoAnalyticsConfigurationResponse{},
+		"SetAnalyticsEngineInput":                &analyticsdevice.SetAnalyticsEngineInput{},
+		"SetAnalyticsEngineInputResponse":        &analyticsdevice.SetAnalyticsEngineInputResponse{},
+		"GetAnalyticsEngineInput":                &analyticsdevice.GetAnalyticsEngineInput{},
+		"GetAnalyticsEngineInputResponse":        &analyticsdevice.GetAnalyticsEngineInputResponse{},
+		"GetAnalyticsEngineInputs":               &analyticsdevice.GetAnalyticsEngineInputs{},
+		"GetAnalyticsEngineInputsResponse":       &analyticsdevice.GetAnalyticsEngineInputsResponse{},
+		"GetAnalyticsDeviceStreamUri":            &analyticsdevice.GetAnalyticsDeviceStreamUri{},
+		"GetAnalyticsDeviceStreamUriResponse":    &analyticsdevice.GetAnalyticsDeviceStreamUriResponse{},
+		"GetVideoAnalyticsConfiguration":         &analyticsdevice.GetVideoAnalyticsConfiguration{},
+		"GetVideoAnalyticsConfigurationResponse": &analyticsdevice.GetVideoAnalyticsConfigurationResponse{},
+		"CreateAnalyticsEngineInputs":            &analyticsdevice.CreateAnalyticsEngineInputs{},
+		"CreateAnalyticsEngineInputsResponse":    &analyticsdevice.CreateAnalyticsEngineInputsResponse{},
+		"DeleteAnalyticsEngineInputs":            &analyticsdevice.DeleteAnalyticsEngineInputs{},
+		"DeleteAnalyticsEngineInputsResponse":    &analyticsdevice.DeleteAnalyticsEngineInputsResponse{},
+		"GetAnalyticsState":                      &analyticsdevice.GetAnalyticsState{},
+		"GetAnalyticsStateResponse":              &analyticsdevice.GetAnalyticsStateResponse{},
+	}
+}
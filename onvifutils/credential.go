@@ -0,0 +1,48 @@
+// Code generated by gonvif-gen from credential.wsdl. DO NOT EDIT.
+
+package onvifutils
+
+import "github.com/sonnt85/gonvif/credential"
+
+func init() {
+	GetOnvifStruct["credential"] = map[string]interface{}{
+		"GetServiceCapabilities":                 &credential.GetServiceCapabilities{},
+		"GetServiceCapabilitiesResponse":         &credential.GetServiceCapabilitiesResponse{},
+		"GetSupportedFormatTypes":                &credential.GetSupportedFormatTypes{},
+		"GetSupportedFormatTypesResponse":        &credential.GetSupportedFormatTypesResponse{},
+		"GetCredentialInfo":                      &credential.GetCredentialInfo{},
+		"GetCredentialInfoResponse":              &credential.GetCredentialInfoResponse{},
+		"GetCredentialInfoList":                  &credential.GetCredentialInfoList{},
+		"GetCredentialInfoListResponse":          &credential.GetCredentialInfoListResponse{},
+		"GetCredentials":                         &credential.GetCredentials{},
+		"GetCredentialsResponse":                 &credential.GetCredentialsResponse{},
+		"GetCredentialList":                      &credential.GetCredentialList{},
+		"GetCredentialListResponse":              &credential.GetCredentialListResponse{},
+		"CreateCredential":                       &credential.CreateCredential{},
+		"CreateCredentialResponse":               &credential.CreateCredentialResponse{},
+		"ModifyCredential":                       &credential.ModifyCredential{},
+		"ModifyCredentialResponse":               &credential.ModifyCredentialResponse{},
+		"DeleteCredential":                       &credential.DeleteCredential{},
+		"DeleteCredentialResponse":               &credential.DeleteCredentialResponse{},
+		"GetCredentialState":                     &credential.GetCredentialState{},
+		"GetCredentialStateResponse":             &credential.GetCredentialStateResponse{},
+		"EnableCredential":                       &credential.EnableCredential{},
+		"EnableCredentialResponse":               &credential.EnableCredentialResponse{},
+		"DisableCredential":                      &credential.DisableCredential{},
+		"DisableCredentialResponse":              &credential.DisableCredentialResponse{},
+		"ResetAntipassbackViolation":             &credential.ResetAntipassbackViolation{},
+		"ResetAntipassbackViolationResponse":     &credential.ResetAntipassbackViolationResponse{},
+		"GetCredentialIdentifiers":               &credential.GetCredentialIdentifiers{},
+		"GetCredentialIdentifiersResponse":       &credential.GetCredentialIdentifiersResponse{},
+		"SetCredentialIdentifier":                &credential.SetCredentialIdentifier{},
+		"SetCredentialIdentifierResponse":        &credential.SetCredentialIdentifierResponse{},
+		"DeleteCredentialIdentifier":             &credential.DeleteCredentialIdentifier{},
+		"DeleteCredentialIdentifierResponse":     &credential.DeleteCredentialIdentifierResponse{},
+		"GetCredentialAccessProfiles":            &credential.GetCredentialAccessProfiles{},
+		"GetCredentialAccessProfilesResponse":    &credential.GetCredentialAccessProfilesResponse{},
+		"SetCredentialAccessProfiles":            &credential.SetCredentialAccessProfiles{},
+		"SetCredentialAccessProfilesResponse":    &credential.SetCredentialAccessProfilesResponse{},
+		"DeleteCredentialAccessProfiles":         &credential.DeleteCredentialAccessProfiles{},
+		"DeleteCredentialAccessProfilesResponse": &credential.DeleteCredentialAccessProfilesResponse{},
+	}
+}
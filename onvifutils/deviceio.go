@@ -0,0 +1,64 @@
+// Code generated by gonvif-gen from deviceio.wsdl. DO NOT EDIT.
+
+package onvifutils
+
+import "github.com/sonnt85/gonvif/deviceio"
+
+func init() {
+	GetOnvifStruct["deviceio"] = map[string]interface{}{
+		"GetServiceCapabilities":                      &deviceio.GetServiceCapabilities{},
+		"GetServiceCapabilitiesResponse":              &deviceio.GetServiceCapabilitiesResponse{},
+		"GetRelayOutputOptions":                       &deviceio.GetRelayOutputOptions{},
+		"GetRelayOutputOptionsResponse":               &deviceio.GetRelayOutputOptionsResponse{},
+		"GetAudioSources":                             &deviceio.GetAudioSources{},
+		"GetAudioSourcesResponse":                     &deviceio.GetAudioSourcesResponse{},
+		"GetAudioOutputs":                             &deviceio.GetAudioOutputs{},
+		"GetAudioOutputsResponse":                     &deviceio.GetAudioOutputsResponse{},
+		"GetVideoSources":                             &deviceio.GetVideoSources{},
+		"GetVideoSourcesResponse":                     &deviceio.GetVideoSourcesResponse{},
+		"GetVideoOutputs":                             &deviceio.GetVideoOutputs{},
+		"GetVideoOutputsResponse":                     &deviceio.GetVideoOutputsResponse{},
+		"GetVideoSourceConfiguration":                 &deviceio.GetVideoSourceConfiguration{},
+		"GetVideoSourceConfigurationResponse":         &deviceio.GetVideoSourceConfigurationResponse{},
+		"GetVideoOutputConfiguration":                 &deviceio.GetVideoOutputConfiguration{},
+		"GetVideoOutputConfigurationResponse":         &deviceio.GetVideoOutputConfigurationResponse{},
+		"GetAudioSourceConfiguration":                 &deviceio.GetAudioSourceConfiguration{},
+		"GetAudioSourceConfigurationResponse":         &deviceio.GetAudioSourceConfigurationResponse{},
+		"GetAudioOutputConfiguration":                 &deviceio.GetAudioOutputConfiguration{},
+		"GetAudioOutputConfigurationResponse":         &deviceio.GetAudioOutputConfigurationResponse{},
+		"SetVideoSourceConfiguration":                 &deviceio.SetVideoSourceConfiguration{},
+		"SetVideoSourceConfigurationResponse":         &deviceio.SetVideoSourceConfigurationResponse{},
+		"SetVideoOutputConfiguration":                 &deviceio.SetVideoOutputConfiguration{},
+		"SetVideoOutputConfigurationResponse":         &deviceio.SetVideoOutputConfigurationResponse{},
+		"SetAudioSourceConfiguration":                 &deviceio.SetAudioSourceConfiguration{},
+		"SetAudioSourceConfigurationResponse":         &deviceio.SetAudioSourceConfigurationResponse{},
+		"SetAudioOutputConfiguration":                 &deviceio.SetAudioOutputConfiguration{},
+		"SetAudioOutputConfigurationResponse":         &deviceio.SetAudioOutputConfigurationResponse{},
+		"GetVideoSourceConfigurationOptions":          &deviceio.GetVideoSourceConfigurationOptions{},
+		"GetVideoSourceConfigurationOptionsResponse":  &deviceio.GetVideoSourceConfigurationOptionsResponse{},
+		"GetVideoOutputConfigurationOptions":          &deviceio.GetVideoOutputConfigurationOptions{},
+		"GetVideoOutputConfigurationOptionsResponse":  &deviceio.GetVideoOutputConfigurationOptionsResponse{},
+		"GetAudioSourceConfigurationOptions":          &deviceio.GetAudioSourceConfigurationOptions{},
+		"GetAudioSourceConfigurationOptionsResponse":  &deviceio.GetAudioSourceConfigurationOptionsResponse{},
+		"GetAudioOutputConfigurationOptions":          &deviceio.GetAudioOutputConfigurationOptions{},
+		"GetAudioOutputConfigurationOptionsResponse":  &deviceio.GetAudioOutputConfigurationOptionsResponse{},
+		"SetRelayOutputSettings":                      &deviceio.SetRelayOutputSettings{},
+		"SetRelayOutputSettingsResponse":              &deviceio.SetRelayOutputSettingsResponse{},
+		"GetDigitalInputs":                            &deviceio.GetDigitalInputs{},
+		"GetDigitalInputsResponse":                    &deviceio.GetDigitalInputsResponse{},
+		"GetDigitalInputConfigurationOptions":         &deviceio.GetDigitalInputConfigurationOptions{},
+		"GetDigitalInputConfigurationOptionsResponse": &deviceio.GetDigitalInputConfigurationOptionsResponse{},
+		"SetDigitalInputConfigurations":               &deviceio.SetDigitalInputConfigurations{},
+		"SetDigitalInputConfigurationsResponse":       &deviceio.SetDigitalInputConfigurationsResponse{},
+		"GetSerialPorts":                              &deviceio.GetSerialPorts{},
+		"GetSerialPortsResponse":                      &deviceio.GetSerialPortsResponse{},
+		"GetSerialPortConfiguration":                  &deviceio.GetSerialPortConfiguration{},
+		"GetSerialPortConfigurationResponse":          &deviceio.GetSerialPortConfigurationResponse{},
+		"SetSerialPortConfiguration":                  &deviceio.SetSerialPortConfiguration{},
+		"SetSerialPortConfigurationResponse":          &deviceio.SetSerialPortConfigurationResponse{},
+		"GetSerialPortConfigurationOptions":           &deviceio.GetSerialPortConfigurationOptions{},
+		"GetSerialPortConfigurationOptionsResponse":   &deviceio.GetSerialPortConfigurationOptionsResponse{},
+		"SendReceiveSerialCommand":                    &deviceio.SendReceiveSerialCommand{},
+		"SendReceiveSerialCommandResponse":            &deviceio.SendReceiveSerialCommandResponse{},
+	}
+}
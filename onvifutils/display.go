@@ -0,0 +1,30 @@
+// Code generated by gonvif-gen from display.wsdl. DO NOT EDIT.
+
+package onvifutils
+
+import "github.com/sonnt85/gonvif/display"
+
+func init() {
+	GetOnvifStruct["display"] = map[string]interface{}{
+		"GetServiceCapabilities":          &display.GetServiceCapabilities{},
+		"GetServiceCapabilitiesResponse":  &display.GetServiceCapabilitiesResponse{},
+		"GetLayout":                       &display.GetLayout{},
+		"GetLayoutResponse":               &display.GetLayoutResponse{},
+		"SetLayout":                       &display.SetLayout{},
+		"SetLayoutResponse":               &display.SetLayoutResponse{},
+		"GetDisplayOptions":               &display.GetDisplayOptions{},
+		"GetDisplayOptionsResponse":       &display.GetDisplayOptionsResponse{},
+		"GetPaneConfigurations":           &display.GetPaneConfigurations{},
+		"GetPaneConfigurationsResponse":   &display.GetPaneConfigurationsResponse{},
+		"GetPaneConfiguration":            &display.GetPaneConfiguration{},
+		"GetPaneConfigurationResponse":    &display.GetPaneConfigurationResponse{},
+		"SetPaneConfigurations":           &display.SetPaneConfigurations{},
+		"SetPaneConfigurationsResponse":   &display.SetPaneConfigurationsResponse{},
+		"SetPaneConfiguration":            &display.SetPaneConfiguration{},
+		"SetPaneConfigurationResponse":    &display.SetPaneConfigurationResponse{},
+		"CreatePaneConfiguration":         &display.CreatePaneConfiguration{},
+		"CreatePaneConfigurationResponse": &display.CreatePaneConfigurationResponse{},
+		"DeletePaneConfiguration":         &display.DeletePaneConfiguration{},
+		"DeletePaneConfigurationResponse": &display.DeletePaneConfigurationResponse{},
+	}
+}
@@ -0,0 +1,36 @@
+// Code generated by gonvif-gen from doorcontrol.wsdl. DO NOT EDIT.
+
+package onvifutils
+
+import "github.com/sonnt85/gonvif/doorcontrol"
+
+func init() {
+	GetOnvifStruct["doorcontrol"] = map[string]interface{}{
+		"GetServiceCapabilities":         &doorcontrol.GetServiceCapabilities{},
+		"GetServiceCapabilitiesResponse": &doorcontrol.GetServiceCapabilitiesResponse{},
+		"GetDoorInfoList":                &doorcontrol.GetDoorInfoList{},
+		"GetDoorInfoListResponse":        &doorcontrol.GetDoorInfoListResponse{},
+		"GetDoorInfo":                    &doorcontrol.GetDoorInfo{},
+		"GetDoorInfoResponse":            &doorcontrol.GetDoorInfoResponse{},
+		"GetDoorState":                   &doorcontrol.GetDoorState{},
+		"GetDoorStateResponse":           &doorcontrol.GetDoorStateResponse{},
+		"AccessDoor":                     &doorcontrol.AccessDoor{},
+		"AccessDoorResponse":             &doorcontrol.AccessDoorResponse{},
+		"LockDoor":                       &doorcontrol.LockDoor{},
+		"LockDoorResponse":               &doorcontrol.LockDoorResponse{},
+		"UnlockDoor":                     &doorcontrol.UnlockDoor{},
+		"UnlockDoorResponse":             &doorcontrol.UnlockDoorResponse{},
+		"BlockDoor":                      &doorcontrol.BlockDoor{},
+		"BlockDoorResponse":              &doorcontrol.BlockDoorResponse{},
+		"LockDownDoor":                   &doorcontrol.LockDownDoor{},
+		"LockDownDoorResponse":           &doorcontrol.LockDownDoorResponse{},
+		"LockDownReleaseDoor":            &doorcontrol.LockDownReleaseDoor{},
+		"LockDownReleaseDoorResponse":    &doorcontrol.LockDownReleaseDoorResponse{},
+		"LockOpenDoor":                   &doorcontrol.LockOpenDoor{},
+		"LockOpenDoorResponse":           &doorcontrol.LockOpenDoorResponse{},
+		"LockOpenReleaseDoor":            &doorcontrol.LockOpenReleaseDoor{},
+		"LockOpenReleaseDoorResponse":    &doorcontrol.LockOpenReleaseDoorResponse{},
+		"DoubleLockDoor":                 &doorcontrol.DoubleLockDoor{},
+		"DoubleLockDoorResponse":         &doorcontrol.DoubleLockDoorResponse{},
+	}
+}
@@ -0,0 +1,102 @@
+// Code generated by gonvif-gen from media2.wsdl. DO NOT EDIT.
+
+package onvifutils
+
+import "github.com/sonnt85/gonvif/media2"
+
+func init() {
+	GetOnvifStruct["media2"] = map[string]interface{}{
+		"GetServiceCapabilities":                      &media2.GetServiceCapabilities{},
+		"GetServiceCapabilitiesResponse":              &media2.GetServiceCapabilitiesResponse{},
+		"CreateProfile":                               &media2.CreateProfile{},
+		"CreateProfileResponse":                       &media2.CreateProfileResponse{},
+		"GetProfiles":                                 &media2.GetProfiles{},
+		"GetProfilesResponse":                         &media2.GetProfilesResponse{},
+		"AddConfiguration":                            &media2.AddConfiguration{},
+		"AddConfigurationResponse":                    &media2.AddConfigurationResponse{},
+		"RemoveConfiguration":                         &media2.RemoveConfiguration{},
+		"RemoveConfigurationResponse":                 &media2.RemoveConfigurationResponse{},
+		"DeleteProfile":                               &media2.DeleteProfile{},
+		"DeleteProfileResponse":                       &media2.DeleteProfileResponse{},
+		"GetVideoSourceConfigurations":                &media2.GetVideoSourceConfigurations{},
+		"GetVideoSourceConfigurationsResponse":        &media2.GetVideoSourceConfigurationsResponse{},
+		"GetVideoEncoderConfigurations":               &media2.GetVideoEncoderConfigurations{},
+		"GetVideoEncoderConfigurationsResponse":       &media2.GetVideoEncoderConfigurationsResponse{},
+		"GetAudioSourceConfigurations":                &media2.GetAudioSourceConfigurations{},
+		"GetAudioSourceConfigurationsResponse":        &media2.GetAudioSourceConfigurationsResponse{},
+		"GetAudioEncoderConfigurations":               &media2.GetAudioEncoderConfigurations{},
+		"GetAudioEncoderConfigurationsResponse":       &media2.GetAudioEncoderConfigurationsResponse{},
+		"GetAnalyticsConfigurations":                  &media2.GetAnalyticsConfigurations{},
+		"GetAnalyticsConfigurationsResponse":          &media2.GetAnalyticsConfigurationsResponse{},
+		"GetMetadataConfigurations":                   &media2.GetMetadataConfigurations{},
+		"GetMetadataConfigurationsResponse":           &media2.GetMetadataConfigurationsResponse{},
+		"GetAudioOutputConfigurations":                &media2.GetAudioOutputConfigurations{},
+		"GetAudioOutputConfigurationsResponse":        &media2.GetAudioOutputConfigurationsResponse{},
+		"GetAudioDecoderConfigurations":               &media2.GetAudioDecoderConfigurations{},
+		"GetAudioDecoderConfigurationsResponse":       &media2.GetAudioDecoderConfigurationsResponse{},
+		"SetVideoSourceConfiguration":                 &media2.SetVideoSourceConfiguration{},
+		"SetVideoSourceConfigurationResponse":         &media2.SetVideoSourceConfigurationResponse{},
+		"SetVideoEncoderConfiguration":                &media2.SetVideoEncoderConfiguration{},
+		"SetVideoEncoderConfigurationResponse":        &media2.SetVideoEncoderConfigurationResponse{},
+		"SetAudioSourceConfiguration":                 &media2.SetAudioSourceConfiguration{},
+		"SetAudioSourceConfigurationResponse":         &media2.SetAudioSourceConfigurationResponse{},
+		"SetAudioEncoderConfiguration":                &media2.SetAudioEncoderConfiguration{},
+		"SetAudioEncoderConfigurationResponse":        &media2.SetAudioEncoderConfigurationResponse{},
+		"SetMetadataConfiguration":                    &media2.SetMetadataConfiguration{},
+		"SetMetadataConfigurationResponse":            &media2.SetMetadataConfigurationResponse{},
+		"SetAudioOutputConfiguration":                 &media2.SetAudioOutputConfiguration{},
+		"SetAudioOutputConfigurationResponse":         &media2.SetAudioOutputConfigurationResponse{},
+		"SetAudioDecoderConfiguration":                &media2.SetAudioDecoderConfiguration{},
+		"SetAudioDecoderConfigurationResponse":        &media2.SetAudioDecoderConfigurationResponse{},
+		"GetVideoSourceConfigurationOptions":          &media2.GetVideoSourceConfigurationOptions{},
+		"GetVideoSourceConfigurationOptionsResponse":  &media2.GetVideoSourceConfigurationOptionsResponse{},
+		"GetVideoEncoderConfigurationOptions":         &media2.GetVideoEncoderConfigurationOptions{},
+		"GetVideoEncoderConfigurationOptionsResponse": &media2.GetVideoEncoderConfigurationOptionsResponse{},
+		"GetAudioSourceConfigurationOptions":          &media2.GetAudioSourceConfigurationOptions{},
+		"GetAudioSourceConfigurationOptionsResponse":  &media2.GetAudioSourceConfigurationOptionsResponse{},
+		"GetAudioEncoderConfigurationOptions":         &media2.GetAudioEncoderConfigurationOptions{},
+		"GetAudioEncoderConfigurationOptionsResponse": &media2.GetAudioEncoderConfigurationOptionsResponse{},
+		"GetMetadataConfigurationOptions":             &media2.GetMetadataConfigurationOptions{},
+		"GetMetadataConfigurationOptionsResponse":     &media2.GetMetadataConfigurationOptionsResponse{},
+		"GetAudioOutputConfigurationOptions":          &media2.GetAudioOutputConfigurationOptions{},
+		"GetAudioOutputConfigurationOptionsResponse":  &media2.GetAudioOutputConfigurationOptionsResponse{},
+		"GetAudioDecoderConfigurationOptions":         &media2.GetAudioDecoderConfigurationOptions{},
+		"GetAudioDecoderConfigurationOptionsResponse": &media2.GetAudioDecoderConfigurationOptionsResponse{},
+		"GetVideoEncoderInstances":                    &media2.GetVideoEncoderInstances{},
+		"GetVideoEncoderInstancesResponse":            &media2.GetVideoEncoderInstancesResponse{},
+		"GetStreamUri":                                &media2.GetStreamUri{},
+		"GetStreamUriResponse":                        &media2.GetStreamUriResponse{},
+		"StartMulticastStreaming":                     &media2.StartMulticastStreaming{},
+		"StartMulticastStreamingResponse":             &media2.StartMulticastStreamingResponse{},
+		"StopMulticastStreaming":                      &media2.StopMulticastStreaming{},
+		"StopMulticastStreamingResponse":              &media2.StopMulticastStreamingResponse{},
+		"SetSynchronizationPoint":                     &media2.SetSynchronizationPoint{},
+		"SetSynchronizationPointResponse":             &media2.SetSynchronizationPointResponse{},
+		"GetSnapshotUri":                              &media2.GetSnapshotUri{},
+		"GetSnapshotUriResponse":                      &media2.GetSnapshotUriResponse{},
+		"GetVideoSourceModes":                         &media2.GetVideoSourceModes{},
+		"GetVideoSourceModesResponse":                 &media2.GetVideoSourceModesResponse{},
+		"SetVideoSourceMode":                          &media2.SetVideoSourceMode{},
+		"SetVideoSourceModeResponse":                  &media2.SetVideoSourceModeResponse{},
+		"GetOSDs":                                     &media2.GetOSDs{},
+		"GetOSDsResponse":                             &media2.GetOSDsResponse{},
+		"GetOSDOptions":                               &media2.GetOSDOptions{},
+		"GetOSDOptionsResponse":                       &media2.GetOSDOptionsResponse{},
+		"SetOSD":                                      &media2.SetOSD{},
+		"SetOSDResponse":                              &media2.SetOSDResponse{},
+		"CreateOSD":                                   &media2.CreateOSD{},
+		"CreateOSDResponse":                           &media2.CreateOSDResponse{},
+		"DeleteOSD":                                   &media2.DeleteOSD{},
+		"DeleteOSDResponse":                           &media2.DeleteOSDResponse{},
+		"GetMasks":                                    &media2.GetMasks{},
+		"GetMasksResponse":                            &media2.GetMasksResponse{},
+		"GetMaskOptions":                              &media2.GetMaskOptions{},
+		"GetMaskOptionsResponse":                      &media2.GetMaskOptionsResponse{},
+		"SetMask":                                     &media2.SetMask{},
+		"SetMaskResponse":                             &media2.SetMaskResponse{},
+		"CreateMask":                                  &media2.CreateMask{},
+		"CreateMaskResponse":                          &media2.CreateMaskResponse{},
+		"DeleteMask":                                  &media2.DeleteMask{},
+		"DeleteMaskResponse":                          &media2.DeleteMaskResponse{},
+	}
+}
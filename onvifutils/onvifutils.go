@@ -2,6 +2,7 @@ package onvifutils
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"errors"
 	"fmt"
@@ -13,16 +14,13 @@ import (
 	"strings"
 	"time"
 
-	"github.com/aler9/gortsplib/pkg/url"
 	"github.com/sonnt85/gosutils/slogrus"
 
-	"github.com/aler9/gortsplib"
 	"github.com/beevik/etree"
 	"github.com/lunny/log"
 	"github.com/sonnt85/gonvif"
 	imaging "github.com/sonnt85/gonvif/Imaging"
 	"github.com/sonnt85/gonvif/analytics"
-	"github.com/sonnt85/gosutils/gcurl"
 
 	"github.com/sonnt85/gonvif/device"
 	"github.com/sonnt85/gonvif/event"
@@ -30,14 +28,13 @@ import (
 	"github.com/sonnt85/gonvif/media"
 	"github.com/sonnt85/gonvif/networking"
 	"github.com/sonnt85/gonvif/ptz"
-	"github.com/sonnt85/gosutils/sexec"
 	"github.com/sonnt85/gosutils/sregexp"
 	"github.com/sonnt85/gosutils/sutils"
 	"github.com/sonnt85/snetutils"
 )
 
 var GetOnvifStruct = map[string]map[string]interface{}{
-	"imaging":   {"GetServiceCapabilities": &imaging.GetServiceCapabilities{}, "GetImagingSettings": &imaging.GetImagingSettings{}, "SetImagingSettings": &imaging.SetImagingSettings{}, "GetOptions": &imaging.GetOptions{}, "Move": &imaging.Move{}, "GetMoveOptions": &imaging.GetMoveOptions{}, "Stop": &imaging.Stop{}, "GetStatus": &imaging.GetStatus{}, "GetPresets": &imaging.GetPresets{}, "GetCurrentPreset": &imaging.GetCurrentPreset{}, "SetCurrentPreset": &imaging.SetCurrentPreset{}},
+	"imaging":   {"Capabilities": &imaging.Capabilities{}, "ImagingPreset": &imaging.ImagingPreset{}, "GetServiceCapabilities": &imaging.GetServiceCapabilities{}, "GetServiceCapabilitiesResponse": &imaging.GetServiceCapabilitiesResponse{}, "GetImagingSettings": &imaging.GetImagingSettings{}, "GetImagingSettingsResponse": &imaging.GetImagingSettingsResponse{}, "SetImagingSettings": &imaging.SetImagingSettings{}, "SetImagingSettingsResponse": &imaging.SetImagingSettingsResponse{}, "GetOptions": &imaging.GetOptions{}, "GetOptionsResponse": &imaging.GetOptionsResponse{}, "Move": &imaging.Move{}, "MoveResponse": &imaging.MoveResponse{}, "GetMoveOptions": &imaging.GetMoveOptions{}, "GetMoveOptionsResponse": &imaging.GetMoveOptionsResponse{}, "Stop": &imaging.Stop{}, "StopResponse": &imaging.StopResponse{}, "GetStatus": &imaging.GetStatus{}, "GetStatusResponse": &imaging.GetStatusResponse{}, "GetPresets": &imaging.GetPresets{}, "GetPresetsResponse": &imaging.GetPresetsResponse{}, "GetCurrentPreset": &imaging.GetCurrentPreset{}, "GetCurrentPresetResponse": &imaging.GetCurrentPresetResponse{}, "SetCurrentPreset": &imaging.SetCurrentPreset{}, "SetCurrentPresetResponse": &imaging.SetCurrentPresetResponse{}},
 	"analytics": {"GetSupportedRules": &analytics.GetSupportedRules{}, "CreateRules": &analytics.CreateRules{}, "DeleteRules": &analytics.DeleteRules{}, "GetRules": &analytics.GetRules{}, "GetRuleOptions": &analytics.GetRuleOptions{}, "ModifyRules": &analytics.ModifyRules{}, "GetServiceCapabilities": &analytics.GetServiceCapabilities{}, "GetSupportedAnalyticsModules": &analytics.GetSupportedAnalyticsModules{}, "GetAnalyticsModuleOptions": &analytics.GetAnalyticsModuleOptions{}, "CreateAnalyticsModules": &analytics.CreateAnalyticsModules{}, "DeleteAnalyticsModules": &analytics.DeleteAnalyticsModules{}, "GetAnalyticsModules": &analytics.GetAnalyticsModules{}, "ModifyAnalyticsModules": &analytics.ModifyAnalyticsModules{}},
 	"device":    {"Service": &device.Service{}, "Capabilities": &device.Capabilities{}, "DeviceServiceCapabilities": &device.DeviceServiceCapabilities{}, "NetworkCapabilities": &device.NetworkCapabilities{}, "SecurityCapabilities": &device.SecurityCapabilities{}, "EAPMethodTypes": &device.EAPMethodTypes{}, "SystemCapabilities": &device.SystemCapabilities{}, "MiscCapabilities": &device.MiscCapabilities{}, "StorageConfiguration": &device.StorageConfiguration{}, "StorageConfigurationData": &device.StorageConfigurationData{}, "UserCredential": &device.UserCredential{}, "GetServices": &device.GetServices{}, "GetServicesResponse": &device.GetServicesResponse{}, "GetServiceCapabilities": &device.GetServiceCapabilities{}, "GetServiceCapabilitiesResponse": &device.GetServiceCapabilitiesResponse{}, "GetDeviceInformation": &device.GetDeviceInformation{}, "GetDeviceInformationResponse": &device.GetDeviceInformationResponse{}, "SetSystemDateAndTime": &device.SetSystemDateAndTime{}, "SetSystemDateAndTimeResponse": &device.SetSystemDateAndTimeResponse{}, "GetSystemDateAndTime": &device.GetSystemDateAndTime{}, "GetSystemDateAndTimeResponse": &device.GetSystemDateAndTimeResponse{}, "SetSystemFactoryDefault": &device.SetSystemFactoryDefault{}, "SetSystemFactoryDefaultResponse": &device.SetSystemFactoryDefaultResponse{}, "UpgradeSystemFirmware": &device.UpgradeSystemFirmware{}, "UpgradeSystemFirmwareResponse": &device.UpgradeSystemFirmwareResponse{}, "SystemReboot": &device.SystemReboot{}, "SystemRebootResponse": &device.SystemRebootResponse{}, "RestoreSystem": &device.RestoreSystem{}, "RestoreSystemResponse": &device.RestoreSystemResponse{}, "GetSystemBackup": &device.GetSystemBackup{}, "GetSystemBackupResponse": &device.GetSystemBackupResponse{}, "GetSystemLog": &device.GetSystemLog{}, "GetSystemLogResponse": &device.GetSystemLogResponse{}, "GetSystemSupportInformation": &device.GetSystemSupportInformation{}, "GetSystemSupportInformationResponse": &device.GetSystemSupportInformationResponse{}, "GetScopes": &device.GetScopes{}, "GetScopesResponse": &device.GetScopesResponse{}, "SetScopes": &device.SetScopes{}, "SetScopesResponse": &device.SetScopesResponse{}, "AddScopes": &device.AddScopes{}, "AddScopesResponse": &device.AddScopesResponse{}, "RemoveScopes": &device.RemoveScopes{}, "RemoveScopesResponse": &device.RemoveScopesResponse{}, "GetDiscoveryMode": &device.GetDiscoveryMode{}, "GetDiscoveryModeResponse": &device.GetDiscoveryModeResponse{}, "SetDiscoveryMode": &device.SetDiscoveryMode{}, "SetDiscoveryModeResponse": &device.SetDiscoveryModeResponse{}, "GetRemoteDiscoveryMode": &device.GetRemoteDiscoveryMode{}, "GetRemoteDiscoveryModeResponse": &device.GetRemoteDiscoveryModeResponse{}, "SetRemoteDiscoveryMode": &device.SetRemoteDiscoveryMode{}, "SetRemoteDiscoveryModeResponse": &device.SetRemoteDiscoveryModeResponse{}, "GetDPAddresses": &device.GetDPAddresses{}, "GetDPAddressesResponse": &device.GetDPAddressesResponse{}, "SetDPAddresses": &device.SetDPAddresses{}, "SetDPAddressesResponse": &device.SetDPAddressesResponse{}, "GetEndpointReference": &device.GetEndpointReference{}, "GetEndpointReferenceResponse": &device.GetEndpointReferenceResponse{}, "GetRemoteUser": &device.GetRemoteUser{}, "GetRemoteUserResponse": &device.GetRemoteUserResponse{}, "SetRemoteUser": &device.SetRemoteUser{}, "SetRemoteUserResponse": &device.SetRemoteUserResponse{}, "GetUsers": &device.GetUsers{}, "GetUsersResponse": &device.GetUsersResponse{}, "CreateUsers": &device.CreateUsers{}, "CreateUsersResponse": &device.CreateUsersResponse{}, "DeleteUsers": &device.DeleteUsers{}, "DeleteUsersResponse": &device.DeleteUsersResponse{}, "SetUser": &device.SetUser{}, "SetUserResponse": &device.SetUserResponse{}, "GetWsdlUrl": &device.GetWsdlUrl{}, "GetWsdlUrlResponse": &device.GetWsdlUrlResponse{}, "GetCapabilities": &device.GetCapabilities{}, "GetCapabilitiesResponse": &device.GetCapabilitiesResponse{}, "GetHostname": &device.GetHostname{}, "GetHostnameResponse": &device.GetHostnameResponse{}, "SetHostname": &device.SetHostname{}, "SetHostnameResponse": &device.SetHostnameResponse{}, "SetHostnameFromDHCP": &device.SetHostnameFromDHCP{}, "SetHostnameFromDHCPResponse": &device.SetHostnameFromDHCPResponse{}, "GetDNS": &device.GetDNS{}, "GetDNSResponse": &device.GetDNSResponse{}, "SetDNS": &device.SetDNS{}, "SetDNSResponse": &device.SetDNSResponse{}, "GetNTP": &device.GetNTP{}, "GetNTPResponse": &device.GetNTPResponse{}, "SetNTP": &device.SetNTP{}, "SetNTPResponse": &device.SetNTPResponse{}, "GetDynamicDNS": &device.GetDynamicDNS{}, "GetDynamicDNSResponse": &device.GetDynamicDNSResponse{}, "SetDynamicDNS": &device.SetDynamicDNS{}, "SetDynamicDNSResponse": &device.SetDynamicDNSResponse{}, "GetNetworkInterfaces": &device.GetNetworkInterfaces{}, "GetNetworkInterfacesResponse": &device.GetNetworkInterfacesResponse{}, "SetNetworkInterfaces": &device.SetNetworkInterfaces{}, "SetNetworkInterfacesResponse": &device.SetNetworkInterfacesResponse{}, "GetNetworkProtocols": &device.GetNetworkProtocols{}, "GetNetworkProtocolsResponse": &device.GetNetworkProtocolsResponse{}, "SetNetworkProtocols": &device.SetNetworkProtocols{}, "SetNetworkProtocolsResponse": &device.SetNetworkProtocolsResponse{}, "GetNetworkDefaultGateway": &device.GetNetworkDefaultGateway{}, "GetNetworkDefaultGatewayResponse": &device.GetNetworkDefaultGatewayResponse{}, "SetNetworkDefaultGateway": &device.SetNetworkDefaultGateway{}, "SetNetworkDefaultGatewayResponse": &device.SetNetworkDefaultGatewayResponse{}, "GetZeroConfiguration": &device.GetZeroConfiguration{}, "GetZeroConfigurationResponse": &device.GetZeroConfigurationResponse{}, "SetZeroConfiguration": &device.SetZeroConfiguration{}, "SetZeroConfigurationResponse": &device.SetZeroConfigurationResponse{}, "GetIPAddressFilter": &device.GetIPAddressFilter{}, "GetIPAddressFilterResponse": &device.GetIPAddressFilterResponse{}, "SetIPAddressFilter": &device.SetIPAddressFilter{}, "SetIPAddressFilterResponse": &device.SetIPAddressFilterResponse{}, "AddIPAddressFilter": &device.AddIPAddressFilter{}, "AddIPAddressFilterResponse": &device.AddIPAddressFilterResponse{}, "RemoveIPAddressFilter": &device.RemoveIPAddressFilter{}, "RemoveIPAddressFilterResponse": &device.RemoveIPAddressFilterResponse{}, "GetAccessPolicy": &device.GetAccessPolicy{}, "GetAccessPolicyResponse": &device.GetAccessPolicyResponse{}, "SetAccessPolicy": &device.SetAccessPolicy{}, "SetAccessPolicyResponse": &device.SetAccessPolicyResponse{}, "CreateCertificate": &device.CreateCertificate{}, "CreateCertificateResponse": &device.CreateCertificateResponse{}, "GetCertificates": &device.GetCertificates{}, "GetCertificatesResponse": &device.GetCertificatesResponse{}, "GetCertificatesStatus": &device.GetCertificatesStatus{}, "GetCertificatesStatusResponse": &device.GetCertificatesStatusResponse{}, "SetCertificatesStatus": &device.SetCertificatesStatus{}, "SetCertificatesStatusResponse": &device.SetCertificatesStatusResponse{}, "DeleteCertificates": &device.DeleteCertificates{}, "DeleteCertificatesResponse": &device.DeleteCertificatesResponse{}, "GetPkcs10Request": &device.GetPkcs10Request{}, "GetPkcs10RequestResponse": &device.GetPkcs10RequestResponse{}, "LoadCertificates": &device.LoadCertificates{}, "LoadCertificatesResponse": &device.LoadCertificatesResponse{}, "GetClientCertificateMode": &device.GetClientCertificateMode{}, "GetClientCertificateModeResponse": &device.GetClientCertificateModeResponse{}, "SetClientCertificateMode": &device.SetClientCertificateMode{}, "SetClientCertificateModeResponse": &device.SetClientCertificateModeResponse{}, "GetRelayOutputs": &device.GetRelayOutputs{}, "GetRelayOutputsResponse": &device.GetRelayOutputsResponse{}, "SetRelayOutputSettings": &device.SetRelayOutputSettings{}, "SetRelayOutputSettingsResponse": &device.SetRelayOutputSettingsResponse{}, "SetRelayOutputState": &device.SetRelayOutputState{}, "SetRelayOutputStateResponse": &device.SetRelayOutputStateResponse{}, "SendAuxiliaryCommand": &device.SendAuxiliaryCommand{}, "SendAuxiliaryCommandResponse": &device.SendAuxiliaryCommandResponse{}, "GetCACertificates": &device.GetCACertificates{}, "GetCACertificatesResponse": &device.GetCACertificatesResponse{}, "LoadCertificateWithPrivateKey": &device.LoadCertificateWithPrivateKey{}, "LoadCertificateWithPrivateKeyResponse": &device.LoadCertificateWithPrivateKeyResponse{}, "GetCertificateInformation": &device.GetCertificateInformation{}, "GetCertificateInformationResponse": &device.GetCertificateInformationResponse{}, "LoadCACertificates": &device.LoadCACertificates{}, "LoadCACertificatesResponse": &device.LoadCACertificatesResponse{}, "CreateDot1XConfiguration": &device.CreateDot1XConfiguration{}, "CreateDot1XConfigurationResponse": &device.CreateDot1XConfigurationResponse{}, "SetDot1XConfiguration": &device.SetDot1XConfiguration{}, "SetDot1XConfigurationResponse": &device.SetDot1XConfigurationResponse{}, "GetDot1XConfiguration": &device.GetDot1XConfiguration{}, "GetDot1XConfigurationResponse": &device.GetDot1XConfigurationResponse{}, "GetDot1XConfigurations": &device.GetDot1XConfigurations{}, "GetDot1XConfigurationsResponse": &device.GetDot1XConfigurationsResponse{}, "DeleteDot1XConfiguration": &device.DeleteDot1XConfiguration{}, "DeleteDot1XConfigurationResponse": &device.DeleteDot1XConfigurationResponse{}, "GetDot11Capabilities": &device.GetDot11Capabilities{}, "GetDot11CapabilitiesResponse": &device.GetDot11CapabilitiesResponse{}, "GetDot11Status": &device.GetDot11Status{}, "GetDot11StatusResponse": &device.GetDot11StatusResponse{}, "ScanAvailableDot11Networks": &device.ScanAvailableDot11Networks{}, "ScanAvailableDot11NetworksResponse": &device.ScanAvailableDot11NetworksResponse{}, "GetSystemUris": &device.GetSystemUris{}, "GetSystemUrisResponse": &device.GetSystemUrisResponse{}, "StartFirmwareUpgrade": &device.StartFirmwareUpgrade{}, "StartFirmwareUpgradeResponse": &device.StartFirmwareUpgradeResponse{}, "StartSystemRestore": &device.StartSystemRestore{}, "StartSystemRestoreResponse": &device.StartSystemRestoreResponse{}, "GetStorageConfigurations": &device.GetStorageConfigurations{}, "GetStorageConfigurationsResponse": &device.GetStorageConfigurationsResponse{}, "CreateStorageConfiguration": &device.CreateStorageConfiguration{}, "CreateStorageConfigurationResponse": &device.CreateStorageConfigurationResponse{}, "GetStorageConfiguration": &device.GetStorageConfiguration{}, "GetStorageConfigurationResponse": &device.GetStorageConfigurationResponse{}, "SetStorageConfiguration": &device.SetStorageConfiguration{}, "SetStorageConfigurationResponse": &device.SetStorageConfigurationResponse{}, "DeleteStorageConfiguration": &device.DeleteStorageConfiguration{}, "DeleteStorageConfigurationResponse": &device.DeleteStorageConfigurationResponse{}, "GetGeoLocation": &device.GetGeoLocation{}, "GetGeoLocationResponse": &device.GetGeoLocationResponse{}, "SetGeoLocation": &device.SetGeoLocation{}, "SetGeoLocationResponse": &device.SetGeoLocationResponse{}, "DeleteGeoLocation": &device.DeleteGeoLocation{}, "DeleteGeoLocationResponse": &device.DeleteGeoLocationResponse{}},
 	"event":     {"AbsoluteOrRelativeTimeType": &event.AbsoluteOrRelativeTimeType{}, "EndpointReferenceType": &event.EndpointReferenceType{}, "FilterType": &event.FilterType{}, "ReferenceParametersType": &event.ReferenceParametersType{}, "MetadataType": &event.MetadataType{}, "TopicSetType": &event.TopicSetType{}, "ExtensibleDocumented": &event.ExtensibleDocumented{}, "NotificationMessageHolderType": &event.NotificationMessageHolderType{}, "QueryExpressionType": &event.QueryExpressionType{}, "TopicExpressionType": &event.TopicExpressionType{}, "Capabilities": &event.Capabilities{}, "ResourceUnknownFault": &event.ResourceUnknownFault{}, "InvalidFilterFault": &event.InvalidFilterFault{}, "TopicExpressionDialectUnknownFault": &event.TopicExpressionDialectUnknownFault{}, "InvalidTopicExpressionFault": &event.InvalidTopicExpressionFault{}, "TopicNotSupportedFault": &event.TopicNotSupportedFault{}, "InvalidProducerPropertiesExpressionFault": &event.InvalidProducerPropertiesExpressionFault{}, "InvalidMessageContentExpressionFault": &event.InvalidMessageContentExpressionFault{}, "UnacceptableInitialTerminationTimeFault": &event.UnacceptableInitialTerminationTimeFault{}, "UnrecognizedPolicyRequestFault": &event.UnrecognizedPolicyRequestFault{}, "UnsupportedPolicyRequestFault": &event.UnsupportedPolicyRequestFault{}, "NotifyMessageNotSupportedFault": &event.NotifyMessageNotSupportedFault{}, "SubscribeCreationFailedFault": &event.SubscribeCreationFailedFault{}},
@@ -226,40 +223,12 @@ func GetSnapshortUrls(xaddr, username, password string) ([]string, error) {
 	return retstr, nil
 }
 
+// IsStreamOnline reports whether the RTSP stream at link delivers RTP
+// packets, see networking.ProbeRTSP. Credentials are taken from the link
 func IsStreamOnline(link string) (ok bool) {
 	slogrus.Debug(link)
-	if !func() (ok bool) {
-		ok = false
-		c := gortsplib.Client{}
-
-		u, err := url.Parse(link)
-		if err != nil {
-			return
-		}
-		c.ReadTimeout = time.Second
-		err = c.Start(u.Scheme, u.Host)
-		if err != nil {
-			return
-		}
-		defer c.Close()
-		// c.Options(u)
-		_, _, _, err = c.Describe(u)
-		if err != nil {
-			return
-		}
-		return true
-	}() {
-		return false
-		// log.Printf("available tracks: %v\n", tracks)
-		// snetutils.DialExpec(link, "rtsp", time.Microsecond*500)
-		if _, _, err := sexec.ExecCommandShellTimeout(fmt.Sprintf("ffprobe -v quiet -print_format json -show_format '%s'", link), time.Second*10); err == nil {
-			return true
-		} else {
-			return false
-		}
-	} else {
-		return true
-	}
+	_, err := networking.ProbeRTSP(context.Background(), link, networking.RTSPProbeOptions{Timeout: 5 * time.Second})
+	return err == nil
 }
 
 //	func GetDeviceInformation(xaddrOrCamip, username, password string) (device.GetDeviceInformationResponse, error) {
@@ -268,12 +237,14 @@ func IsStreamOnline(link string) (ok bool) {
 type CamStream struct {
 	Model        string   `json:"model,omitempty"`
 	MacVendor    string   `json:"macvendor,omitempty"`
+	OUI          []string `json:"oui,omitempty"`
 	Paths        []string `json:"paths"`
 	Manufacturer string   `json:"manufacturer,omitempty"`
 }
 
+// Deprecated: CamStreamsCache is no longer downloaded, its entries are matched
+// after those of StreamPathProviders
 var CamStreamsCache = []CamStream{}
-var camStreamLastCommit string
 
 func GetStreamUrls(xaddrOrCamip, username, password string) (stream_urls []string, err error) {
 	stream_urls = make([]string, 0)
@@ -300,51 +271,18 @@ func GetStreamUrls(xaddrOrCamip, username, password string) (stream_urls []strin
 
 	}
 	if len(stream_urls) == 0 {
-		var resp *gcurl.Response
 		var dev *gonvif.Device
 		dev, err = gonvif.NewDevice(gonvif.DeviceParams{Xaddr: xaddrOrCamip, Username: username, Password: password})
 		if err != nil {
 			return
 		}
-		// curl -H "Accept: application/vnd.github.VERSION.sha"
-		if resp, err = gcurl.GetDefaultRequest().WithHeader("Accept", "application/vnd.github.VERSION.sha").Get("https://api.github.com/repos/sonnt85/camstreamlist/commits/main"); err == nil {
-			if currentCommit, err := resp.Text(); err == nil && camStreamLastCommit != currentCommit {
-				if resp, err = gcurl.Get("https://raw.githubusercontent.com/sonnt85/camstreamlist/main/camstreamlist.json"); err == nil {
-					streams := make([]CamStream, 0)
-					if err = resp.JSONUnmarshal(&streams); err == nil && len(streams) != 0 {
-						camStreamLastCommit = currentCommit
-						CamStreamsCache = streams
-					}
-				}
-			}
-		}
-
-		mac := ""
-		for i := 0; i < len(CamStreamsCache); i++ {
-			if len(CamStreamsCache[i].Model) != 0 {
-				if sregexp.New(CamStreamsCache[i].Model).MatchString(dev.Model) {
-					stream_urls = CamStreamsCache[i].Paths
-					break
-				}
-			}
 
-			if len(CamStreamsCache[i].MacVendor) != 0 {
-				if len(mac) == 0 {
-					mac, _ = snetutils.MacFromIP(ipcam)
-					mac = strings.ReplaceAll(mac, ":", "")
-				}
-				if len(mac) != 0 {
-					if sregexp.New(CamStreamsCache[i].MacVendor).MatchString(mac) {
-						stream_urls = CamStreamsCache[i].Paths
-						break
-					}
-				}
-			}
-			if len(CamStreamsCache[i].Manufacturer) != 0 {
-				if sregexp.New(CamStreamsCache[i].Manufacturer).MatchString(dev.Manufacturer) {
-					stream_urls = CamStreamsCache[i].Paths
-					break
-				}
+		query := StreamPathQuery{Model: dev.Model, Manufacturer: dev.Manufacturer}
+		stream_urls, err = lookupStreamPaths(query)
+		if len(stream_urls) == 0 {
+			// the MAC address is only resolved when the device info did not match
+			if query.MAC, _ = snetutils.MacFromIP(ipcam); len(query.MAC) != 0 {
+				stream_urls, err = lookupStreamPaths(query)
 			}
 		}
 	}
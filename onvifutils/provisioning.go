@@ -0,0 +1,26 @@
+// Code generated by gonvif-gen from provisioning.wsdl. DO NOT EDIT.
+
+package onvifutils
+
+import "github.com/sonnt85/gonvif/provisioning"
+
+func init() {
+	GetOnvifStruct["provisioning"] = map[string]interface{}{
+		"GetServiceCapabilities":         &provisioning.GetServiceCapabilities{},
+		"GetServiceCapabilitiesResponse": &provisioning.GetServiceCapabilitiesResponse{},
+		"PanMove":                        &provisioning.PanMove{},
+		"PanMoveResponse":                &provisioning.PanMoveResponse{},
+		"TiltMove":                       &provisioning.TiltMove{},
+		"TiltMoveResponse":               &provisioning.TiltMoveResponse{},
+		"ZoomMove":                       &provisioning.ZoomMove{},
+		"ZoomMoveResponse":               &provisioning.ZoomMoveResponse{},
+		"RollMove":                       &provisioning.RollMove{},
+		"RollMoveResponse":               &provisioning.RollMoveResponse{},
+		"FocusMove":                      &provisioning.FocusMove{},
+		"FocusMoveResponse":              &provisioning.FocusMoveResponse{},
+		"Stop":                           &provisioning.Stop{},
+		"StopResponse":                   &provisioning.StopResponse{},
+		"GetUsage":                       &provisioning.GetUsage{},
+		"GetUsageResponse":               &provisioning.GetUsageResponse{},
+	}
+}
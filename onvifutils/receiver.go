@@ -0,0 +1,26 @@
+// Code generated by gonvif-gen from receiver.wsdl. DO NOT EDIT.
+
+package onvifutils
+
+import "github.com/sonnt85/gonvif/receiver"
+
+func init() {
+	GetOnvifStruct["receiver"] = map[string]interface{}{
+		"GetServiceCapabilities":         &receiver.GetServiceCapabilities{},
+		"GetServiceCapabilitiesResponse": &receiver.GetServiceCapabilitiesResponse{},
+		"GetReceivers":                   &receiver.GetReceivers{},
+		"GetReceiversResponse":           &receiver.GetReceiversResponse{},
+		"GetReceiver":                    &receiver.GetReceiver{},
+		"GetReceiverResponse":            &receiver.GetReceiverResponse{},
+		"CreateReceiver":                 &receiver.CreateReceiver{},
+		"CreateReceiverResponse":         &receiver.CreateReceiverResponse{},
+		"DeleteReceiver":                 &receiver.DeleteReceiver{},
+		"DeleteReceiverResponse":         &receiver.DeleteReceiverResponse{},
+		"ConfigureReceiver":              &receiver.ConfigureReceiver{},
+		"ConfigureReceiverResponse":      &receiver.ConfigureReceiverResponse{},
+		"SetReceiverMode":                &receiver.SetReceiverMode{},
+		"SetReceiverModeResponse":        &receiver.SetReceiverModeResponse{},
+		"GetReceiverState":               &receiver.GetReceiverState{},
+		"GetReceiverStateResponse":       &receiver.GetReceiverStateResponse{},
+	}
+}
@@ -0,0 +1,52 @@
+// Code generated by gonvif-gen from recording.wsdl. DO NOT EDIT.
+
+package onvifutils
+
+import "github.com/sonnt85/gonvif/recording"
+
+func init() {
+	GetOnvifStruct["recording"] = map[string]interface{}{
+		"GetServiceCapabilities":               &recording.GetServiceCapabilities{},
+		"GetServiceCapabilitiesResponse":       &recording.GetServiceCapabilitiesResponse{},
+		"CreateRecording":                      &recording.CreateRecording{},
+		"CreateRecordingResponse":              &recording.CreateRecordingResponse{},
+		"DeleteRecording":                      &recording.DeleteRecording{},
+		"DeleteRecordingResponse":              &recording.DeleteRecordingResponse{},
+		"GetRecordings":                        &recording.GetRecordings{},
+		"GetRecordingsResponse":                &recording.GetRecordingsResponse{},
+		"SetRecordingConfiguration":            &recording.SetRecordingConfiguration{},
+		"SetRecordingConfigurationResponse":    &recording.SetRecordingConfigurationResponse{},
+		"GetRecordingConfiguration":            &recording.GetRecordingConfiguration{},
+		"GetRecordingConfigurationResponse":    &recording.GetRecordingConfigurationResponse{},
+		"GetRecordingOptions":                  &recording.GetRecordingOptions{},
+		"GetRecordingOptionsResponse":          &recording.GetRecordingOptionsResponse{},
+		"CreateTrack":                          &recording.CreateTrack{},
+		"CreateTrackResponse":                  &recording.CreateTrackResponse{},
+		"DeleteTrack":                          &recording.DeleteTrack{},
+		"DeleteTrackResponse":                  &recording.DeleteTrackResponse{},
+		"GetTrackConfiguration":                &recording.GetTrackConfiguration{},
+		"GetTrackConfigurationResponse":        &recording.GetTrackConfigurationResponse{},
+		"SetTrackConfiguration":                &recording.SetTrackConfiguration{},
+		"SetTrackConfigurationResponse":        &recording.SetTrackConfigurationResponse{},
+		"CreateRecordingJob":                   &recording.CreateRecordingJob{},
+		"CreateRecordingJobResponse":           &recording.CreateRecordingJobResponse{},
+		"DeleteRecordingJob":                   &recording.DeleteRecordingJob{},
+		"DeleteRecordingJobResponse":           &recording.DeleteRecordingJobResponse{},
+		"GetRecordingJobs":                     &recording.GetRecordingJobs{},
+		"GetRecordingJobsResponse":             &recording.GetRecordingJobsResponse{},
+		"SetRecordingJobConfiguration":         &recording.SetRecordingJobConfiguration{},
+		"SetRecordingJobConfigurationResponse": &recording.SetRecordingJobConfigurationResponse{},
+		"GetRecordingJobConfiguration":         &recording.GetRecordingJobConfiguration{},
+		"GetRecordingJobConfigurationResponse": &recording.GetRecordingJobConfigurationResponse{},
+		"SetRecordingJobMode":                  &recording.SetRecordingJobMode{},
+		"SetRecordingJobModeResponse":          &recording.SetRecordingJobModeResponse{},
+		"GetRecordingJobState":                 &recording.GetRecordingJobState{},
+		"GetRecordingJobStateResponse":         &recording.GetRecordingJobStateResponse{},
+		"ExportRecordedData":                   &recording.ExportRecordedData{},
+		"ExportRecordedDataResponse":           &recording.ExportRecordedDataResponse{},
+		"StopExportRecordedData":               &recording.StopExportRecordedData{},
+		"StopExportRecordedDataResponse":       &recording.StopExportRecordedDataResponse{},
+		"GetExportRecordedDataState":           &recording.GetExportRecordedDataState{},
+		"GetExportRecordedDataStateResponse":   &recording.GetExportRecordedDataStateResponse{},
+	}
+}
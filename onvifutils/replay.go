@@ -0,0 +1,18 @@
+// Code generated by gonvif-gen from replay.wsdl. DO NOT EDIT.
+
+package onvifutils
+
+import "github.com/sonnt85/gonvif/replay"
+
+func init() {
+	GetOnvifStruct["replay"] = map[string]interface{}{
+		"GetServiceCapabilities":         &replay.GetServiceCapabilities{},
+		"GetServiceCapabilitiesResponse": &replay.GetServiceCapabilitiesResponse{},
+		"GetReplayUri":                   &replay.GetReplayUri{},
+		"GetReplayUriResponse":           &replay.GetReplayUriResponse{},
+		"GetReplayConfiguration":         &replay.GetReplayConfiguration{},
+		"GetReplayConfigurationResponse": &replay.GetReplayConfigurationResponse{},
+		"SetReplayConfiguration":         &replay.SetReplayConfiguration{},
+		"SetReplayConfigurationResponse": &replay.SetReplayConfigurationResponse{},
+	}
+}
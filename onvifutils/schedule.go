@@ -0,0 +1,42 @@
+// Code generated by gonvif-gen from schedule.wsdl. DO NOT EDIT.
+
+package onvifutils
+
+import "github.com/sonnt85/gonvif/schedule"
+
+func init() {
+	GetOnvifStruct["schedule"] = map[string]interface{}{
+		"GetServiceCapabilities":             &schedule.GetServiceCapabilities{},
+		"GetServiceCapabilitiesResponse":     &schedule.GetServiceCapabilitiesResponse{},
+		"GetScheduleState":                   &schedule.GetScheduleState{},
+		"GetScheduleStateResponse":           &schedule.GetScheduleStateResponse{},
+		"GetScheduleInfo":                    &schedule.GetScheduleInfo{},
+		"GetScheduleInfoResponse":            &schedule.GetScheduleInfoResponse{},
+		"GetScheduleInfoList":                &schedule.GetScheduleInfoList{},
+		"GetScheduleInfoListResponse":        &schedule.GetScheduleInfoListResponse{},
+		"GetSchedules":                       &schedule.GetSchedules{},
+		"GetSchedulesResponse":               &schedule.GetSchedulesResponse{},
+		"GetScheduleList":                    &schedule.GetScheduleList{},
+		"GetScheduleListResponse":            &schedule.GetScheduleListResponse{},
+		"CreateSchedule":                     &schedule.CreateSchedule{},
+		"CreateScheduleResponse":             &schedule.CreateScheduleResponse{},
+		"ModifySchedule":                     &schedule.ModifySchedule{},
+		"ModifyScheduleResponse":             &schedule.ModifyScheduleResponse{},
+		"DeleteSchedule":                     &schedule.DeleteSchedule{},
+		"DeleteScheduleResponse":             &schedule.DeleteScheduleResponse{},
+		"GetSpecialDayGroupInfo":             &schedule.GetSpecialDayGroupInfo{},
+		"GetSpecialDayGroupInfoResponse":     &schedule.GetSpecialDayGroupInfoResponse{},
+		"GetSpecialDayGroupInfoList":         &schedule.GetSpecialDayGroupInfoList{},
+		"GetSpecialDayGroupInfoListResponse": &schedule.GetSpecialDayGroupInfoListResponse{},
+		"GetSpecialDayGroups":                &schedule.GetSpecialDayGroups{},
+		"GetSpecialDayGroupsResponse":        &schedule.GetSpecialDayGroupsResponse{},
+		"GetSpecialDayGroupList":             &schedule.GetSpecialDayGroupList{},
+		"GetSpecialDayGroupListResponse":     &schedule.GetSpecialDayGroupListResponse{},
+		"CreateSpecialDayGroup":              &schedule.CreateSpecialDayGroup{},
+		"CreateSpecialDayGroupResponse":      &schedule.CreateSpecialDayGroupResponse{},
+		"ModifySpecialDayGroup":              &schedule.ModifySpecialDayGroup{},
+		"ModifySpecialDayGroupResponse":      &schedule.ModifySpecialDayGroupResponse{},
+		"DeleteSpecialDayGroup":              &schedule.DeleteSpecialDayGroup{},
+		"DeleteSpecialDayGroupResponse":      &schedule.DeleteSpecialDayGroupResponse{},
+	}
+}
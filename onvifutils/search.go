@@ -0,0 +1,38 @@
+// Code generated by gonvif-gen from search.wsdl. DO NOT EDIT.
+
+package onvifutils
+
+import "github.com/sonnt85/gonvif/search"
+
+func init() {
+	GetOnvifStruct["search"] = map[string]interface{}{
+		"GetServiceCapabilities":              &search.GetServiceCapabilities{},
+		"GetServiceCapabilitiesResponse":      &search.GetServiceCapabilitiesResponse{},
+		"GetRecordingSummary":                 &search.GetRecordingSummary{},
+		"GetRecordingSummaryResponse":         &search.GetRecordingSummaryResponse{},
+		"GetRecordingInformation":             &search.GetRecordingInformation{},
+		"GetRecordingInformationResponse":     &search.GetRecordingInformationResponse{},
+		"GetMediaAttributes":                  &search.GetMediaAttributes{},
+		"GetMediaAttributesResponse":          &search.GetMediaAttributesResponse{},
+		"FindRecordings":                      &search.FindRecordings{},
+		"FindRecordingsResponse":              &search.FindRecordingsResponse{},
+		"GetRecordingSearchResults":           &search.GetRecordingSearchResults{},
+		"GetRecordingSearchResultsResponse":   &search.GetRecordingSearchResultsResponse{},
+		"FindEvents":                          &search.FindEvents{},
+		"FindEventsResponse":                  &search.FindEventsResponse{},
+		"GetEventSearchResults":               &search.GetEventSearchResults{},
+		"GetEventSearchResultsResponse":       &search.GetEventSearchResultsResponse{},
+		"FindPTZPosition":                     &search.FindPTZPosition{},
+		"FindPTZPositionResponse":             &search.FindPTZPositionResponse{},
+		"GetPTZPositionSearchResults":         &search.GetPTZPositionSearchResults{},
+		"GetPTZPositionSearchResultsResponse": &search.GetPTZPositionSearchResultsResponse{},
+		"GetSearchState":                      &search.GetSearchState{},
+		"GetSearchStateResponse":              &search.GetSearchStateResponse{},
+		"EndSearch":                           &search.EndSearch{},
+		"EndSearchResponse":                   &search.EndSearchResponse{},
+		"FindMetadata":                        &search.FindMetadata{},
+		"FindMetadataResponse":                &search.FindMetadataResponse{},
+		"GetMetadataSearchResults":            &search.GetMetadataSearchResults{},
+		"GetMetadataSearchResultsResponse":    &search.GetMetadataSearchResultsResponse{},
+	}
+}
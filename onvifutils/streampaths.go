@@ -0,0 +1,208 @@
+package onvifutils
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sonnt85/gosutils/gcurl"
+)
+
+// StreamPathProvider supplies the stream URL templates that GetStreamUrls
+// tries when a device does not answer GetStreamUri
+type StreamPathProvider interface {
+	CamStreams() ([]CamStream, error)
+}
+
+// StreamPathQuery describes the camera whose stream paths are looked up
+type StreamPathQuery struct {
+	Model        string
+	Manufacturer string
+	// MAC address of the camera, any separator
+	MAC string
+}
+
+// StreamPathProviders are the sources of LookupStreamPaths. Entries of
+// earlier providers win over later ones at the same match level. The remote
+// camstreamlist is only used when added, e.g. with NewRemoteStreamPaths
+var StreamPathProviders = []StreamPathProvider{DefaultStreamPaths()}
+
+//go:embed camstreamlist.json
+var defaultCamStreams []byte
+
+// StreamPathsFunc adapts a function to a StreamPathProvider
+type StreamPathsFunc func() ([]CamStream, error)
+
+// CamStreams calls f
+func (f StreamPathsFunc) CamStreams() ([]CamStream, error) {
+	return f()
+}
+
+// DefaultStreamPaths returns the dataset embedded in gonvif
+func DefaultStreamPaths() StreamPathProvider {
+	return StreamPathsFunc(func() ([]CamStream, error) {
+		streams := make([]CamStream, 0)
+		err := json.Unmarshal(defaultCamStreams, &streams)
+		return streams, err
+	})
+}
+
+// FileStreamPaths returns the entries of a camstreamlist JSON file, or of all
+// the *.json files of a directory. The files are read on every lookup so
+// they can be edited while running
+func FileStreamPaths(path string) StreamPathProvider {
+	return StreamPathsFunc(func() ([]CamStream, error) {
+		files := []string{path}
+		if info, err := os.Stat(path); err != nil {
+			return nil, err
+		} else if info.IsDir() {
+			if files, err = filepath.Glob(filepath.Join(path, "*.json")); err != nil {
+				return nil, err
+			}
+			sort.Strings(files)
+		}
+
+		streams := make([]CamStream, 0)
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+			var entries []CamStream
+			if err := json.Unmarshal(data, &entries); err != nil {
+				return nil, fmt.Errorf("%s: %v", file, err)
+			}
+			streams = append(streams, entries...)
+		}
+		return streams, nil
+	})
+}
+
+// remoteStreamPaths downloads the camstreamlist of github.com/sonnt85/camstreamlist,
+// again only when its last commit changed
+type remoteStreamPaths struct {
+	mu         sync.Mutex
+	lastCommit string
+	streams    []CamStream
+}
+
+// NewRemoteStreamPaths returns the camstreamlist published on GitHub. It
+// needs network access and has to be added to StreamPathProviders explicitly
+func NewRemoteStreamPaths() StreamPathProvider {
+	return &remoteStreamPaths{}
+}
+
+// CamStreams returns the last downloaded list, refreshed when the repository changed
+func (remote *remoteStreamPaths) CamStreams() ([]CamStream, error) {
+	remote.mu.Lock()
+	defer remote.mu.Unlock()
+
+	// curl -H "Accept: application/vnd.github.VERSION.sha"
+	resp, err := gcurl.GetDefaultRequest().WithHeader("Accept", "application/vnd.github.VERSION.sha").Get("https://api.github.com/repos/sonnt85/camstreamlist/commits/main")
+	if err != nil {
+		return remote.streams, err
+	}
+	currentCommit, err := resp.Text()
+	if err != nil || currentCommit == remote.lastCommit {
+		return remote.streams, err
+	}
+	if resp, err = gcurl.Get("https://raw.githubusercontent.com/sonnt85/camstreamlist/main/camstreamlist.json"); err != nil {
+		return remote.streams, err
+	}
+	streams := make([]CamStream, 0)
+	if err = resp.JSONUnmarshal(&streams); err == nil && len(streams) != 0 {
+		remote.lastCommit = currentCommit
+		remote.streams = streams
+	}
+	return remote.streams, err
+}
+
+// Match levels of a CamStream entry, the lower the better
+const (
+	matchModel = iota
+	matchManufacturer
+	matchMAC
+	matchNone
+)
+
+// match returns the best match level of stream for query
+func (stream CamStream) match(query StreamPathQuery, mac string) int {
+	if stream.Model != "" && query.Model != "" && matchPattern(stream.Model, query.Model) {
+		return matchModel
+	}
+	if stream.Manufacturer != "" && query.Manufacturer != "" && matchPattern(stream.Manufacturer, query.Manufacturer) {
+		return matchManufacturer
+	}
+	if mac != "" {
+		for _, oui := range stream.OUI {
+			if strings.HasPrefix(mac, normalizeMAC(oui)) {
+				return matchMAC
+			}
+		}
+		if stream.MacVendor != "" && matchPattern(stream.MacVendor, mac) {
+			return matchMAC
+		}
+	}
+	return matchNone
+}
+
+// matchPattern matches value against a case insensitive regular expression,
+// invalid expressions never match
+func matchPattern(pattern, value string) bool {
+	re, err := regexp.Compile("(?i)" + pattern)
+	return err == nil && re.MatchString(value)
+}
+
+// normalizeMAC returns the upper case hex digits of a MAC address or prefix
+func normalizeMAC(mac string) string {
+	return strings.ToUpper(strings.NewReplacer(":", "", "-", "", ".", "").Replace(mac))
+}
+
+// MatchStreamPaths returns the paths of the best entry of streams for query:
+// a model match first, then a manufacturer match, then a MAC OUI match. The
+// first entry wins at the same level
+func MatchStreamPaths(streams []CamStream, query StreamPathQuery) []string {
+	mac := normalizeMAC(query.MAC)
+	best, paths := matchNone, []string(nil)
+	for _, stream := range streams {
+		if level := stream.match(query, mac); level < best && len(stream.Paths) != 0 {
+			best, paths = level, stream.Paths
+		}
+	}
+	return append([]string(nil), paths...)
+}
+
+// LookupStreamPaths returns the best stream paths of StreamPathProviders for
+// query. Failing providers are skipped, their error is returned when nothing matched
+func LookupStreamPaths(query StreamPathQuery) ([]string, error) {
+	var streams []CamStream
+	var firstErr error
+	for _, provider := range StreamPathProviders {
+		entries, err := provider.CamStreams()
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		streams = append(streams, entries...)
+	}
+	if paths := MatchStreamPaths(streams, query); len(paths) != 0 {
+		return paths, nil
+	}
+	return nil, firstErr
+}
+
+// lookupStreamPaths is LookupStreamPaths falling back to the deprecated CamStreamsCache
+func lookupStreamPaths(query StreamPathQuery) ([]string, error) {
+	paths, err := LookupStreamPaths(query)
+	if len(paths) == 0 {
+		if paths = MatchStreamPaths(CamStreamsCache, query); len(paths) != 0 {
+			err = nil
+		}
+	}
+	return paths, err
+}
@@ -0,0 +1,55 @@
+package onvifutils
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestMatchStreamPaths(t *testing.T) {
+	streams := []CamStream{
+		{OUI: []string{"AA:BB:CC"}, Paths: []string{"rtsp://1.1.1.1/oui"}},
+		{Manufacturer: "^acme", Paths: []string{"rtsp://1.1.1.1/acme"}},
+		{Model: "^DS-2CD", Paths: []string{"rtsp://1.1.1.1/model"}},
+		{Manufacturer: "acme corp", Paths: []string{"rtsp://1.1.1.1/second"}},
+	}
+	for _, test := range []struct {
+		query StreamPathQuery
+		want  string
+	}{
+		{StreamPathQuery{Model: "ds-2cd2043", Manufacturer: "ACME", MAC: "aa-bb-cc-00-11-22"}, "rtsp://1.1.1.1/model"},
+		{StreamPathQuery{Model: "X1", Manufacturer: "Acme Corp", MAC: "aa:bb:cc:00:11:22"}, "rtsp://1.1.1.1/acme"},
+		{StreamPathQuery{Model: "X1", MAC: "aabbcc001122"}, "rtsp://1.1.1.1/oui"},
+	} {
+		got := MatchStreamPaths(streams, test.query)
+		if len(got) != 1 || got[0] != test.want {
+			t.Errorf("MatchStreamPaths(%+v) = %v, want %s", test.query, got, test.want)
+		}
+	}
+	if got := MatchStreamPaths(streams, StreamPathQuery{Model: "X1"}); len(got) != 0 {
+		t.Errorf("unexpected match %v", got)
+	}
+}
+
+func TestLookupStreamPaths(t *testing.T) {
+	dir := t.TempDir()
+	site := `[{"manufacturer": "^hikvision", "paths": ["rtsp://10.0.0.1/site"]}]`
+	if err := os.WriteFile(filepath.Join(dir, "site.json"), []byte(site), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func(providers []StreamPathProvider) { StreamPathProviders = providers }(StreamPathProviders)
+	query := StreamPathQuery{Manufacturer: "HIKVISION"}
+
+	paths, err := LookupStreamPaths(query)
+	if err != nil || len(paths) == 0 || paths[0] != "rtsp://192.168.1.64:554/Streaming/Channels/101" {
+		t.Errorf("default dataset: %v, %v", paths, err)
+	}
+
+	StreamPathProviders = append([]StreamPathProvider{FileStreamPaths(dir)}, StreamPathProviders...)
+	paths, err = LookupStreamPaths(query)
+	if err != nil || !reflect.DeepEqual(paths, []string{"rtsp://10.0.0.1/site"}) {
+		t.Errorf("directory source: %v, %v", paths, err)
+	}
+}
@@ -0,0 +1,26 @@
+// Code generated by gonvif-gen from thermal.wsdl. DO NOT EDIT.
+
+package onvifutils
+
+import "github.com/sonnt85/gonvif/thermal"
+
+func init() {
+	GetOnvifStruct["thermal"] = map[string]interface{}{
+		"GetServiceCapabilities":                    &thermal.GetServiceCapabilities{},
+		"GetServiceCapabilitiesResponse":            &thermal.GetServiceCapabilitiesResponse{},
+		"GetConfigurationOptions":                   &thermal.GetConfigurationOptions{},
+		"GetConfigurationOptionsResponse":           &thermal.GetConfigurationOptionsResponse{},
+		"GetConfiguration":                          &thermal.GetConfiguration{},
+		"GetConfigurationResponse":                  &thermal.GetConfigurationResponse{},
+		"GetConfigurations":                         &thermal.GetConfigurations{},
+		"GetConfigurationsResponse":                 &thermal.GetConfigurationsResponse{},
+		"SetConfiguration":                          &thermal.SetConfiguration{},
+		"SetConfigurationResponse":                  &thermal.SetConfigurationResponse{},
+		"GetRadiometryConfigurationOptions":         &thermal.GetRadiometryConfigurationOptions{},
+		"GetRadiometryConfigurationOptionsResponse": &thermal.GetRadiometryConfigurationOptionsResponse{},
+		"GetRadiometryConfiguration":                &thermal.GetRadiometryConfiguration{},
+		"GetRadiometryConfigurationResponse":        &thermal.GetRadiometryConfigurationResponse{},
+		"SetRadiometryConfiguration":                &thermal.SetRadiometryConfiguration{},
+		"SetRadiometryConfigurationResponse":        &thermal.SetRadiometryConfigurationResponse{},
+	}
+}
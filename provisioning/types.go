@@ -0,0 +1,177 @@
+// Code generated by gonvif-gen from provisioning.wsdl. DO NOT EDIT.
+
+package provisioning
+
+import (
+	"github.com/sonnt85/gonvif/gosoap"
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Namespace of the provisioning service
+const Namespace = "http://www.onvif.org/ver10/provisioning/wsdl"
+
+// Prefix of the provisioning service elements
+const Prefix = "tpv"
+
+// PanDirection the direction for PanMove to move the device
+type PanDirection xsd.String
+
+const (
+	PanDirectionLeft  PanDirection = "Left"
+	PanDirectionRight PanDirection = "Right"
+)
+
+// TiltDirection the direction for TiltMove to move the device
+type TiltDirection xsd.String
+
+const (
+	TiltDirectionUp   TiltDirection = "Up"
+	TiltDirectionDown TiltDirection = "Down"
+)
+
+// ZoomDirection the direction for ZoomMove to change the focal length in relation to the video source
+type ZoomDirection xsd.String
+
+const (
+	ZoomDirectionWide      ZoomDirection = "Wide"
+	ZoomDirectionTelephoto ZoomDirection = "Telephoto"
+)
+
+// RollDirection the direction for RollMove to move the device
+type RollDirection xsd.String
+
+const (
+	RollDirectionClockwise        RollDirection = "Clockwise"
+	RollDirectionCounterclockwise RollDirection = "Counterclockwise"
+	RollDirectionAuto             RollDirection = "Auto"
+)
+
+// FocusDirection the direction for FocusMove to move the focal plane in relation to the video source
+type FocusDirection xsd.String
+
+const (
+	FocusDirectionNear FocusDirection = "Near"
+	FocusDirectionFar  FocusDirection = "Far"
+	FocusDirectionAuto FocusDirection = "Auto"
+)
+
+// Usage the quantity of movement events that have occured over the lifetime of the device
+type Usage struct {
+	Pan   xsd.PositiveInteger `xml:"tpv:Pan,omitempty"`
+	Tilt  xsd.PositiveInteger `xml:"tpv:Tilt,omitempty"`
+	Zoom  xsd.PositiveInteger `xml:"tpv:Zoom,omitempty"`
+	Roll  xsd.PositiveInteger `xml:"tpv:Roll,omitempty"`
+	Focus xsd.PositiveInteger `xml:"tpv:Focus,omitempty"`
+}
+
+// SourceCapabilities the provisioning capabilities of a video source on the device
+type SourceCapabilities struct {
+	VideoSourceToken  onvif.ReferenceToken `xml:"VideoSourceToken,attr"`
+	MaximumPanMoves   xsd.PositiveInteger  `xml:"MaximumPanMoves,attr,omitempty"`
+	MaximumTiltMoves  xsd.PositiveInteger  `xml:"MaximumTiltMoves,attr,omitempty"`
+	MaximumZoomMoves  xsd.PositiveInteger  `xml:"MaximumZoomMoves,attr,omitempty"`
+	MaximumRollMoves  xsd.PositiveInteger  `xml:"MaximumRollMoves,attr,omitempty"`
+	AutoLevel         xsd.Boolean          `xml:"AutoLevel,attr,omitempty"`
+	MaximumFocusMoves xsd.PositiveInteger  `xml:"MaximumFocusMoves,attr,omitempty"`
+	AutoFocus         xsd.Boolean          `xml:"AutoFocus,attr,omitempty"`
+}
+
+// Capabilities the capabilities of Provisioning Service on the device
+type Capabilities struct {
+	DefaultTimeout xsd.Duration         `xml:"tpv:DefaultTimeout"`
+	Source         []SourceCapabilities `xml:"tpv:Source"`
+}
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tpv:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type PanMove struct {
+	XMLName     string               `xml:"tpv:PanMove"`
+	VideoSource onvif.ReferenceToken `xml:"tpv:VideoSource"`
+	Direction   PanDirection         `xml:"tpv:Direction"`
+	Timeout     xsd.Duration         `xml:"tpv:Timeout,omitempty"`
+}
+
+type PanMoveResponse struct {
+}
+
+type TiltMove struct {
+	XMLName     string               `xml:"tpv:TiltMove"`
+	VideoSource onvif.ReferenceToken `xml:"tpv:VideoSource"`
+	Direction   TiltDirection        `xml:"tpv:Direction"`
+	Timeout     xsd.Duration         `xml:"tpv:Timeout,omitempty"`
+}
+
+type TiltMoveResponse struct {
+}
+
+type ZoomMove struct {
+	XMLName     string               `xml:"tpv:ZoomMove"`
+	VideoSource onvif.ReferenceToken `xml:"tpv:VideoSource"`
+	Direction   ZoomDirection        `xml:"tpv:Direction"`
+	Timeout     xsd.Duration         `xml:"tpv:Timeout,omitempty"`
+}
+
+type ZoomMoveResponse struct {
+}
+
+type RollMove struct {
+	XMLName     string               `xml:"tpv:RollMove"`
+	VideoSource onvif.ReferenceToken `xml:"tpv:VideoSource"`
+	Direction   RollDirection        `xml:"tpv:Direction"`
+	Timeout     xsd.Duration         `xml:"tpv:Timeout,omitempty"`
+}
+
+type RollMoveResponse struct {
+}
+
+type FocusMove struct {
+	XMLName     string               `xml:"tpv:FocusMove"`
+	VideoSource onvif.ReferenceToken `xml:"tpv:VideoSource"`
+	Direction   FocusDirection       `xml:"tpv:Direction"`
+	Timeout     xsd.Duration         `xml:"tpv:Timeout,omitempty"`
+}
+
+type FocusMoveResponse struct {
+}
+
+type Stop struct {
+	XMLName     string               `xml:"tpv:Stop"`
+	VideoSource onvif.ReferenceToken `xml:"tpv:VideoSource"`
+}
+
+type StopResponse struct {
+}
+
+type GetUsage struct {
+	XMLName     string               `xml:"tpv:GetUsage"`
+	VideoSource onvif.ReferenceToken `xml:"tpv:VideoSource"`
+}
+
+type GetUsageResponse struct {
+	Usage Usage
+}
+
+// Actions maps the request elements of the service to their WS-Addressing action
+var Actions = map[string]string{
+	"tpv:GetServiceCapabilities": "http://www.onvif.org/ver10/provisioning/wsdl/GetServiceCapabilities",
+	"tpv:PanMove":                "http://www.onvif.org/ver10/provisioning/wsdl/PanMove",
+	"tpv:TiltMove":               "http://www.onvif.org/ver10/provisioning/wsdl/TiltMove",
+	"tpv:ZoomMove":               "http://www.onvif.org/ver10/provisioning/wsdl/ZoomMove",
+	"tpv:RollMove":               "http://www.onvif.org/ver10/provisioning/wsdl/RollMove",
+	"tpv:FocusMove":              "http://www.onvif.org/ver10/provisioning/wsdl/FocusMove",
+	"tpv:Stop":                   "http://www.onvif.org/ver10/provisioning/wsdl/Stop",
+	"tpv:GetUsage":               "http://www.onvif.org/ver10/provisioning/wsdl/Usage",
+}
+
+func init() {
+	for tag, action := range Actions {
+		gosoap.RegisterAction(tag, action)
+	}
+}
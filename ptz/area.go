@@ -0,0 +1,137 @@
+package ptz
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// DefaultFieldOfView is a 30x zoom camera with a 16:9 frame
+var DefaultFieldOfView = FieldOfView{Wide: 60, Tele: 2, AspectRatio: 16.0 / 9}
+
+// ErrEmptyArea is returned for an area without surface in the frame
+var ErrEmptyArea = errors.New("area is empty or outside the frame")
+
+// FieldOfView describes the optics of a camera
+type FieldOfView struct {
+	// Wide and Tele are the horizontal fields of view in degrees at the
+	// minimum and maximum zoom
+	Wide, Tele float64
+	// AspectRatio is the frame width over its height
+	AspectRatio float64
+}
+
+// horizontal returns the horizontal field of view at a zoom of r, the
+// magnification is taken as growing geometrically with the zoom
+func (fov FieldOfView) horizontal(zoom float64, r onvif.FloatRange) float64 {
+	t := 0.0
+	if r.Max > r.Min {
+		t = math.Max(0, math.Min(1, (zoom-r.Min)/(r.Max-r.Min)))
+	}
+	return fov.Wide * math.Pow(fov.Tele/fov.Wide, t)
+}
+
+// zoom returns the zoom of r giving a horizontal field of view
+func (fov FieldOfView) zoom(horizontal float64, r onvif.FloatRange) float64 {
+	if fov.Tele == fov.Wide {
+		return r.Min
+	}
+	t := math.Log(horizontal/fov.Wide) / math.Log(fov.Tele/fov.Wide)
+	return r.Min + math.Max(0, math.Min(1, t))*(r.Max-r.Min)
+}
+
+// Area is a rectangle of the video frame in [0, 1] from its top left corner
+type Area struct {
+	X, Y, Width, Height float64
+}
+
+// clip returns the part of area in the frame, false when it is empty
+func (area Area) clip() (Area, bool) {
+	left, top := math.Max(area.X, 0), math.Max(area.Y, 0)
+	right, bottom := math.Min(area.X+area.Width, 1), math.Min(area.Y+area.Height, 1)
+	if right <= left || bottom <= top {
+		return Area{}, false
+	}
+	return Area{X: left, Y: top, Width: right - left, Height: bottom - top}, true
+}
+
+func (c *Controller) fieldOfView() FieldOfView {
+	if c.FieldOfView == (FieldOfView{}) {
+		return DefaultFieldOfView
+	}
+	return c.FieldOfView
+}
+
+// AreaRequest returns the RelativeMove or AbsoluteMove request centering
+// area and zooming until it fills the frame from the position of status.
+// Nodes with the FOV translation space get a RelativeMove, the others an
+// AbsoluteMove approximated with the FieldOfView of the controller
+func (c *Controller) AreaRequest(status Status, area Area) (interface{}, error) {
+	area, ok := area.clip()
+	if !ok {
+		return nil, ErrEmptyArea
+	}
+	spaces := c.Node.SupportedPTZSpaces
+	fov := c.fieldOfView()
+	zoomRange := onvif.FloatRange{Max: 1}
+	if space, ok := space1D(spaces.AbsoluteZoomPositionSpace, ZoomPositionGenericSpace); ok {
+		zoomRange = space.XRange
+	}
+
+	// the center of the area from the center of the frame, 1 at its edges
+	x := (area.X+area.Width/2)*2 - 1
+	y := 1 - (area.Y+area.Height/2)*2
+	horizontal := fov.horizontal(status.Position.Zoom, zoomRange)
+	// the larger side of the area fills the frame
+	zoom := fov.zoom(horizontal*math.Max(area.Width, area.Height), zoomRange)
+
+	if _, ok := space2D(spaces.RelativePanTiltTranslationSpace, TranslationSpaceFov); ok {
+		move := RelativeMove{
+			ProfileToken: c.Profile,
+			Translation:  onvif.PTZVector{PanTilt: &onvif.Vector2D{X: x, Y: y, Space: TranslationSpaceFov}},
+		}
+		if space, ok := space1D(spaces.RelativeZoomTranslationSpace, ZoomTranslationGenericSpace); ok {
+			move.Translation.Zoom = &onvif.Vector1D{X: clampRange(zoom-status.Position.Zoom, space.XRange), Space: ZoomTranslationGenericSpace}
+		}
+		return move, nil
+	}
+
+	vertical := degrees(2 * math.Atan(math.Tan(radians(horizontal/2))/fov.AspectRatio))
+	pan := degrees(math.Atan(x * math.Tan(radians(horizontal/2))))
+	tilt := degrees(math.Atan(y * math.Tan(radians(vertical/2))))
+	position := c.turn(status.Position, pan, tilt)
+	position.Zoom = zoom
+	return c.absoluteMove(position, 0), nil
+}
+
+// turn returns position turned by pan and tilt degrees. Without degree
+// space the generic ranges are taken as 360 degrees of pan and 180 of tilt
+func (c *Controller) turn(position Position, pan, tilt float64) Position {
+	if degrees, ok := space2D(c.Node.SupportedPTZSpaces.AbsolutePanTiltPositionSpace, PositionSpaceDegrees); ok {
+		currentPan, currentTilt, _ := c.ToDegrees(position.Pan, position.Tilt)
+		position.Pan, position.Tilt, _ = c.FromDegrees(wrapPan(currentPan+pan, degrees.XRange), currentTilt+tilt)
+		return position
+	}
+	generic := c.genericRange()
+	position.Pan += pan / 360 * (generic.XRange.Max - generic.XRange.Min)
+	position.Tilt += tilt / 180 * (generic.YRange.Max - generic.YRange.Min)
+	return position
+}
+
+// ZoomToArea centers area of the frame and zooms until it fills the frame
+func (c *Controller) ZoomToArea(ctx context.Context, area Area) error {
+	status, err := c.Status(ctx)
+	if err != nil {
+		return err
+	}
+	request, err := c.AreaRequest(status, area)
+	if err != nil {
+		return err
+	}
+	if move, ok := request.(RelativeMove); ok {
+		return c.caller.CallMethodUnmarshal(ctx, move, &RelativeMoveResponse{})
+	}
+	return c.caller.CallMethodUnmarshal(ctx, request, &AbsoluteMoveResponse{})
+}
@@ -0,0 +1,44 @@
+package ptz
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+func TestZoomToArea(t *testing.T) {
+	ctx := context.Background()
+	camera := &ptzCamera{}
+	c, err := NewController(ctx, camera, "profile", "ptz0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the right half of the frame: 16.1 degrees right at 60 degrees wide,
+	// a 2x zoom is 0.204 of the 30x range
+	area := Area{X: 0.5, Y: 0.25, Width: 0.5, Height: 0.5}
+	if err := c.ZoomToArea(ctx, area); err != nil {
+		t.Fatal(err)
+	}
+	position := camera.position
+	if math.Abs(position.PanTilt.X-16.102/180) > 1e-4 || math.Abs(position.PanTilt.Y) > 1e-9 || math.Abs(position.Zoom.X-0.2038) > 1e-4 {
+		t.Errorf("moved to %+v %+v", position.PanTilt, position.Zoom)
+	}
+
+	c.Node.SupportedPTZSpaces.RelativePanTiltTranslationSpace = []onvif.Space2DDescription{{URI: TranslationSpaceFov}}
+	c.Node.SupportedPTZSpaces.RelativeZoomTranslationSpace = []onvif.Space1DDescription{{URI: ZoomTranslationGenericSpace, XRange: onvif.FloatRange{Min: -1, Max: 1}}}
+	// a 2x zoom is the same translation from any zoom
+	request, err := c.AreaRequest(Status{Position: Position{Zoom: 0.1}}, area)
+	move, ok := request.(RelativeMove)
+	if err != nil || !ok {
+		t.Fatalf("AreaRequest = %T, %v", request, err)
+	}
+	if translation := move.Translation; translation.PanTilt.X != 0.5 || translation.PanTilt.Y != 0 || math.Abs(translation.Zoom.X-0.2038) > 1e-4 {
+		t.Errorf("relative move %+v %+v", translation.PanTilt, translation.Zoom)
+	}
+
+	if _, err := c.AreaRequest(Status{}, Area{X: 1.2, Width: 0.1, Height: 0.1}); err != ErrEmptyArea {
+		t.Errorf("area outside the frame: %v", err)
+	}
+}
@@ -0,0 +1,421 @@
+package ptz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Coordinate spaces of the PTZ service
+const (
+	PositionGenericSpace        = "http://www.onvif.org/ver10/tptz/PanTiltSpaces/PositionGenericSpace"
+	PositionSpaceDegrees        = "http://www.onvif.org/ver10/tptz/PanTiltSpaces/SphericalPositionSpaceDegrees"
+	TranslationGenericSpace     = "http://www.onvif.org/ver10/tptz/PanTiltSpaces/TranslationGenericSpace"
+	TranslationSpaceDegrees     = "http://www.onvif.org/ver10/tptz/PanTiltSpaces/SphericalTranslationSpaceDegrees"
+	TranslationSpaceFov         = "http://www.onvif.org/ver10/tptz/PanTiltSpaces/TranslationSpaceFov"
+	VelocityGenericSpace        = "http://www.onvif.org/ver10/tptz/PanTiltSpaces/VelocityGenericSpace"
+	ZoomPositionGenericSpace    = "http://www.onvif.org/ver10/tptz/ZoomSpaces/PositionGenericSpace"
+	ZoomTranslationGenericSpace = "http://www.onvif.org/ver10/tptz/ZoomSpaces/TranslationGenericSpace"
+	ZoomVelocityGenericSpace    = "http://www.onvif.org/ver10/tptz/ZoomSpaces/VelocityGenericSpace"
+	GenericSpeedSpace           = "http://www.onvif.org/ver10/tptz/PanTiltSpaces/GenericSpeedSpace"
+	ZoomGenericSpeedSpace       = "http://www.onvif.org/ver10/tptz/ZoomSpaces/ZoomGenericSpeedSpace"
+)
+
+// Move status of GetStatus
+const (
+	MoveStatusIdle    = "IDLE"
+	MoveStatusMoving  = "MOVING"
+	MoveStatusUnknown = "UNKNOWN"
+)
+
+// ErrNoDegreeSpace is returned by the degree conversions when the node does
+// not support the spherical position space in degrees
+var ErrNoDegreeSpace = errors.New("PTZ node has no position space in degrees")
+
+// Caller sends a request to the PTZ service and decodes its response,
+// gonvif.Device implements it
+type Caller interface {
+	CallMethodUnmarshal(ctx context.Context, method, response interface{}) error
+}
+
+// Position is a pan/tilt/zoom position in the generic spaces
+type Position struct {
+	Pan, Tilt, Zoom float64
+}
+
+// Status is the position and move status of a Controller
+type Status struct {
+	Position Position
+	// PanTilt and Zoom are MoveStatusIdle, MoveStatusMoving, MoveStatusUnknown
+	// or empty when the device does not report them
+	PanTilt string
+	Zoom    string
+	UtcTime xsd.DateTime
+}
+
+// Moving reports whether pan/tilt or zoom is moving
+func (status Status) Moving() bool {
+	return status.PanTilt == MoveStatusMoving || status.Zoom == MoveStatusMoving
+}
+
+// Controller moves the PTZ node of a media profile in the generic spaces,
+// clamping to the limits of its configuration
+type Controller struct {
+	Profile       onvif.ReferenceToken
+	Node          onvif.PTZNode
+	Configuration onvif.PTZConfiguration
+	Options       onvif.PTZConfigurationOptions
+	// PollInterval is the GetStatus interval of WaitIdle, the tours and the
+	// patrols, 200ms when 0
+	PollInterval time.Duration
+	// FieldOfView approximates the area moves of the nodes without FOV
+	// translation space, DefaultFieldOfView when zero
+	FieldOfView FieldOfView
+
+	caller Caller
+	mu     sync.Mutex
+	// presets maps the preset names to their tokens
+	presets map[string]onvif.ReferenceToken
+}
+
+// NewController reads the PTZ configuration configToken of the profile
+// profileToken, its node and options. configToken may be empty when the
+// device has a single PTZ configuration
+func NewController(ctx context.Context, caller Caller, profileToken, configToken onvif.ReferenceToken) (*Controller, error) {
+	c := &Controller{Profile: profileToken, caller: caller}
+	if configToken == "" {
+		var configurations GetConfigurationsResponse
+		if err := caller.CallMethodUnmarshal(ctx, GetConfigurations{}, &configurations); err != nil {
+			return nil, err
+		}
+		if len(configurations.PTZConfiguration) != 1 {
+			return nil, fmt.Errorf("device has %d PTZ configurations, the configuration token is required", len(configurations.PTZConfiguration))
+		}
+		c.Configuration = configurations.PTZConfiguration[0]
+	} else {
+		var configuration GetConfigurationResponse
+		if err := caller.CallMethodUnmarshal(ctx, GetConfiguration{PTZConfigurationToken: configToken}, &configuration); err != nil {
+			return nil, err
+		}
+		c.Configuration = configuration.PTZConfiguration
+	}
+
+	var node GetNodeResponse
+	if err := caller.CallMethodUnmarshal(ctx, GetNode{NodeToken: c.Configuration.NodeToken}, &node); err != nil {
+		return nil, err
+	}
+	c.Node = node.PTZNode
+	var options GetConfigurationOptionsResponse
+	if err := caller.CallMethodUnmarshal(ctx, GetConfigurationOptions{ConfigurationToken: c.Configuration.Token}, &options); err != nil {
+		return nil, err
+	}
+	c.Options = options.PTZConfigurationOptions
+	return c, nil
+}
+
+// space2D returns the pan/tilt space uri of spaces
+func space2D(spaces []onvif.Space2DDescription, uri string) (onvif.Space2DDescription, bool) {
+	for _, space := range spaces {
+		if string(space.URI) == uri {
+			return space, true
+		}
+	}
+	return onvif.Space2DDescription{}, false
+}
+
+// space1D returns the zoom space uri of spaces
+func space1D(spaces []onvif.Space1DDescription, uri string) (onvif.Space1DDescription, bool) {
+	for _, space := range spaces {
+		if string(space.URI) == uri {
+			return space, true
+		}
+	}
+	return onvif.Space1DDescription{}, false
+}
+
+// clampRange bounds v to r, empty ranges do not bound
+func clampRange(v float64, r onvif.FloatRange) float64 {
+	if r.Min == 0 && r.Max == 0 {
+		return v
+	}
+	if v < r.Min {
+		return r.Min
+	}
+	if v > r.Max {
+		return r.Max
+	}
+	return v
+}
+
+// mapRange maps v from the range from to the range to
+func mapRange(v float64, from, to onvif.FloatRange) float64 {
+	if from.Max == from.Min {
+		return to.Min
+	}
+	return to.Min + (v-from.Min)*(to.Max-to.Min)/(from.Max-from.Min)
+}
+
+// HasDegrees reports whether the node supports positions in degrees
+func (c *Controller) HasDegrees() bool {
+	_, ok := space2D(c.Node.SupportedPTZSpaces.AbsolutePanTiltPositionSpace, PositionSpaceDegrees)
+	return ok
+}
+
+// genericRange returns the ranges of the generic position space, [-1, 1]
+// when the node does not describe it
+func (c *Controller) genericRange() onvif.Space2DDescription {
+	if space, ok := space2D(c.Node.SupportedPTZSpaces.AbsolutePanTiltPositionSpace, PositionGenericSpace); ok {
+		return space
+	}
+	return onvif.Space2DDescription{URI: PositionGenericSpace, XRange: onvif.FloatRange{Min: -1, Max: 1}, YRange: onvif.FloatRange{Min: -1, Max: 1}}
+}
+
+// ToDegrees converts a generic pan/tilt position to degrees
+func (c *Controller) ToDegrees(pan, tilt float64) (float64, float64, error) {
+	degrees, ok := space2D(c.Node.SupportedPTZSpaces.AbsolutePanTiltPositionSpace, PositionSpaceDegrees)
+	if !ok {
+		return 0, 0, ErrNoDegreeSpace
+	}
+	generic := c.genericRange()
+	return mapRange(pan, generic.XRange, degrees.XRange), mapRange(tilt, generic.YRange, degrees.YRange), nil
+}
+
+// FromDegrees converts a pan/tilt position in degrees to the generic space
+func (c *Controller) FromDegrees(pan, tilt float64) (float64, float64, error) {
+	degrees, ok := space2D(c.Node.SupportedPTZSpaces.AbsolutePanTiltPositionSpace, PositionSpaceDegrees)
+	if !ok {
+		return 0, 0, ErrNoDegreeSpace
+	}
+	generic := c.genericRange()
+	return mapRange(pan, degrees.XRange, generic.XRange), mapRange(tilt, degrees.YRange, generic.YRange), nil
+}
+
+// Clamp bounds a position to the generic spaces of the node and the limits
+// of the configuration
+func (c *Controller) Clamp(position Position) Position {
+	generic := c.genericRange()
+	position.Pan = clampRange(position.Pan, generic.XRange)
+	position.Tilt = clampRange(position.Tilt, generic.YRange)
+	if zoom, ok := space1D(c.Node.SupportedPTZSpaces.AbsoluteZoomPositionSpace, ZoomPositionGenericSpace); ok {
+		position.Zoom = clampRange(position.Zoom, zoom.XRange)
+	}
+
+	if limits := c.Configuration.PanTiltLimits.Range; limits.URI == "" || limits.URI == PositionGenericSpace {
+		position.Pan = clampRange(position.Pan, limits.XRange)
+		position.Tilt = clampRange(position.Tilt, limits.YRange)
+	} else if limits.URI == PositionSpaceDegrees {
+		if pan, tilt, err := c.ToDegrees(position.Pan, position.Tilt); err == nil {
+			position.Pan, position.Tilt, _ = c.FromDegrees(clampRange(pan, limits.XRange), clampRange(tilt, limits.YRange))
+		}
+	}
+	if limits := c.Configuration.ZoomLimits.Range; limits.URI == "" || limits.URI == ZoomPositionGenericSpace {
+		position.Zoom = clampRange(position.Zoom, limits.XRange)
+	}
+	return position
+}
+
+// MoveTo moves to a position of the generic spaces, clamped to the limits
+func (c *Controller) MoveTo(ctx context.Context, pan, tilt, zoom float64) error {
+	return c.moveTo(ctx, Position{Pan: pan, Tilt: tilt, Zoom: zoom}, 0)
+}
+
+// moveTo moves to position clamped to the limits, at the default speed when
+// speed is 0
+func (c *Controller) moveTo(ctx context.Context, position Position, speed float64) error {
+	return c.caller.CallMethodUnmarshal(ctx, c.absoluteMove(position, speed), &AbsoluteMoveResponse{})
+}
+
+// absoluteMove returns the AbsoluteMove to position clamped to the limits
+func (c *Controller) absoluteMove(position Position, speed float64) AbsoluteMove {
+	position = c.Clamp(position)
+	return AbsoluteMove{
+		ProfileToken: c.Profile,
+		Position: onvif.PTZVector{
+			PanTilt: &onvif.Vector2D{X: position.Pan, Y: position.Tilt, Space: PositionGenericSpace},
+			Zoom:    &onvif.Vector1D{X: position.Zoom, Space: ZoomPositionGenericSpace},
+		},
+		Speed: c.speed(speed),
+	}
+}
+
+// MoveToDegrees moves pan/tilt to a position in degrees wrapped into the pan
+// range of the node and clamped to the limits, keeping the zoom. The speed is
+// the default speed when 0
+func (c *Controller) MoveToDegrees(ctx context.Context, pan, tilt, speed float64) error {
+	degrees, ok := space2D(c.Node.SupportedPTZSpaces.AbsolutePanTiltPositionSpace, PositionSpaceDegrees)
+	if !ok {
+		return ErrNoDegreeSpace
+	}
+	pan = wrapPan(pan, degrees.XRange)
+	genericPan, genericTilt, _ := c.FromDegrees(pan, tilt)
+	position := c.Clamp(Position{Pan: genericPan, Tilt: genericTilt})
+	pan, tilt, _ = c.ToDegrees(position.Pan, position.Tilt)
+	move := AbsoluteMove{
+		ProfileToken: c.Profile,
+		Position:     onvif.PTZVector{PanTilt: &onvif.Vector2D{X: pan, Y: tilt, Space: PositionSpaceDegrees}},
+		Speed:        c.speed(speed),
+	}
+	return c.caller.CallMethodUnmarshal(ctx, move, &AbsoluteMoveResponse{})
+}
+
+// wrapPan wraps a pan in degrees into r by turns
+func wrapPan(pan float64, r onvif.FloatRange) float64 {
+	for pan < r.Min && pan+360 <= r.Max {
+		pan += 360
+	}
+	for pan > r.Max && pan-360 >= r.Min {
+		pan -= 360
+	}
+	return pan
+}
+
+// Nudge moves by a translation of the generic spaces. Nodes without relative
+// moves are moved to the current position plus the translation
+func (c *Controller) Nudge(ctx context.Context, pan, tilt, zoom float64) error {
+	spaces := c.Node.SupportedPTZSpaces
+	translation, ok := space2D(spaces.RelativePanTiltTranslationSpace, TranslationGenericSpace)
+	if !ok {
+		status, err := c.Status(ctx)
+		if err != nil {
+			return err
+		}
+		p := status.Position
+		return c.MoveTo(ctx, p.Pan+pan, p.Tilt+tilt, p.Zoom+zoom)
+	}
+
+	move := RelativeMove{
+		ProfileToken: c.Profile,
+		Translation: onvif.PTZVector{
+			PanTilt: &onvif.Vector2D{
+				X:     clampRange(pan, translation.XRange),
+				Y:     clampRange(tilt, translation.YRange),
+				Space: TranslationGenericSpace,
+			},
+		},
+	}
+	if zoomSpace, ok := space1D(spaces.RelativeZoomTranslationSpace, ZoomTranslationGenericSpace); ok && zoom != 0 {
+		move.Translation.Zoom = &onvif.Vector1D{X: clampRange(zoom, zoomSpace.XRange), Space: ZoomTranslationGenericSpace}
+	}
+	return c.caller.CallMethodUnmarshal(ctx, move, &RelativeMoveResponse{})
+}
+
+// Jog moves at a velocity of the generic spaces for duration then stops.
+// The move carries duration as timeout in case the stop is lost
+func (c *Controller) Jog(ctx context.Context, pan, tilt, zoom float64, duration time.Duration) error {
+	move := ContinuousMove{ProfileToken: c.Profile, Velocity: c.velocity(pan, tilt, zoom), Timeout: formatDuration(duration)}
+	if err := c.caller.CallMethodUnmarshal(ctx, move, &ContinuousMoveResponse{}); err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		// stop the camera even though the caller gave up, the move timeout
+		// stops it anyway once duration elapses so the stop is bounded by it
+		stopCtx, cancel := context.WithTimeout(context.Background(), duration)
+		c.Stop(stopCtx)
+		cancel()
+		return ctx.Err()
+	}
+	return c.Stop(ctx)
+}
+
+// velocity returns a velocity of the generic spaces clamped to their ranges
+func (c *Controller) velocity(pan, tilt, zoom float64) onvif.PTZSpeed {
+	spaces := c.Node.SupportedPTZSpaces
+	panTilt, _ := space2D(spaces.ContinuousPanTiltVelocitySpace, VelocityGenericSpace)
+	velocity := onvif.PTZSpeed{
+		PanTilt: &onvif.Vector2D{X: clampRange(pan, panTilt.XRange), Y: clampRange(tilt, panTilt.YRange), Space: VelocityGenericSpace},
+	}
+	if zoom != 0 {
+		space, _ := space1D(spaces.ContinuousZoomVelocitySpace, ZoomVelocityGenericSpace)
+		velocity.Zoom = &onvif.Vector1D{X: clampRange(zoom, space.XRange), Space: ZoomVelocityGenericSpace}
+	}
+	return velocity
+}
+
+// Stop stops pan/tilt and zoom moves
+func (c *Controller) Stop(ctx context.Context) error {
+	stop := xsd.Boolean(true)
+	return c.caller.CallMethodUnmarshal(ctx, Stop{ProfileToken: c.Profile, PanTilt: &stop, Zoom: &stop}, &StopResponse{})
+}
+
+// Status returns the current position and move status
+func (c *Controller) Status(ctx context.Context) (Status, error) {
+	var response GetStatusResponse
+	if err := c.caller.CallMethodUnmarshal(ctx, GetStatus{ProfileToken: c.Profile}, &response); err != nil {
+		return Status{}, err
+	}
+	ptzStatus := response.PTZStatus
+	status := Status{
+		PanTilt: string(ptzStatus.MoveStatus.PanTilt),
+		Zoom:    string(ptzStatus.MoveStatus.Zoom),
+		UtcTime: ptzStatus.UtcTime,
+	}
+	if pt := ptzStatus.Position.PanTilt; pt != nil {
+		status.Position.Pan, status.Position.Tilt = pt.X, pt.Y
+		if string(pt.Space) == PositionSpaceDegrees {
+			pan, tilt, err := c.FromDegrees(pt.X, pt.Y)
+			if err != nil {
+				return Status{}, err
+			}
+			status.Position.Pan, status.Position.Tilt = pan, tilt
+		}
+	}
+	if zoom := ptzStatus.Position.Zoom; zoom != nil {
+		status.Position.Zoom = zoom.X
+	}
+	return status, nil
+}
+
+// WaitIdle polls GetStatus until pan/tilt and zoom stop moving. Devices not
+// reporting the move status are idle when two polls give the same position
+func (c *Controller) WaitIdle(ctx context.Context) error {
+	ticker := time.NewTicker(c.pollInterval())
+	defer ticker.Stop()
+
+	var last *Position
+	for {
+		status, err := c.Status(ctx)
+		if err != nil {
+			return err
+		}
+		if status.PanTilt != "" || status.Zoom != "" {
+			if !status.Moving() {
+				return nil
+			}
+		} else if last != nil && *last == status.Position {
+			return nil
+		}
+		last = &status.Position
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// pollInterval returns the GetStatus interval
+func (c *Controller) pollInterval() time.Duration {
+	if c.PollInterval == 0 {
+		return 200 * time.Millisecond
+	}
+	return c.PollInterval
+}
+
+// formatDuration returns d as an xsd:duration, empty when d is not positive
+func formatDuration(d time.Duration) xsd.Duration {
+	if d <= 0 {
+		return ""
+	}
+	return xsd.NewDuration(d)
+}
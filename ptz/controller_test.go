@@ -0,0 +1,216 @@
+package ptz
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sonnt85/gonvif/device"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// ptzCamera is a PTZ node with generic and degree spaces, limited to half
+// of its pan range, that reports MOVING on the first status after a move.
+// It keeps presets and tours, a started tour reports Touring on the second
+// status poll. It is safe for the patrol goroutine
+type ptzCamera struct {
+	mu       sync.Mutex
+	position onvif.PTZVector
+	moving   bool
+	calls    []string
+	// moves records the AbsoluteMove, GeoMove and GotoPreset requests
+	moves    []interface{}
+	velocity onvif.PTZSpeed
+	timeout  string
+	// latency delays every answer
+	latency time.Duration
+
+	presets    []onvif.PTZPreset
+	tours      map[onvif.ReferenceToken]*onvif.PresetTour
+	polls      int
+	failModify bool
+	// locations answers GetGeoLocation
+	locations []onvif.LocationEntity
+}
+
+func (camera *ptzCamera) CallMethodUnmarshal(ctx context.Context, method, response interface{}) error {
+	time.Sleep(camera.latency)
+	camera.mu.Lock()
+	defer camera.mu.Unlock()
+	camera.calls = append(camera.calls, reflect.TypeOf(method).Name())
+	unit := onvif.FloatRange{Min: -1, Max: 1}
+	switch method := method.(type) {
+	case GetConfiguration:
+		configuration := &response.(*GetConfigurationResponse).PTZConfiguration
+		configuration.Token = method.PTZConfigurationToken
+		configuration.NodeToken = "node"
+		configuration.PanTiltLimits.Range = onvif.Space2DDescription{URI: PositionGenericSpace,
+			XRange: onvif.FloatRange{Min: -0.5, Max: 0.5}, YRange: unit}
+	case GetNode:
+		spaces := &response.(*GetNodeResponse).PTZNode.SupportedPTZSpaces
+		spaces.AbsolutePanTiltPositionSpace = []onvif.Space2DDescription{
+			{URI: PositionGenericSpace, XRange: unit, YRange: unit},
+			{URI: PositionSpaceDegrees, XRange: onvif.FloatRange{Min: -180, Max: 180}, YRange: onvif.FloatRange{Min: -90, Max: 0}},
+		}
+		spaces.AbsoluteZoomPositionSpace = []onvif.Space1DDescription{{URI: ZoomPositionGenericSpace, XRange: onvif.FloatRange{Max: 1}}}
+		spaces.RelativePanTiltTranslationSpace = []onvif.Space2DDescription{{URI: TranslationGenericSpace, XRange: unit, YRange: unit}}
+		spaces.ContinuousPanTiltVelocitySpace = []onvif.Space2DDescription{{URI: VelocityGenericSpace, XRange: unit, YRange: unit}}
+	case GetConfigurationOptions:
+	case AbsoluteMove:
+		camera.position, camera.moving = method.Position, true
+		camera.moves = append(camera.moves, method)
+	case GeoMove:
+		camera.moves = append(camera.moves, method)
+	case RelativeMove:
+		camera.position.PanTilt.X += method.Translation.PanTilt.X
+		camera.position.PanTilt.Y += method.Translation.PanTilt.Y
+		camera.moving = true
+	case ContinuousMove:
+		camera.velocity, camera.timeout = method.Velocity, string(method.Timeout)
+	case Stop:
+	case GetStatus:
+		status := &response.(*GetStatusResponse).PTZStatus
+		status.Position = camera.position
+		status.MoveStatus.PanTilt, status.MoveStatus.Zoom = MoveStatusIdle, MoveStatusIdle
+		if camera.moving {
+			status.MoveStatus.PanTilt = MoveStatusMoving
+		}
+		camera.moving = false
+	case device.GetGeoLocation:
+		response.(*device.GetGeoLocationResponse).Location = camera.locations
+	case GetPresets:
+		response.(*GetPresetsResponse).Preset = append([]onvif.PTZPreset(nil), camera.presets...)
+	case SetPreset:
+		token := method.PresetToken
+		if token == "" {
+			token = onvif.ReferenceToken("preset" + string(rune('0'+len(camera.presets))))
+			camera.presets = append(camera.presets, onvif.PTZPreset{Token: token, Name: onvif.Name(method.PresetName)})
+		}
+		response.(*SetPresetResponse).PresetToken = token
+	case RemovePreset:
+		for i, preset := range camera.presets {
+			if preset.Token == method.PresetToken {
+				camera.presets = append(camera.presets[:i], camera.presets[i+1:]...)
+				return nil
+			}
+		}
+		return errors.New("no such preset")
+	case GotoPreset:
+		for _, preset := range camera.presets {
+			if preset.Token == method.PresetToken {
+				camera.position, camera.moving = preset.PTZPosition, true
+			}
+		}
+		camera.moves = append(camera.moves, method)
+	case GetPresetTourOptions:
+		options := &response.(*GetPresetTourOptionsResponse).Options
+		options.StartingCondition.Direction = []onvif.PTZPresetTourDirection{"Forward"}
+		options.TourSpot.StayTime = onvif.DurationRange{Min: "PT5S", Max: "PT1M"}
+		for _, preset := range camera.presets {
+			options.TourSpot.PresetDetail.PresetToken = append(options.TourSpot.PresetDetail.PresetToken, preset.Token)
+		}
+	case GetPresetTours:
+		for _, tour := range camera.tours {
+			response.(*GetPresetToursResponse).PresetTour = append(response.(*GetPresetToursResponse).PresetTour, *tour)
+		}
+	case CreatePresetTour:
+		if camera.tours == nil {
+			camera.tours = make(map[onvif.ReferenceToken]*onvif.PresetTour)
+		}
+		token := onvif.ReferenceToken("tour" + string(rune('0'+len(camera.tours))))
+		camera.tours[token] = &onvif.PresetTour{Token: token}
+		response.(*CreatePresetTourResponse).PresetTourToken = token
+	case ModifyPresetTour:
+		if camera.failModify {
+			return errors.New("modify refused")
+		}
+		tour := method.PresetTour
+		camera.tours[tour.Token] = &tour
+	case RemovePresetTour:
+		delete(camera.tours, method.PresetTourToken)
+	case OperatePresetTour:
+		state := map[string]string{TourStart: TourActive, TourStop: TourIdle, TourPause: TourPaused}[string(method.Operation)]
+		camera.tours[method.PresetTourToken].Status.State = onvif.PTZPresetTourState(state)
+		camera.polls = 0
+	case GetPresetTour:
+		tour := *camera.tours[method.PresetTourToken]
+		if camera.polls++; camera.polls < 2 {
+			tour.Status.State = "Moving"
+		}
+		response.(*GetPresetTourResponse).PresetTour = tour
+	default:
+		return errors.New("unexpected method")
+	}
+	return nil
+}
+
+// turn moves the camera as a user would
+func (camera *ptzCamera) turn(pan float64) {
+	camera.mu.Lock()
+	camera.position = onvif.PTZVector{PanTilt: &onvif.Vector2D{X: pan}, Zoom: &onvif.Vector1D{}}
+	camera.mu.Unlock()
+}
+
+// count returns the number of moves requested so far
+func (camera *ptzCamera) count() int {
+	camera.mu.Lock()
+	defer camera.mu.Unlock()
+	return len(camera.moves)
+}
+
+func TestController(t *testing.T) {
+	ctx := context.Background()
+	camera := &ptzCamera{}
+	c, err := NewController(ctx, camera, "profile", "ptz0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.PollInterval = time.Millisecond
+
+	pan, tilt, err := c.ToDegrees(0.5, 0)
+	if err != nil || pan != 90 || tilt != -45 {
+		t.Errorf("ToDegrees = %g, %g, %v", pan, tilt, err)
+	}
+	if pan, tilt, _ := c.FromDegrees(-90, -90); pan != -0.5 || tilt != -1 {
+		t.Errorf("FromDegrees = %g, %g", pan, tilt)
+	}
+
+	if err := c.MoveTo(ctx, 0.9, 2, 1.5); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.WaitIdle(ctx); err != nil {
+		t.Fatal(err)
+	}
+	status, err := c.Status(ctx)
+	if err != nil || status.Position != (Position{Pan: 0.5, Tilt: 1, Zoom: 1}) || status.Moving() {
+		t.Errorf("status after MoveTo %+v, %v", status, err)
+	}
+
+	if err := c.Nudge(ctx, -0.25, -0.5, 0); err != nil {
+		t.Fatal(err)
+	}
+	if status, _ := c.Status(ctx); math.Abs(status.Position.Pan-0.25) > 1e-9 || status.Position.Tilt != 0.5 {
+		t.Errorf("status after Nudge %+v", status)
+	}
+
+	camera.calls = nil
+	if err := c.Jog(ctx, 3, 0, 0, 10*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(camera.calls, []string{"ContinuousMove", "Stop"}) || camera.velocity.PanTilt.X != 1 || camera.velocity.Zoom != nil || camera.timeout != "PT0.01S" {
+		t.Errorf("Jog sent %v velocity %+v timeout %s", camera.calls, camera.velocity.PanTilt, camera.timeout)
+	}
+
+	camera.position.PanTilt = &onvif.Vector2D{X: 90, Y: -45, Space: PositionSpaceDegrees}
+	if status, err := c.Status(ctx); err != nil || status.Position.Pan != 0.5 || status.Position.Tilt != 0 {
+		t.Errorf("status in degrees %+v, %v", status.Position, err)
+	}
+	noDegrees := &Controller{Profile: "profile", caller: camera}
+	if _, err := noDegrees.Status(ctx); !errors.Is(err, ErrNoDegreeSpace) {
+		t.Errorf("status in degrees without degree space: %v", err)
+	}
+}
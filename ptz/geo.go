@@ -0,0 +1,172 @@
+package ptz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/sonnt85/gonvif/device"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// earthRadius is the mean radius of the earth in meters
+const earthRadius = 6371008.8
+
+// ErrNoGeoLocation is returned when the device reports no geo location
+var ErrNoGeoLocation = errors.New("device has no geo location")
+
+// ErrGeoReferences is returned when a calibration has less than two references
+var ErrGeoReferences = errors.New("PTZ geo calibration needs at least two references")
+
+// GeoCalibration places and orients a camera to point it at geo locations
+// without GeoMove
+type GeoCalibration struct {
+	Location onvif.GeoLocation
+	// Heading is the bearing of pan 0 in degrees clockwise from north and
+	// Pitch the elevation of tilt 0 in degrees
+	Heading float64
+	Pitch   float64
+	// Residual is the largest heading error of the references in degrees
+	Residual float64
+}
+
+// GeoReference is a preset aimed at a known geo location
+type GeoReference struct {
+	Preset string
+	Target onvif.GeoLocation
+}
+
+// Aim returns the pan and tilt in degrees pointing at target
+func (calibration GeoCalibration) Aim(target onvif.GeoLocation) (float64, float64) {
+	bearing, elevation := direction(calibration.Location, target)
+	return wrapDegrees(bearing - calibration.Heading), elevation - calibration.Pitch
+}
+
+// direction returns the bearing in degrees clockwise from north and the
+// elevation in degrees of to seen from from, with the earth curvature
+func direction(from, to onvif.GeoLocation) (float64, float64) {
+	lat1, lat2 := radians(float64(from.Lat)), radians(float64(to.Lat))
+	dLat, dLon := lat2-lat1, radians(float64(to.Lon-from.Lon))
+
+	y := math.Sin(dLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+	bearing := degrees(math.Atan2(y, x))
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	distance := 2 * earthRadius * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+	drop := distance * distance / (2 * earthRadius)
+	elevation := degrees(math.Atan2(float64(to.Elevation-from.Elevation)-drop, distance))
+	return bearing, elevation
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func degrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
+
+// wrapDegrees wraps an angle into [-180, 180)
+func wrapDegrees(angle float64) float64 {
+	angle = math.Mod(angle+180, 360)
+	if angle < 0 {
+		angle += 360
+	}
+	return angle - 180
+}
+
+// GeoCalibration reads the location and orientation of the camera from the
+// device, preferring the video source entries. The yaw is the heading and
+// the pitch the pitch of the calibration
+func (c *Controller) GeoCalibration(ctx context.Context) (GeoCalibration, error) {
+	var response device.GetGeoLocationResponse
+	if err := c.caller.CallMethodUnmarshal(ctx, device.GetGeoLocation{}, &response); err != nil {
+		return GeoCalibration{}, err
+	}
+	var entity *onvif.LocationEntity
+	for i, location := range response.Location {
+		if location.GeoLocation != nil && (entity == nil || location.Entity == "VideoSource" && entity.Entity != "VideoSource") {
+			entity = &response.Location[i]
+		}
+	}
+	if entity == nil {
+		return GeoCalibration{}, ErrNoGeoLocation
+	}
+	calibration := GeoCalibration{Location: *entity.GeoLocation}
+	if orientation := entity.GeoOrientation; orientation != nil {
+		calibration.Heading, calibration.Pitch = float64(orientation.Yaw), float64(orientation.Pitch)
+	}
+	return calibration, nil
+}
+
+// Calibrate fits the heading and pitch of a camera at location from presets
+// aimed at known targets
+func (c *Controller) Calibrate(ctx context.Context, location onvif.GeoLocation, references []GeoReference) (GeoCalibration, error) {
+	if len(references) < 2 {
+		return GeoCalibration{}, ErrGeoReferences
+	}
+	presets, err := c.Presets(ctx)
+	if err != nil {
+		return GeoCalibration{}, err
+	}
+
+	calibration := GeoCalibration{Location: location}
+	headings := make([]float64, len(references))
+	var sin, cos float64
+	for i, reference := range references {
+		pan, tilt, err := c.presetDegrees(presets, reference.Preset)
+		if err != nil {
+			return GeoCalibration{}, err
+		}
+		bearing, elevation := direction(location, reference.Target)
+		headings[i] = bearing - pan
+		sin += math.Sin(radians(headings[i]))
+		cos += math.Cos(radians(headings[i]))
+		calibration.Pitch += (elevation - tilt) / float64(len(references))
+	}
+	// the mean of the headings on the circle
+	calibration.Heading = wrapDegrees(degrees(math.Atan2(sin, cos)))
+	for _, heading := range headings {
+		calibration.Residual = math.Max(calibration.Residual, math.Abs(wrapDegrees(heading-calibration.Heading)))
+	}
+	return calibration, nil
+}
+
+// presetDegrees returns the pan/tilt position in degrees of the preset name
+func (c *Controller) presetDegrees(presets []onvif.PTZPreset, name string) (float64, float64, error) {
+	for _, preset := range presets {
+		if string(preset.Name) != name {
+			continue
+		}
+		panTilt := preset.PTZPosition.PanTilt
+		if panTilt == nil {
+			return 0, 0, fmt.Errorf("PTZ preset %q has no pan/tilt position", name)
+		}
+		if string(panTilt.Space) == PositionSpaceDegrees {
+			return panTilt.X, panTilt.Y, nil
+		}
+		return c.ToDegrees(panTilt.X, panTilt.Y)
+	}
+	return 0, 0, &PresetNotFoundError{Name: name}
+}
+
+// PointAt points the camera at target with GeoMove when the node supports
+// it. Otherwise pan/tilt are computed from calibration, or from the geo
+// location of the device when calibration is nil, and moved to in degrees
+func (c *Controller) PointAt(ctx context.Context, target onvif.GeoLocation, calibration *GeoCalibration, speed float64) error {
+	if c.Node.GeoMove {
+		request := GeoMove{ProfileToken: c.Profile, Target: target, Speed: c.speed(speed)}
+		return c.caller.CallMethodUnmarshal(ctx, request, &GeoMoveResponse{})
+	}
+	if calibration == nil {
+		located, err := c.GeoCalibration(ctx)
+		if err != nil {
+			return err
+		}
+		calibration = &located
+	}
+	pan, tilt := calibration.Aim(target)
+	return c.MoveToDegrees(ctx, pan, tilt, speed)
+}
@@ -0,0 +1,63 @@
+package ptz
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+var geoCameraLocation = onvif.GeoLocation{Lat: 48.85, Lon: 2.35, Elevation: 30}
+
+func TestPointAt(t *testing.T) {
+	ctx := context.Background()
+	// the camera faces east at pan 0, its presets aim north and east of it
+	location := geoCameraLocation
+	camera := &ptzCamera{
+		locations: []onvif.LocationEntity{
+			{Entity: "Device"},
+			{Entity: "VideoSource", GeoLocation: &location, GeoOrientation: &onvif.GeoOrientation{Yaw: 90}},
+		},
+		presets: []onvif.PTZPreset{
+			{Name: "north", PTZPosition: onvif.PTZVector{PanTilt: &onvif.Vector2D{X: -90, Space: PositionSpaceDegrees}}},
+			{Name: "east", PTZPosition: onvif.PTZVector{PanTilt: &onvif.Vector2D{X: -0.995, Space: PositionGenericSpace}}},
+		},
+	}
+	c := &Controller{Profile: "profile", caller: camera}
+	c.Node.SupportedPTZSpaces.AbsolutePanTiltPositionSpace = []onvif.Space2DDescription{
+		{URI: PositionGenericSpace, XRange: onvif.FloatRange{Min: -1, Max: 1}, YRange: onvif.FloatRange{Min: -1, Max: 1}},
+		{URI: PositionSpaceDegrees, XRange: onvif.FloatRange{Min: 0, Max: 360}, YRange: onvif.FloatRange{Min: -90, Max: 90}},
+	}
+	north := onvif.GeoLocation{Lat: 48.86, Lon: 2.35, Elevation: 30}
+	east := onvif.GeoLocation{Lat: 48.85, Lon: 2.37, Elevation: 30}
+
+	if err := c.PointAt(ctx, north, nil, 0); err != nil {
+		t.Fatal(err)
+	}
+	// north is -90 from the east heading, wrapped into [0, 360]
+	panTilt := camera.moves[0].(AbsoluteMove).Position.PanTilt
+	if math.Abs(panTilt.X-270) > 1e-6 || panTilt.Y > 0 || panTilt.Y < -0.01 || panTilt.Space != PositionSpaceDegrees {
+		t.Errorf("moved to %+v", panTilt)
+	}
+
+	calibration, err := c.Calibrate(ctx, geoCameraLocation, []GeoReference{{"north", north}, {"east", east}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the east preset is 0.9 degrees off, the headings are 90 and 89.1
+	if math.Abs(calibration.Heading-89.55) > 0.01 || math.Abs(calibration.Residual-0.45) > 0.01 {
+		t.Errorf("calibration %+v", calibration)
+	}
+	if _, err := c.Calibrate(ctx, geoCameraLocation, []GeoReference{{"north", north}}); err != ErrGeoReferences {
+		t.Errorf("single reference: %v", err)
+	}
+
+	c.Node.GeoMove = true
+	if err := c.PointAt(ctx, east, &calibration, 0); err != nil {
+		t.Fatal(err)
+	}
+	if move := camera.moves[1].(GeoMove); move.Target != east {
+		t.Errorf("GeoMove to %+v", move.Target)
+	}
+}
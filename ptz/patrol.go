@@ -0,0 +1,355 @@
+package ptz
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+)
+
+// Patrol states
+const (
+	PatrolRunning     = "Running"
+	PatrolPaused      = "Paused"
+	PatrolManual      = "Manual"
+	PatrolOffSchedule = "OffSchedule"
+)
+
+// ErrEmptyPatrol is returned when a patrol has no stop
+var ErrEmptyPatrol = errors.New("PTZ patrol has no stop")
+
+// PatrolStop is a stop of a patrol, a preset or a position
+type PatrolStop struct {
+	// Preset is the preset name, Position is used when empty
+	Preset   string
+	Position Position
+	// Dwell is the time spent at the stop once reached
+	Dwell time.Duration
+	// Speed is a speed of the generic speed spaces, the default speed when 0
+	Speed float64
+}
+
+// PatrolWindow is a daily time window of a patrol schedule. From and To are
+// offsets from midnight, a window with To before From spans midnight and a
+// window with To equal to From lasts the whole day
+type PatrolWindow struct {
+	From, To time.Duration
+	// Weekdays are the days the window starts on, every day when empty
+	Weekdays []time.Weekday
+}
+
+// Contains reports whether t is in the window
+func (w PatrolWindow) Contains(t time.Time) bool {
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset, day := t.Sub(midnight), t.Weekday()
+	if w.From < w.To {
+		return offset >= w.From && offset < w.To && w.on(day)
+	}
+	if offset >= w.From {
+		return w.on(day)
+	}
+	// the part after midnight of the window started the day before
+	return offset < w.To && w.on((day+6)%7)
+}
+
+func (w PatrolWindow) on(day time.Weekday) bool {
+	if len(w.Weekdays) == 0 {
+		return true
+	}
+	for _, weekday := range w.Weekdays {
+		if weekday == day {
+			return true
+		}
+	}
+	return false
+}
+
+// PatrolOptions configure a Patrol
+type PatrolOptions struct {
+	Stops []PatrolStop
+	// Schedule are the windows the patrol runs in, always when empty
+	Schedule []PatrolWindow
+	// ManualHold is how long the camera must be left alone after a manual
+	// control before the patrol resumes, 30s when 0
+	ManualHold time.Duration
+	// Tolerance is the drift of the generic spaces taken as a manual control
+	// while dwelling at a stop, 0.01 when 0
+	Tolerance float64
+	// Now is the clock of the schedule, time.Now when nil
+	Now func() time.Time
+	// OnEvent is called when the state or the stop changes and on errors
+	OnEvent func(PatrolEvent)
+}
+
+// PatrolEvent is a change of a Patrol
+type PatrolEvent struct {
+	State string
+	// Stop is the index of the current stop
+	Stop int
+	Err  error
+}
+
+// Patrol moves a Controller through its stops in a loop for the devices
+// without preset tours. It holds when paused, outside its schedule and when
+// the camera is controlled manually, either signalled by Manual or detected
+// from GetStatus while dwelling, and resumes at the interrupted stop
+type Patrol struct {
+	controller *Controller
+	options    PatrolOptions
+	cancel     context.CancelFunc
+	wake       chan struct{}
+	done       chan struct{}
+
+	mu     sync.Mutex
+	paused bool
+	// manual is the end of the manual hold
+	manual time.Time
+	state  string
+	stop   int
+}
+
+// Patrol starts a Patrol, closed by Close or by cancelling ctx. The presets
+// of the stops must exist
+func (c *Controller) Patrol(ctx context.Context, options PatrolOptions) (*Patrol, error) {
+	if len(options.Stops) == 0 {
+		return nil, ErrEmptyPatrol
+	}
+	for _, stop := range options.Stops {
+		if stop.Preset == "" {
+			continue
+		}
+		if _, err := c.PresetToken(ctx, stop.Preset); err != nil {
+			return nil, err
+		}
+	}
+	if options.ManualHold == 0 {
+		options.ManualHold = 30 * time.Second
+	}
+	if options.Tolerance == 0 {
+		options.Tolerance = 0.01
+	}
+	if options.Now == nil {
+		options.Now = time.Now
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	p := &Patrol{
+		controller: c,
+		options:    options,
+		cancel:     cancel,
+		wake:       make(chan struct{}, 1),
+		done:       make(chan struct{}),
+	}
+	go p.run(ctx)
+	return p, nil
+}
+
+// Pause holds the patrol until Resume
+func (p *Patrol) Pause() {
+	p.mu.Lock()
+	p.paused = true
+	p.mu.Unlock()
+	p.signal()
+}
+
+// Resume resumes a paused patrol
+func (p *Patrol) Resume() {
+	p.mu.Lock()
+	p.paused = false
+	p.mu.Unlock()
+	p.signal()
+}
+
+// Manual signals a manual control of the camera, the patrol holds until the
+// camera is left alone for ManualHold
+func (p *Patrol) Manual() {
+	p.hold()
+	p.signal()
+}
+
+// State returns the state and the index of the current stop
+func (p *Patrol) State() (string, int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.state, p.stop
+}
+
+// Close stops the patrol
+func (p *Patrol) Close() {
+	p.cancel()
+	<-p.done
+}
+
+func (p *Patrol) signal() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+// hold starts or extends the manual hold
+func (p *Patrol) hold() {
+	p.mu.Lock()
+	p.manual = time.Now().Add(p.options.ManualHold)
+	p.mu.Unlock()
+}
+
+// held returns the state holding the patrol, empty when it can run
+func (p *Patrol) held() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	switch {
+	case p.paused:
+		return PatrolPaused
+	case time.Now().Before(p.manual):
+		return PatrolManual
+	case !p.scheduled(p.options.Now()):
+		return PatrolOffSchedule
+	}
+	return ""
+}
+
+func (p *Patrol) scheduled(t time.Time) bool {
+	if len(p.options.Schedule) == 0 {
+		return true
+	}
+	for _, window := range p.options.Schedule {
+		if window.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// drifted reports whether the camera moved away from position
+func (p *Patrol) drifted(position, status Position) bool {
+	tolerance := p.options.Tolerance
+	return math.Abs(status.Pan-position.Pan) > tolerance ||
+		math.Abs(status.Tilt-position.Tilt) > tolerance ||
+		math.Abs(status.Zoom-position.Zoom) > tolerance
+}
+
+// setState records the state and the stop and reports the changes and err
+func (p *Patrol) setState(state string, stop int, err error) {
+	p.mu.Lock()
+	changed := state != p.state || stop != p.stop
+	p.state, p.stop = state, stop
+	p.mu.Unlock()
+	if (changed || err != nil) && p.options.OnEvent != nil {
+		p.options.OnEvent(PatrolEvent{State: state, Stop: stop, Err: err})
+	}
+}
+
+func (p *Patrol) run(ctx context.Context) {
+	defer close(p.done)
+	next := 0
+	for {
+		if !p.ready(ctx, next) {
+			return
+		}
+		p.setState(PatrolRunning, next, nil)
+		stop := p.options.Stops[next]
+		reached, err := p.visit(ctx, stop)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			// keep the pace of the patrol while the device fails
+			p.setState(PatrolRunning, next, err)
+			timer := time.NewTimer(stop.Dwell + p.controller.pollInterval())
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			}
+		} else if !reached {
+			continue
+		}
+		next = (next + 1) % len(p.options.Stops)
+	}
+}
+
+// ready waits until nothing holds the patrol, false when ctx is done. The
+// manual hold is extended while GetStatus shows the camera moving
+func (p *Patrol) ready(ctx context.Context, stop int) bool {
+	ticker := time.NewTicker(p.controller.pollInterval())
+	defer ticker.Stop()
+
+	var last *Position
+	for {
+		state := p.held()
+		if state == "" {
+			return true
+		}
+		p.setState(state, stop, nil)
+		if state == PatrolManual {
+			if status, err := p.controller.Status(ctx); err == nil {
+				if status.Moving() || last != nil && p.drifted(*last, status.Position) {
+					p.hold()
+				}
+				last = &status.Position
+			}
+		} else {
+			last = nil
+		}
+
+		select {
+		case <-p.wake:
+		case <-ticker.C:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// visit moves to stop and dwells there, false when the dwell is interrupted
+func (p *Patrol) visit(ctx context.Context, stop PatrolStop) (bool, error) {
+	c := p.controller
+	var err error
+	if stop.Preset != "" {
+		err = c.GotoPreset(ctx, stop.Preset, stop.Speed)
+	} else {
+		err = c.moveTo(ctx, stop.Position, stop.Speed)
+	}
+	if err == nil {
+		err = c.WaitIdle(ctx)
+	}
+	var status Status
+	if err == nil {
+		status, err = c.Status(ctx)
+	}
+	if err != nil {
+		return false, err
+	}
+	return p.dwell(ctx, stop.Dwell, status.Position), nil
+}
+
+// dwell waits at position, false when interrupted by a hold. A move away
+// from position is a manual control
+func (p *Patrol) dwell(ctx context.Context, d time.Duration, position Position) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	ticker := time.NewTicker(p.controller.pollInterval())
+	defer ticker.Stop()
+
+	for {
+		if p.held() != "" {
+			return false
+		}
+		select {
+		case <-timer.C:
+			return true
+		case <-p.wake:
+		case <-ticker.C:
+			status, err := p.controller.Status(ctx)
+			if err == nil && (status.Moving() || p.drifted(position, status.Position)) {
+				p.hold()
+				return false
+			}
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
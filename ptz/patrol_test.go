@@ -0,0 +1,110 @@
+package ptz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+func waitFor(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timeout waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestPatrol(t *testing.T) {
+	ctx := context.Background()
+	camera := &ptzCamera{presets: []onvif.PTZPreset{{Token: "preset0", Name: "gate",
+		PTZPosition: onvif.PTZVector{PanTilt: &onvif.Vector2D{X: -0.5}, Zoom: &onvif.Vector1D{}}}}}
+	c := &Controller{Profile: "profile", caller: camera, PollInterval: time.Millisecond}
+	unit := onvif.FloatRange{Min: -1, Max: 1}
+	c.Node.SupportedPTZSpaces.AbsolutePanTiltPositionSpace = []onvif.Space2DDescription{{URI: PositionGenericSpace, XRange: unit, YRange: unit}}
+
+	if _, err := c.Patrol(ctx, PatrolOptions{}); err != ErrEmptyPatrol {
+		t.Errorf("empty patrol: %v", err)
+	}
+	var notFound *PresetNotFoundError
+	if _, err := c.Patrol(ctx, PatrolOptions{Stops: []PatrolStop{{Preset: "parking"}}}); !errors.As(err, &notFound) {
+		t.Errorf("unknown preset: %v", err)
+	}
+
+	p, err := c.Patrol(ctx, PatrolOptions{
+		Stops: []PatrolStop{
+			{Preset: "gate", Dwell: 5 * time.Millisecond},
+			{Position: Position{Pan: 0.5}, Dwell: 50 * time.Millisecond},
+		},
+		ManualHold: 20 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	waitFor(t, "two rounds", func() bool { return camera.count() >= 4 })
+
+	p.Pause()
+	waitFor(t, "pause", func() bool { state, _ := p.State(); return state == PatrolPaused })
+	moves := camera.count()
+	time.Sleep(20 * time.Millisecond)
+	if camera.count() != moves {
+		t.Errorf("paused patrol moved")
+	}
+	p.Resume()
+	waitFor(t, "resume", func() bool { return camera.count() > moves })
+
+	// a user turns the camera, the patrol holds then resumes at the stop it
+	// was interrupted at
+	var interrupted int
+	waitFor(t, "manual control", func() bool {
+		camera.turn(0.9)
+		state, stop := p.State()
+		interrupted = stop
+		return state == PatrolManual
+	})
+	waitFor(t, "resume after the hold", func() bool {
+		state, stop := p.State()
+		return state == PatrolRunning && stop == interrupted
+	})
+}
+
+func TestPatrolSchedule(t *testing.T) {
+	night := PatrolWindow{From: 22 * time.Hour, To: 6 * time.Hour, Weekdays: []time.Weekday{time.Friday}}
+	for _, test := range []struct {
+		time     time.Time
+		contains bool
+	}{
+		{time.Date(2024, 3, 1, 23, 0, 0, 0, time.UTC), true}, // Friday
+		{time.Date(2024, 3, 2, 5, 59, 0, 0, time.UTC), true},
+		{time.Date(2024, 3, 2, 6, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, 3, 2, 23, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, 3, 1, 5, 0, 0, 0, time.UTC), false},
+	} {
+		if contains := night.Contains(test.time); contains != test.contains {
+			t.Errorf("Contains(%s) = %t", test.time, contains)
+		}
+	}
+
+	camera := &ptzCamera{}
+	c := &Controller{Profile: "profile", caller: camera, PollInterval: time.Millisecond}
+	noon := func() time.Time { return time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC) }
+	p, err := c.Patrol(context.Background(), PatrolOptions{
+		Stops:    []PatrolStop{{Position: Position{Pan: 0.5}}},
+		Schedule: []PatrolWindow{night},
+		Now:      noon,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "off schedule", func() bool { state, _ := p.State(); return state == PatrolOffSchedule })
+	p.Close()
+	if camera.count() != 0 {
+		t.Errorf("patrol moved off schedule")
+	}
+}
@@ -0,0 +1,124 @@
+package ptz
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// PresetNotFoundError is returned when no preset of the profile has the name
+type PresetNotFoundError struct {
+	Name string
+}
+
+func (err *PresetNotFoundError) Error() string {
+	return fmt.Sprintf("PTZ preset %q not found", err.Name)
+}
+
+// Presets reads the presets of the profile and refreshes the tokens tracked
+// by name
+func (c *Controller) Presets(ctx context.Context) ([]onvif.PTZPreset, error) {
+	var response GetPresetsResponse
+	if err := c.caller.CallMethodUnmarshal(ctx, GetPresets{ProfileToken: c.Profile}, &response); err != nil {
+		return nil, err
+	}
+	presets := make(map[string]onvif.ReferenceToken, len(response.Preset))
+	for _, preset := range response.Preset {
+		presets[string(preset.Name)] = preset.Token
+	}
+	c.mu.Lock()
+	c.presets = presets
+	c.mu.Unlock()
+	return response.Preset, nil
+}
+
+// PresetToken returns the token of the preset name, reading the presets
+// when the name is not tracked yet
+func (c *Controller) PresetToken(ctx context.Context, name string) (onvif.ReferenceToken, error) {
+	c.mu.Lock()
+	token, ok := c.presets[name]
+	c.mu.Unlock()
+	if ok {
+		return token, nil
+	}
+	if _, err := c.Presets(ctx); err != nil {
+		return "", err
+	}
+	c.mu.Lock()
+	token, ok = c.presets[name]
+	c.mu.Unlock()
+	if !ok {
+		return "", &PresetNotFoundError{Name: name}
+	}
+	return token, nil
+}
+
+// SavePreset stores the current position as the preset name, overwriting the
+// preset of that name when it exists
+func (c *Controller) SavePreset(ctx context.Context, name string) (onvif.ReferenceToken, error) {
+	token, err := c.PresetToken(ctx, name)
+	if _, notFound := err.(*PresetNotFoundError); err != nil && !notFound {
+		return "", err
+	}
+	request := SetPreset{ProfileToken: c.Profile, PresetName: xsd.String(name), PresetToken: token}
+	var response SetPresetResponse
+	if err := c.caller.CallMethodUnmarshal(ctx, request, &response); err != nil {
+		return "", err
+	}
+	if response.PresetToken != "" {
+		token = response.PresetToken
+	}
+	c.mu.Lock()
+	if c.presets == nil {
+		c.presets = make(map[string]onvif.ReferenceToken)
+	}
+	c.presets[name] = token
+	c.mu.Unlock()
+	return token, nil
+}
+
+// GotoPreset moves to the preset name, at the default speed when speed is 0
+func (c *Controller) GotoPreset(ctx context.Context, name string, speed float64) error {
+	token, err := c.PresetToken(ctx, name)
+	if err != nil {
+		return err
+	}
+	request := GotoPreset{ProfileToken: c.Profile, PresetToken: token, Speed: c.speed(speed)}
+	return c.caller.CallMethodUnmarshal(ctx, request, &GotoPresetResponse{})
+}
+
+// RemovePreset removes the preset name
+func (c *Controller) RemovePreset(ctx context.Context, name string) error {
+	token, err := c.PresetToken(ctx, name)
+	if err != nil {
+		return err
+	}
+	if err := c.caller.CallMethodUnmarshal(ctx, RemovePreset{ProfileToken: c.Profile, PresetToken: token}, &RemovePresetResponse{}); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	delete(c.presets, name)
+	c.mu.Unlock()
+	return nil
+}
+
+// speed returns a speed of the generic speed spaces clamped to their ranges,
+// nil for 0
+func (c *Controller) speed(speed float64) *onvif.PTZSpeed {
+	if speed == 0 {
+		return nil
+	}
+	panTilt, _ := space1D(c.Node.SupportedPTZSpaces.PanTiltSpeedSpace, GenericSpeedSpace)
+	zoom, _ := space1D(c.Node.SupportedPTZSpaces.ZoomSpeedSpace, ZoomGenericSpeedSpace)
+	return genericSpeed(clampRange(speed, panTilt.XRange), clampRange(speed, zoom.XRange))
+}
+
+// genericSpeed returns a speed of the generic speed spaces
+func genericSpeed(panTilt, zoom float64) *onvif.PTZSpeed {
+	return &onvif.PTZSpeed{
+		PanTilt: &onvif.Vector2D{X: panTilt, Y: panTilt, Space: GenericSpeedSpace},
+		Zoom:    &onvif.Vector1D{X: zoom, Space: ZoomGenericSpeedSpace},
+	}
+}
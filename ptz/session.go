@@ -0,0 +1,184 @@
+package ptz
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// SessionOptions configure a Session
+type SessionOptions struct {
+	// Interval is the minimum delay between two commands, 100ms when 0
+	Interval time.Duration
+	// Timeout is sent as ContinuousMove timeout and is the dead-man delay:
+	// Stop is sent when no Move arrives for Timeout, 1s when 0
+	Timeout time.Duration
+	// OnCommand is called after every command with its latency
+	OnCommand func(command string, latency time.Duration, err error)
+}
+
+// SessionStats are the commands sent by a Session
+type SessionStats struct {
+	Moves  int
+	Stops  int
+	Errors int
+	// LastLatency, MaxLatency and MeanLatency are the round trip durations
+	// of the commands
+	LastLatency time.Duration
+	MaxLatency  time.Duration
+	MeanLatency time.Duration
+}
+
+// Session streams joystick velocities to a Controller with ContinuousMove.
+// Move can be called at any rate, the latest velocity is sent at most once per
+// Interval and sent again before the device Timeout elapses while updates
+// keep coming. Stop is sent when updates stop for Timeout, on a zero velocity
+// and when the session is closed or its context cancelled
+type Session struct {
+	controller *Controller
+	options    SessionOptions
+	cancel     context.CancelFunc
+	wake       chan struct{}
+	done       chan struct{}
+
+	mu       sync.Mutex
+	velocity Position
+	changed  bool
+	input    time.Time
+	stats    SessionStats
+	total    time.Duration
+}
+
+// Session starts a Session, closed by Close or by cancelling ctx
+func (c *Controller) Session(ctx context.Context, options SessionOptions) *Session {
+	if options.Interval == 0 {
+		options.Interval = 100 * time.Millisecond
+	}
+	if options.Timeout == 0 {
+		options.Timeout = time.Second
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Session{
+		controller: c,
+		options:    options,
+		cancel:     cancel,
+		wake:       make(chan struct{}, 1),
+		done:       make(chan struct{}),
+	}
+	go s.run(ctx)
+	return s
+}
+
+// Move sets the velocity of the generic spaces, a zero velocity stops
+func (s *Session) Move(pan, tilt, zoom float64) {
+	s.mu.Lock()
+	velocity := Position{Pan: pan, Tilt: tilt, Zoom: zoom}
+	if velocity != s.velocity {
+		s.velocity, s.changed = velocity, true
+	}
+	s.input = time.Now()
+	s.mu.Unlock()
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Stats returns the commands sent so far
+func (s *Session) Stats() SessionStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stats
+}
+
+// Close stops the device and the session
+func (s *Session) Close() {
+	s.cancel()
+	<-s.done
+}
+
+func (s *Session) run(ctx context.Context) {
+	defer close(s.done)
+	ticker := time.NewTicker(s.options.Interval)
+	defer ticker.Stop()
+
+	var lastCommand time.Time
+	moving := false
+	for {
+		select {
+		case <-ctx.Done():
+			if moving {
+				// the session context is gone, bound the stop by the timeout
+				stopCtx, cancel := context.WithTimeout(context.Background(), s.options.Timeout)
+				s.send(stopCtx, Position{})
+				cancel()
+			}
+			return
+		case <-s.wake:
+		case <-ticker.C:
+		}
+
+		now := time.Now()
+		if now.Sub(lastCommand) < s.options.Interval {
+			continue
+		}
+		s.mu.Lock()
+		velocity := s.velocity
+		// dead man: the input stopped while moving
+		deadMan := moving && now.Sub(s.input) >= s.options.Timeout
+		// refresh the move before the device timeout while the input goes on
+		refresh := moving && s.input.After(lastCommand) && now.Sub(lastCommand) >= s.options.Timeout/2
+		if deadMan {
+			s.velocity, velocity = Position{}, Position{}
+		}
+		send := deadMan || s.changed || refresh
+		s.changed = false
+		s.mu.Unlock()
+		if !send {
+			continue
+		}
+		s.send(ctx, velocity)
+		lastCommand = time.Now()
+		moving = velocity != Position{}
+	}
+}
+
+// send sends a ContinuousMove, or a Stop for a zero velocity, and records its latency
+func (s *Session) send(ctx context.Context, velocity Position) {
+	c := s.controller
+	command := "ContinuousMove"
+	start := time.Now()
+	var err error
+	if velocity == (Position{}) {
+		command = "Stop"
+		err = c.Stop(ctx)
+	} else {
+		move := ContinuousMove{
+			ProfileToken: c.Profile,
+			Velocity:     c.velocity(velocity.Pan, velocity.Tilt, velocity.Zoom),
+			Timeout:      formatDuration(s.options.Timeout),
+		}
+		err = c.caller.CallMethodUnmarshal(ctx, move, &ContinuousMoveResponse{})
+	}
+	latency := time.Since(start)
+
+	s.mu.Lock()
+	if command == "Stop" {
+		s.stats.Stops++
+	} else {
+		s.stats.Moves++
+	}
+	if err != nil {
+		s.stats.Errors++
+	}
+	s.total += latency
+	s.stats.LastLatency = latency
+	if latency > s.stats.MaxLatency {
+		s.stats.MaxLatency = latency
+	}
+	s.stats.MeanLatency = s.total / time.Duration(s.stats.Moves+s.stats.Stops)
+	s.mu.Unlock()
+	if s.options.OnCommand != nil {
+		s.options.OnCommand(command, latency, err)
+	}
+}
@@ -0,0 +1,55 @@
+package ptz
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSession(t *testing.T) {
+	camera := &ptzCamera{}
+	c, err := NewController(context.Background(), camera, "profile", "ptz0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	camera.calls, camera.latency = nil, time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := c.Session(ctx, SessionOptions{Interval: 20 * time.Millisecond, Timeout: 200 * time.Millisecond})
+	// a joystick held steady after a burst of updates
+	for i := 0; i < 100; i++ {
+		pan := 0.5
+		if i < 50 {
+			pan = float64(i) / 100
+		}
+		s.Move(pan, 0, 0)
+		time.Sleep(5 * time.Millisecond)
+	}
+	// the input stops: dead man
+	time.Sleep(400 * time.Millisecond)
+	stats := s.Stats()
+	if stats.Moves < 3 || stats.Moves > 30 {
+		t.Errorf("sent %d moves for 100 updates", stats.Moves)
+	}
+	if stats.Stops != 1 {
+		t.Errorf("sent %d stops after the input stopped, want 1", stats.Stops)
+	}
+
+	s.Move(0, 0.5, 0)
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	s.Close()
+	stats = s.Stats()
+	if stats.Stops != 2 || camera.calls[len(camera.calls)-1] != "Stop" {
+		t.Errorf("no stop on cancel: %+v %v", stats, camera.calls)
+	}
+	if stats.Moves+stats.Stops != len(camera.calls) || stats.Errors != 0 {
+		t.Errorf("stats %+v for %d calls", stats, len(camera.calls))
+	}
+	if stats.MaxLatency < time.Millisecond || stats.MeanLatency < time.Millisecond || stats.MeanLatency > stats.MaxLatency {
+		t.Errorf("latency %+v", stats)
+	}
+	if camera.timeout != "PT0.2S" {
+		t.Errorf("ContinuousMove timeout %s", camera.timeout)
+	}
+}
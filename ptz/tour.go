@@ -0,0 +1,347 @@
+package ptz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/sonnt85/gonvif/internal/rollback"
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Preset tour operations and states
+const (
+	TourStart  = "Start"
+	TourStop   = "Stop"
+	TourPause  = "Pause"
+	TourIdle   = "Idle"
+	TourActive = "Touring"
+	TourPaused = "Paused"
+)
+
+// TourSpot is a stop of a preset tour
+type TourSpot struct {
+	// Preset is the name of the preset, Home the home position
+	Preset string
+	Home   bool
+	// StayTime is the time spent at the spot, the device default when 0
+	StayTime time.Duration
+	// Speed is the speed of the generic speed spaces, the default when 0
+	Speed float64
+}
+
+// TourOptionError is returned when a tour does not match GetPresetTourOptions
+type TourOptionError struct {
+	// Spot is the index of the spot, -1 for the tour settings
+	Spot      int
+	Field     string
+	Value     string
+	Supported string
+}
+
+func (err *TourOptionError) Error() string {
+	where := "preset tour"
+	if err.Spot >= 0 {
+		where += " spot " + strconv.Itoa(err.Spot)
+	}
+	return fmt.Sprintf("%s: %s %s is not supported, supported: %s", where, err.Field, err.Value, err.Supported)
+}
+
+// TourBuilder composes a preset tour of a Controller: it resolves the preset
+// names, validates the tour against GetPresetTourOptions then creates or
+// modifies it
+type TourBuilder struct {
+	controller        *Controller
+	name              string
+	token             onvif.ReferenceToken
+	spots             []TourSpot
+	autoStart         bool
+	random            bool
+	direction         string
+	recurringTime     int
+	recurringDuration time.Duration
+}
+
+// NewTourBuilder returns a builder of a preset tour called name
+func NewTourBuilder(controller *Controller, name string) *TourBuilder {
+	return &TourBuilder{controller: controller, name: name}
+}
+
+// WithToken modifies the existing tour token instead of creating one
+func (builder *TourBuilder) WithToken(token onvif.ReferenceToken) *TourBuilder {
+	builder.token = token
+	return builder
+}
+
+// Spot adds a stop at the preset name for stayTime
+func (builder *TourBuilder) Spot(name string, stayTime time.Duration) *TourBuilder {
+	return builder.AddSpot(TourSpot{Preset: name, StayTime: stayTime})
+}
+
+// Home adds a stop at the home position for stayTime
+func (builder *TourBuilder) Home(stayTime time.Duration) *TourBuilder {
+	return builder.AddSpot(TourSpot{Home: true, StayTime: stayTime})
+}
+
+// AddSpot adds a stop
+func (builder *TourBuilder) AddSpot(spot TourSpot) *TourBuilder {
+	builder.spots = append(builder.spots, spot)
+	return builder
+}
+
+// AutoStart starts the tour when the device boots
+func (builder *TourBuilder) AutoStart(autoStart bool) *TourBuilder {
+	builder.autoStart = autoStart
+	return builder
+}
+
+// Random visits the spots in a random order
+func (builder *TourBuilder) Random(random bool) *TourBuilder {
+	builder.random = random
+	return builder
+}
+
+// Direction is Forward or Backward
+func (builder *TourBuilder) Direction(direction string) *TourBuilder {
+	builder.direction = direction
+	return builder
+}
+
+// Recurring repeats the tour times times or for duration, 0 for the defaults
+func (builder *TourBuilder) Recurring(times int, duration time.Duration) *TourBuilder {
+	builder.recurringTime, builder.recurringDuration = times, duration
+	return builder
+}
+
+// Build creates, or modifies with WithToken, the tour and returns its token.
+// A created tour is removed again when it cannot be modified
+func (builder *TourBuilder) Build(ctx context.Context) (onvif.ReferenceToken, error) {
+	c := builder.controller
+	tour, err := builder.tour(ctx)
+	if err != nil {
+		return "", err
+	}
+	var options GetPresetTourOptionsResponse
+	request := GetPresetTourOptions{ProfileToken: c.Profile, PresetTourToken: builder.token}
+	if err := c.caller.CallMethodUnmarshal(ctx, request, &options); err != nil {
+		return "", err
+	}
+	if err := c.ValidateTour(tour, options.Options); err != nil {
+		return "", err
+	}
+
+	if builder.token != "" {
+		return builder.token, c.caller.CallMethodUnmarshal(ctx, ModifyPresetTour{ProfileToken: c.Profile, PresetTour: tour}, &ModifyPresetTourResponse{})
+	}
+	if max := c.Node.Extension.SupportedPresetTour.MaximumNumberOfPresetTours; max > 0 {
+		var tours GetPresetToursResponse
+		if err := c.caller.CallMethodUnmarshal(ctx, GetPresetTours{ProfileToken: c.Profile}, &tours); err != nil {
+			return "", err
+		}
+		if len(tours.PresetTour) >= max {
+			return "", fmt.Errorf("maximum number of preset tours reached (%d)", max)
+		}
+	}
+	var created CreatePresetTourResponse
+	if err := c.caller.CallMethodUnmarshal(ctx, CreatePresetTour{ProfileToken: c.Profile}, &created); err != nil {
+		return "", err
+	}
+	tour.Token = created.PresetTourToken
+	if err := c.caller.CallMethodUnmarshal(ctx, ModifyPresetTour{ProfileToken: c.Profile, PresetTour: tour}, &ModifyPresetTourResponse{}); err != nil {
+		rollbackCtx, cancel := rollback.Context()
+		defer cancel()
+		if rollbackErr := c.RemoveTour(rollbackCtx, created.PresetTourToken); rollbackErr != nil {
+			return "", fmt.Errorf("%v (rollback failed: %v)", err, rollbackErr)
+		}
+		return "", err
+	}
+	return created.PresetTourToken, nil
+}
+
+// tour returns the tour described by the builder with the preset tokens
+func (builder *TourBuilder) tour(ctx context.Context) (onvif.PresetTour, error) {
+	c := builder.controller
+	tour := onvif.PresetTour{
+		Token:     builder.token,
+		Name:      onvif.Name(builder.name),
+		Status:    onvif.PTZPresetTourStatus{State: TourIdle},
+		AutoStart: xsd.Boolean(builder.autoStart),
+		StartingCondition: onvif.PTZPresetTourStartingCondition{
+			RandomPresetOrder: xsd.Boolean(builder.random),
+			RecurringTime:     xsd.Int(builder.recurringTime),
+			Direction:         onvif.PTZPresetTourDirection(builder.direction),
+		},
+	}
+	if builder.recurringDuration > 0 {
+		tour.StartingCondition.RecurringDuration = xsd.NewDuration(builder.recurringDuration)
+	}
+	for _, spot := range builder.spots {
+		tourSpot := onvif.PTZPresetTourSpot{}
+		if spot.Speed != 0 {
+			// validated against the speed spaces instead of clamped
+			tourSpot.Speed = genericSpeed(spot.Speed, spot.Speed)
+		}
+		if spot.StayTime > 0 {
+			tourSpot.StayTime = xsd.NewDuration(spot.StayTime)
+		}
+		if spot.Home {
+			home := xsd.Boolean(true)
+			tourSpot.PresetDetail.Home = &home
+		} else {
+			token, err := c.PresetToken(ctx, spot.Preset)
+			if err != nil {
+				return tour, err
+			}
+			tourSpot.PresetDetail.PresetToken = token
+		}
+		tour.TourSpot = append(tour.TourSpot, tourSpot)
+	}
+	return tour, nil
+}
+
+// ValidateTour checks a tour against the options of the profile and the
+// speed spaces of the node
+func (c *Controller) ValidateTour(tour onvif.PresetTour, options onvif.PTZPresetTourOptions) error {
+	if len(tour.TourSpot) == 0 {
+		return fmt.Errorf("preset tour without spot")
+	}
+	if tour.AutoStart && !options.AutoStart {
+		return &TourOptionError{Spot: -1, Field: "AutoStart", Value: "true", Supported: "false"}
+	}
+	condition, conditionOptions := tour.StartingCondition, options.StartingCondition
+	if r := conditionOptions.RecurringTime; condition.RecurringTime != 0 && r.Max > 0 &&
+		(int(condition.RecurringTime) < r.Min || int(condition.RecurringTime) > r.Max) {
+		return &TourOptionError{Spot: -1, Field: "RecurringTime", Value: strconv.Itoa(int(condition.RecurringTime)),
+			Supported: fmt.Sprintf("%d-%d", r.Min, r.Max)}
+	}
+	if err := checkDuration(-1, "RecurringDuration", condition.RecurringDuration, conditionOptions.RecurringDuration); err != nil {
+		return err
+	}
+	if direction := condition.Direction; direction != "" && len(conditionOptions.Direction) != 0 {
+		supported := false
+		for _, d := range conditionOptions.Direction {
+			supported = supported || d == direction
+		}
+		if !supported {
+			return &TourOptionError{Spot: -1, Field: "Direction", Value: string(direction), Supported: fmt.Sprint(conditionOptions.Direction)}
+		}
+	}
+
+	spotOptions := options.TourSpot
+	panTiltSpeed, _ := space1D(c.Node.SupportedPTZSpaces.PanTiltSpeedSpace, GenericSpeedSpace)
+	zoomSpeed, _ := space1D(c.Node.SupportedPTZSpaces.ZoomSpeedSpace, ZoomGenericSpeedSpace)
+	for i, spot := range tour.TourSpot {
+		detail := spot.PresetDetail
+		switch {
+		case detail.Home != nil && bool(*detail.Home):
+			if !spotOptions.PresetDetail.Home {
+				return &TourOptionError{Spot: i, Field: "Home", Value: "true", Supported: "false"}
+			}
+		case detail.PresetToken != "" && len(spotOptions.PresetDetail.PresetToken) != 0:
+			supported := false
+			for _, token := range spotOptions.PresetDetail.PresetToken {
+				supported = supported || token == detail.PresetToken
+			}
+			if !supported {
+				return &TourOptionError{Spot: i, Field: "PresetToken", Value: string(detail.PresetToken),
+					Supported: fmt.Sprint(spotOptions.PresetDetail.PresetToken)}
+			}
+		}
+		if err := checkDuration(i, "StayTime", spot.StayTime, spotOptions.StayTime); err != nil {
+			return err
+		}
+		if spot.Speed != nil {
+			if pt := spot.Speed.PanTilt; pt != nil && clampRange(pt.X, panTiltSpeed.XRange) != pt.X {
+				return &TourOptionError{Spot: i, Field: "Speed", Value: strconv.FormatFloat(pt.X, 'g', -1, 64),
+					Supported: fmt.Sprintf("%g-%g", panTiltSpeed.XRange.Min, panTiltSpeed.XRange.Max)}
+			}
+			if zoom := spot.Speed.Zoom; zoom != nil && clampRange(zoom.X, zoomSpeed.XRange) != zoom.X {
+				return &TourOptionError{Spot: i, Field: "ZoomSpeed", Value: strconv.FormatFloat(zoom.X, 'g', -1, 64),
+					Supported: fmt.Sprintf("%g-%g", zoomSpeed.XRange.Min, zoomSpeed.XRange.Max)}
+			}
+		}
+	}
+	return nil
+}
+
+// checkDuration checks a duration against a range, unset or unparsable
+// bounds do not apply
+func checkDuration(spot int, field string, value xsd.Duration, r onvif.DurationRange) error {
+	if value == "" {
+		return nil
+	}
+	d, err := value.TimeDuration()
+	if err != nil {
+		return err
+	}
+	min, minErr := r.Min.TimeDuration()
+	max, maxErr := r.Max.TimeDuration()
+	if r.Min != "" && minErr == nil && d < min || r.Max != "" && maxErr == nil && max > 0 && d > max {
+		return &TourOptionError{Spot: spot, Field: field, Value: d.String(), Supported: fmt.Sprintf("%s-%s", r.Min, r.Max)}
+	}
+	return nil
+}
+
+// Tours returns the preset tours of the profile
+func (c *Controller) Tours(ctx context.Context) ([]onvif.PresetTour, error) {
+	var response GetPresetToursResponse
+	if err := c.caller.CallMethodUnmarshal(ctx, GetPresetTours{ProfileToken: c.Profile}, &response); err != nil {
+		return nil, err
+	}
+	return response.PresetTour, nil
+}
+
+// TourStatus returns the status of the tour token
+func (c *Controller) TourStatus(ctx context.Context, token onvif.ReferenceToken) (onvif.PTZPresetTourStatus, error) {
+	var response GetPresetTourResponse
+	if err := c.caller.CallMethodUnmarshal(ctx, GetPresetTour{ProfileToken: c.Profile, PresetTourToken: token}, &response); err != nil {
+		return onvif.PTZPresetTourStatus{}, err
+	}
+	return response.PresetTour.Status, nil
+}
+
+// StartTour starts the tour token and waits until the device reports it touring
+func (c *Controller) StartTour(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.operateTour(ctx, token, TourStart, TourActive)
+}
+
+// StopTour stops the tour token and waits until the device reports it idle
+func (c *Controller) StopTour(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.operateTour(ctx, token, TourStop, TourIdle)
+}
+
+// PauseTour pauses the tour token and waits until the device reports it paused
+func (c *Controller) PauseTour(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.operateTour(ctx, token, TourPause, TourPaused)
+}
+
+// RemoveTour removes the tour token
+func (c *Controller) RemoveTour(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.caller.CallMethodUnmarshal(ctx, RemovePresetTour{ProfileToken: c.Profile, PresetTourToken: token}, &RemovePresetTourResponse{})
+}
+
+// operateTour sends operation then polls the tour status until it is state
+func (c *Controller) operateTour(ctx context.Context, token onvif.ReferenceToken, operation, state string) error {
+	request := OperatePresetTour{ProfileToken: c.Profile, PresetTourToken: token, Operation: onvif.PTZPresetTourOperation(operation)}
+	if err := c.caller.CallMethodUnmarshal(ctx, request, &OperatePresetTourResponse{}); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(c.pollInterval())
+	defer ticker.Stop()
+	for {
+		status, err := c.TourStatus(ctx, token)
+		if err != nil {
+			return err
+		}
+		if string(status.State) == state {
+			return nil
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return fmt.Errorf("preset tour %s still %s after %s: %v", token, status.State, operation, ctx.Err())
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package ptz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+func TestPresets(t *testing.T) {
+	ctx := context.Background()
+	camera := &ptzCamera{presets: []onvif.PTZPreset{{Token: "preset0", Name: "gate"}}}
+	c := &Controller{Profile: "profile", caller: camera}
+
+	if token, err := c.SavePreset(ctx, "gate"); err != nil || token != "preset0" {
+		t.Errorf("overwrite gate = %s, %v", token, err)
+	}
+	if token, err := c.SavePreset(ctx, "parking"); err != nil || token != "preset1" || len(camera.presets) != 2 {
+		t.Errorf("save parking = %s, %v", token, err)
+	}
+	if err := c.GotoPreset(ctx, "parking", 0.5); err != nil {
+		t.Error(err)
+	}
+	if err := c.RemovePreset(ctx, "gate"); err != nil || len(camera.presets) != 1 {
+		t.Errorf("remove gate: %v", err)
+	}
+	var notFound *PresetNotFoundError
+	if err := c.GotoPreset(ctx, "gate", 0); !errors.As(err, &notFound) {
+		t.Errorf("goto removed preset: %v", err)
+	}
+}
+
+func TestTours(t *testing.T) {
+	ctx := context.Background()
+	camera := &ptzCamera{
+		presets: []onvif.PTZPreset{{Token: "preset0", Name: "gate"}, {Token: "preset1", Name: "parking"}},
+		tours:   map[onvif.ReferenceToken]*onvif.PresetTour{},
+	}
+	c := &Controller{Profile: "profile", caller: camera, PollInterval: time.Millisecond}
+	c.Node.SupportedPTZSpaces.PanTiltSpeedSpace = []onvif.Space1DDescription{{URI: GenericSpeedSpace, XRange: onvif.FloatRange{Max: 1}}}
+
+	var optionErr *TourOptionError
+	_, err := NewTourBuilder(c, "night").Spot("gate", 2*time.Second).Build(ctx)
+	if !errors.As(err, &optionErr) || optionErr.Field != "StayTime" || optionErr.Spot != 0 {
+		t.Errorf("short stay: %v", err)
+	}
+	_, err = NewTourBuilder(c, "night").Spot("gate", 10*time.Second).Direction("Backward").Build(ctx)
+	if !errors.As(err, &optionErr) || optionErr.Field != "Direction" {
+		t.Errorf("backward: %v", err)
+	}
+	_, err = NewTourBuilder(c, "night").Spot("gate", 10*time.Second).AddSpot(TourSpot{Preset: "parking", Speed: 2}).Build(ctx)
+	if !errors.As(err, &optionErr) || optionErr.Field != "Speed" || optionErr.Spot != 1 {
+		t.Errorf("fast spot: %v", err)
+	}
+	camera.failModify = true
+	if _, err := NewTourBuilder(c, "night").Spot("gate", 10*time.Second).Build(ctx); err == nil || len(camera.tours) != 0 {
+		t.Errorf("refused tour not rolled back: %v, %d tours", err, len(camera.tours))
+	}
+	camera.failModify = false
+
+	token, err := NewTourBuilder(c, "night").Spot("gate", 10*time.Second).AddSpot(TourSpot{Preset: "parking", Speed: 0.5}).Build(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tour := camera.tours[token]
+	if tour.Name != "night" || len(tour.TourSpot) != 2 || tour.TourSpot[1].PresetDetail.PresetToken != "preset1" ||
+		tour.TourSpot[0].StayTime != "PT10S" || tour.TourSpot[1].Speed.PanTilt.X != 0.5 {
+		t.Errorf("unexpected tour %+v", tour)
+	}
+
+	if err := c.StartTour(ctx, token); err != nil || camera.polls != 2 {
+		t.Errorf("start: %v after %d polls", err, camera.polls)
+	}
+	if err := c.PauseTour(ctx, token); err != nil {
+		t.Error(err)
+	}
+	if err := c.StopTour(ctx, token); err != nil {
+		t.Error(err)
+	}
+	if status, err := c.TourStatus(ctx, token); err != nil || status.State != TourIdle {
+		t.Errorf("status %+v, %v", status, err)
+	}
+}
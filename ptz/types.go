@@ -28,7 +28,7 @@ type GetNodes struct {
 }
 
 type GetNodesResponse struct {
-	PTZNode onvif.PTZNode
+	PTZNode []onvif.PTZNode
 }
 
 type GetNode struct {
@@ -41,8 +41,8 @@ type GetNodeResponse struct {
 }
 
 type GetConfiguration struct {
-	XMLName      string               `xml:"tptz:GetConfiguration"`
-	ProfileToken onvif.ReferenceToken `xml:"tptz:ProfileToken"`
+	XMLName               string               `xml:"tptz:GetConfiguration"`
+	PTZConfigurationToken onvif.ReferenceToken `xml:"tptz:PTZConfigurationToken"`
 }
 
 type GetConfigurationResponse struct {
@@ -54,7 +54,7 @@ type GetConfigurations struct {
 }
 
 type GetConfigurationsResponse struct {
-	PTZConfiguration onvif.PTZConfiguration
+	PTZConfiguration []onvif.PTZConfiguration
 }
 
 type SetConfiguration struct {
@@ -67,8 +67,8 @@ type SetConfigurationResponse struct {
 }
 
 type GetConfigurationOptions struct {
-	XMLName      string               `xml:"tptz:GetConfigurationOptions"`
-	ProfileToken onvif.ReferenceToken `xml:"tptz:ProfileToken"`
+	XMLName            string               `xml:"tptz:GetConfigurationOptions"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tptz:ConfigurationToken"`
 }
 
 type GetConfigurationOptionsResponse struct {
@@ -91,14 +91,14 @@ type GetPresets struct {
 }
 
 type GetPresetsResponse struct {
-	Preset onvif.PTZPreset
+	Preset []onvif.PTZPreset
 }
 
 type SetPreset struct {
 	XMLName      string               `xml:"tptz:SetPreset"`
 	ProfileToken onvif.ReferenceToken `xml:"tptz:ProfileToken"`
-	PresetName   xsd.String           `xml:"tptz:PresetName"`
-	PresetToken  onvif.ReferenceToken `xml:"tptz:PresetToken"`
+	PresetName   xsd.String           `xml:"tptz:PresetName,omitempty"`
+	PresetToken  onvif.ReferenceToken `xml:"tptz:PresetToken,omitempty"`
 }
 
 type SetPresetResponse struct {
@@ -118,7 +118,7 @@ type GotoPreset struct {
 	XMLName      string               `xml:"tptz:GotoPreset"`
 	ProfileToken onvif.ReferenceToken `xml:"tptz:ProfileToken"`
 	PresetToken  onvif.ReferenceToken `xml:"tptz:PresetToken"`
-	Speed        onvif.PTZSpeed       `xml:"tptz:Speed"`
+	Speed        *onvif.PTZSpeed      `xml:"tptz:Speed,omitempty"`
 }
 
 type GotoPresetResponse struct {
@@ -127,7 +127,7 @@ type GotoPresetResponse struct {
 type GotoHomePosition struct {
 	XMLName      string               `xml:"tptz:GotoHomePosition"`
 	ProfileToken onvif.ReferenceToken `xml:"tptz:ProfileToken"`
-	Speed        onvif.PTZSpeed       `xml:"tptz:Speed"`
+	Speed        *onvif.PTZSpeed      `xml:"tptz:Speed,omitempty"`
 }
 
 type GotoHomePositionResponse struct {
@@ -145,7 +145,7 @@ type ContinuousMove struct {
 	XMLName      string               `xml:"tptz:ContinuousMove"`
 	ProfileToken onvif.ReferenceToken `xml:"tptz:ProfileToken"`
 	Velocity     onvif.PTZSpeed       `xml:"tptz:Velocity"`
-	Timeout      xsd.Duration         `xml:"tptz:Timeout"`
+	Timeout      xsd.Duration         `xml:"tptz:Timeout,omitempty"`
 }
 
 type ContinuousMoveResponse struct {
@@ -155,7 +155,7 @@ type RelativeMove struct {
 	XMLName      string               `xml:"tptz:RelativeMove"`
 	ProfileToken onvif.ReferenceToken `xml:"tptz:ProfileToken"`
 	Translation  onvif.PTZVector      `xml:"tptz:Translation"`
-	Speed        onvif.PTZSpeed       `xml:"tptz:Speed"`
+	Speed        *onvif.PTZSpeed      `xml:"tptz:Speed,omitempty"`
 }
 
 type RelativeMoveResponse struct {
@@ -174,7 +174,7 @@ type AbsoluteMove struct {
 	XMLName      string               `xml:"tptz:AbsoluteMove"`
 	ProfileToken onvif.ReferenceToken `xml:"tptz:ProfileToken"`
 	Position     onvif.PTZVector      `xml:"tptz:Position"`
-	Speed        onvif.PTZSpeed       `xml:"tptz:Speed"`
+	Speed        *onvif.PTZSpeed      `xml:"tptz:Speed,omitempty"`
 }
 
 type AbsoluteMoveResponse struct {
@@ -184,9 +184,9 @@ type GeoMove struct {
 	XMLName      string               `xml:"tptz:GeoMove"`
 	ProfileToken onvif.ReferenceToken `xml:"tptz:ProfileToken"`
 	Target       onvif.GeoLocation    `xml:"tptz:Target"`
-	Speed        onvif.PTZSpeed       `xml:"tptz:Speed"`
-	AreaHeight   xsd.Float            `xml:"tptz:AreaHeight"`
-	AreaWidth    xsd.Float            `xml:"tptz:AreaWidth"`
+	Speed        *onvif.PTZSpeed      `xml:"tptz:Speed,omitempty"`
+	AreaHeight   xsd.Float            `xml:"tptz:AreaHeight,omitempty"`
+	AreaWidth    xsd.Float            `xml:"tptz:AreaWidth,omitempty"`
 }
 
 type GeoMoveResponse struct {
@@ -195,8 +195,8 @@ type GeoMoveResponse struct {
 type Stop struct {
 	XMLName      string               `xml:"tptz:Stop"`
 	ProfileToken onvif.ReferenceToken `xml:"tptz:ProfileToken"`
-	PanTilt      xsd.Boolean          `xml:"tptz:PanTilt"`
-	Zoom         xsd.Boolean          `xml:"tptz:Zoom"`
+	PanTilt      *xsd.Boolean         `xml:"tptz:PanTilt,omitempty"`
+	Zoom         *xsd.Boolean         `xml:"tptz:Zoom,omitempty"`
 }
 
 type StopResponse struct {
@@ -208,7 +208,7 @@ type GetPresetTours struct {
 }
 
 type GetPresetToursResponse struct {
-	PresetTour onvif.PresetTour
+	PresetTour []onvif.PresetTour
 }
 
 type GetPresetTour struct {
@@ -224,7 +224,7 @@ type GetPresetTourResponse struct {
 type GetPresetTourOptions struct {
 	XMLName         string               `xml:"tptz:GetPresetTourOptions"`
 	ProfileToken    onvif.ReferenceToken `xml:"tptz:ProfileToken"`
-	PresetTourToken onvif.ReferenceToken `xml:"tptz:PresetTourToken"`
+	PresetTourToken onvif.ReferenceToken `xml:"tptz:PresetTourToken,omitempty"`
 }
 
 type GetPresetTourOptionsResponse struct {
@@ -252,8 +252,8 @@ type ModifyPresetTourResponse struct {
 type OperatePresetTour struct {
 	XMLName         string                       `xml:"tptz:OperatePresetTour"`
 	ProfileToken    onvif.ReferenceToken         `xml:"tptz:ProfileToken"`
-	PresetTourToken onvif.ReferenceToken         `xml:"onvif:PresetTourToken"`
-	Operation       onvif.PTZPresetTourOperation `xml:"onvif:Operation"`
+	PresetTourToken onvif.ReferenceToken         `xml:"tptz:PresetTourToken"`
+	Operation       onvif.PTZPresetTourOperation `xml:"tptz:Operation"`
 }
 
 type OperatePresetTourResponse struct {
@@ -274,5 +274,5 @@ type GetCompatibleConfigurations struct {
 }
 
 type GetCompatibleConfigurationsResponse struct {
-	PTZConfiguration onvif.PTZConfiguration
+	PTZConfiguration []onvif.PTZConfiguration
 }
@@ -0,0 +1,31 @@
+package gonvif
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sonnt85/gonvif/ptz"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Device drives PTZ nodes with ptz.NewController
+var _ ptz.Caller = Device{}
+
+// PTZController returns the ptz.Controller of the PTZ configuration of the
+// media profile profileToken
+func (dev Device) PTZController(ctx context.Context, profileToken onvif.ReferenceToken) (*ptz.Controller, error) {
+	profiles, err := dev.GetMediaProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, profile := range profiles {
+		if profile.Token != profileToken {
+			continue
+		}
+		if profile.PTZ == nil {
+			return nil, fmt.Errorf("profile %s has no PTZ configuration", profileToken)
+		}
+		return ptz.NewController(ctx, dev, profileToken, profile.PTZ.Token)
+	}
+	return nil, fmt.Errorf("profile %s not found", profileToken)
+}
@@ -0,0 +1,110 @@
+// Code generated by gonvif-gen from receiver.wsdl. DO NOT EDIT.
+
+package receiver
+
+import (
+	"github.com/sonnt85/gonvif/gosoap"
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Namespace of the receiver service
+const Namespace = "http://www.onvif.org/ver10/receiver/wsdl"
+
+// Prefix of the receiver service elements
+const Prefix = "trv"
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"trv:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type Capabilities struct {
+	RTPMulticast         xsd.Boolean `xml:"RTP_Multicast,attr,omitempty"`
+	RTPTCP               xsd.Boolean `xml:"RTP_TCP,attr,omitempty"`
+	RTPRTSPTCP           xsd.Boolean `xml:"RTP_RTSP_TCP,attr,omitempty"`
+	SupportedReceivers   xsd.Int     `xml:"SupportedReceivers,attr"`
+	MaximumRTSPURILength xsd.Int     `xml:"MaximumRTSPURILength,attr,omitempty"`
+}
+
+type GetReceivers struct {
+	XMLName string `xml:"trv:GetReceivers"`
+}
+
+type GetReceiversResponse struct {
+	Receivers []xsd.AnyType
+}
+
+type GetReceiver struct {
+	XMLName       string               `xml:"trv:GetReceiver"`
+	ReceiverToken onvif.ReferenceToken `xml:"trv:ReceiverToken"`
+}
+
+type GetReceiverResponse struct {
+	Receiver xsd.AnyType
+}
+
+type CreateReceiver struct {
+	XMLName       string      `xml:"trv:CreateReceiver"`
+	Configuration xsd.AnyType `xml:"trv:Configuration"`
+}
+
+type CreateReceiverResponse struct {
+	Receiver xsd.AnyType
+}
+
+type DeleteReceiver struct {
+	XMLName       string               `xml:"trv:DeleteReceiver"`
+	ReceiverToken onvif.ReferenceToken `xml:"trv:ReceiverToken"`
+}
+
+type DeleteReceiverResponse struct {
+}
+
+type ConfigureReceiver struct {
+	XMLName       string               `xml:"trv:ConfigureReceiver"`
+	ReceiverToken onvif.ReferenceToken `xml:"trv:ReceiverToken"`
+	Configuration xsd.AnyType          `xml:"trv:Configuration"`
+}
+
+type ConfigureReceiverResponse struct {
+}
+
+type SetReceiverMode struct {
+	XMLName       string               `xml:"trv:SetReceiverMode"`
+	ReceiverToken onvif.ReferenceToken `xml:"trv:ReceiverToken"`
+	Mode          xsd.AnyType          `xml:"trv:Mode"`
+}
+
+type SetReceiverModeResponse struct {
+}
+
+type GetReceiverState struct {
+	XMLName       string               `xml:"trv:GetReceiverState"`
+	ReceiverToken onvif.ReferenceToken `xml:"trv:ReceiverToken"`
+}
+
+type GetReceiverStateResponse struct {
+	ReceiverState xsd.AnyType
+}
+
+// Actions maps the request elements of the service to their WS-Addressing action
+var Actions = map[string]string{
+	"trv:GetServiceCapabilities": "http://www.onvif.org/ver10/receiver/wsdl/GetServiceCapabilities",
+	"trv:GetReceivers":           "http://www.onvif.org/ver10/receiver/wsdl/GetReceivers",
+	"trv:GetReceiver":            "http://www.onvif.org/ver10/receiver/wsdl/GetReceiver",
+	"trv:CreateReceiver":         "http://www.onvif.org/ver10/receiver/wsdl/CreateReceiver",
+	"trv:DeleteReceiver":         "http://www.onvif.org/ver10/receiver/wsdl/DeleteReceiver",
+	"trv:ConfigureReceiver":      "http://www.onvif.org/ver10/receiver/wsdl/ConfigureReceiver",
+	"trv:SetReceiverMode":        "http://www.onvif.org/ver10/receiver/wsdl/SetReceiverMode",
+	"trv:GetReceiverState":       "http://www.onvif.org/ver10/receiver/wsdl/GetReceiverState",
+}
+
+func init() {
+	for tag, action := range Actions {
+		gosoap.RegisterAction(tag, action)
+	}
+}
@@ -0,0 +1,279 @@
+// Code generated by gonvif-gen from recording.wsdl. DO NOT EDIT.
+
+package recording
+
+import (
+	"github.com/sonnt85/gonvif/gosoap"
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Namespace of the recording service
+const Namespace = "http://www.onvif.org/ver10/recording/wsdl"
+
+// Prefix of the recording service elements
+const Prefix = "trc"
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"trc:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type Capabilities struct {
+	DynamicRecordings          xsd.Boolean          `xml:"DynamicRecordings,attr,omitempty"`
+	DynamicTracks              xsd.Boolean          `xml:"DynamicTracks,attr,omitempty"`
+	Encoding                   EncodingTypes        `xml:"Encoding,attr,omitempty"`
+	MaxRate                    xsd.Float            `xml:"MaxRate,attr,omitempty"`
+	MaxTotalRate               xsd.Float            `xml:"MaxTotalRate,attr,omitempty"`
+	MaxRecordings              xsd.Float            `xml:"MaxRecordings,attr,omitempty"`
+	MaxRecordingJobs           xsd.Int              `xml:"MaxRecordingJobs,attr,omitempty"`
+	Options                    xsd.Boolean          `xml:"Options,attr,omitempty"`
+	MetadataRecording          xsd.Boolean          `xml:"MetadataRecording,attr,omitempty"`
+	SupportedExportFileFormats onvif.StringAttrList `xml:"SupportedExportFileFormats,attr,omitempty"`
+}
+
+// The value is a space separated list.
+type EncodingTypes xsd.String
+
+type CreateRecording struct {
+	XMLName                string      `xml:"trc:CreateRecording"`
+	RecordingConfiguration xsd.AnyType `xml:"trc:RecordingConfiguration"`
+}
+
+type CreateRecordingResponse struct {
+	RecordingToken xsd.AnyType
+}
+
+type DeleteRecording struct {
+	XMLName        string      `xml:"trc:DeleteRecording"`
+	RecordingToken xsd.AnyType `xml:"trc:RecordingToken"`
+}
+
+type DeleteRecordingResponse struct {
+}
+
+type GetRecordings struct {
+	XMLName string `xml:"trc:GetRecordings"`
+}
+
+type GetRecordingsResponse struct {
+	RecordingItem []xsd.AnyType
+}
+
+type SetRecordingConfiguration struct {
+	XMLName                string      `xml:"trc:SetRecordingConfiguration"`
+	RecordingToken         xsd.AnyType `xml:"trc:RecordingToken"`
+	RecordingConfiguration xsd.AnyType `xml:"trc:RecordingConfiguration"`
+}
+
+type SetRecordingConfigurationResponse struct {
+}
+
+type GetRecordingConfiguration struct {
+	XMLName        string      `xml:"trc:GetRecordingConfiguration"`
+	RecordingToken xsd.AnyType `xml:"trc:RecordingToken"`
+}
+
+type GetRecordingConfigurationResponse struct {
+	RecordingConfiguration xsd.AnyType
+}
+
+type CreateTrack struct {
+	XMLName            string      `xml:"trc:CreateTrack"`
+	RecordingToken     xsd.AnyType `xml:"trc:RecordingToken"`
+	TrackConfiguration xsd.AnyType `xml:"trc:TrackConfiguration"`
+}
+
+type CreateTrackResponse struct {
+	TrackToken xsd.AnyType
+}
+
+type DeleteTrack struct {
+	XMLName        string      `xml:"trc:DeleteTrack"`
+	RecordingToken xsd.AnyType `xml:"trc:RecordingToken"`
+	TrackToken     xsd.AnyType `xml:"trc:TrackToken"`
+}
+
+type DeleteTrackResponse struct {
+}
+
+type GetTrackConfiguration struct {
+	XMLName        string      `xml:"trc:GetTrackConfiguration"`
+	RecordingToken xsd.AnyType `xml:"trc:RecordingToken"`
+	TrackToken     xsd.AnyType `xml:"trc:TrackToken"`
+}
+
+type GetTrackConfigurationResponse struct {
+	TrackConfiguration xsd.AnyType
+}
+
+type SetTrackConfiguration struct {
+	XMLName            string      `xml:"trc:SetTrackConfiguration"`
+	RecordingToken     xsd.AnyType `xml:"trc:RecordingToken"`
+	TrackToken         xsd.AnyType `xml:"trc:TrackToken"`
+	TrackConfiguration xsd.AnyType `xml:"trc:TrackConfiguration"`
+}
+
+type SetTrackConfigurationResponse struct {
+}
+
+type CreateRecordingJob struct {
+	XMLName          string      `xml:"trc:CreateRecordingJob"`
+	JobConfiguration xsd.AnyType `xml:"trc:JobConfiguration"`
+}
+
+type CreateRecordingJobResponse struct {
+	JobToken         xsd.AnyType
+	JobConfiguration xsd.AnyType
+}
+
+type DeleteRecordingJob struct {
+	XMLName  string      `xml:"trc:DeleteRecordingJob"`
+	JobToken xsd.AnyType `xml:"trc:JobToken"`
+}
+
+type DeleteRecordingJobResponse struct {
+}
+
+type GetRecordingJobs struct {
+	XMLName string `xml:"trc:GetRecordingJobs"`
+}
+
+type GetRecordingJobsResponse struct {
+	JobItem []xsd.AnyType
+}
+
+type SetRecordingJobConfiguration struct {
+	XMLName          string      `xml:"trc:SetRecordingJobConfiguration"`
+	JobToken         xsd.AnyType `xml:"trc:JobToken"`
+	JobConfiguration xsd.AnyType `xml:"trc:JobConfiguration"`
+}
+
+type SetRecordingJobConfigurationResponse struct {
+	JobConfiguration xsd.AnyType
+}
+
+type GetRecordingJobConfiguration struct {
+	XMLName  string      `xml:"trc:GetRecordingJobConfiguration"`
+	JobToken xsd.AnyType `xml:"trc:JobToken"`
+}
+
+type GetRecordingJobConfigurationResponse struct {
+	JobConfiguration xsd.AnyType
+}
+
+type SetRecordingJobMode struct {
+	XMLName  string      `xml:"trc:SetRecordingJobMode"`
+	JobToken xsd.AnyType `xml:"trc:JobToken"`
+	Mode     xsd.AnyType `xml:"trc:Mode"`
+}
+
+type SetRecordingJobModeResponse struct {
+}
+
+type GetRecordingJobState struct {
+	XMLName  string      `xml:"trc:GetRecordingJobState"`
+	JobToken xsd.AnyType `xml:"trc:JobToken"`
+}
+
+type GetRecordingJobStateResponse struct {
+	State xsd.AnyType
+}
+
+type GetRecordingOptions struct {
+	XMLName        string      `xml:"trc:GetRecordingOptions"`
+	RecordingToken xsd.AnyType `xml:"trc:RecordingToken"`
+}
+
+type GetRecordingOptionsResponse struct {
+	Options RecordingOptions
+}
+
+type ExportRecordedData struct {
+	XMLName            string       `xml:"trc:ExportRecordedData"`
+	StartPoint         xsd.DateTime `xml:"trc:StartPoint,omitempty"`
+	EndPoint           xsd.DateTime `xml:"trc:EndPoint,omitempty"`
+	SearchScope        xsd.AnyType  `xml:"trc:SearchScope"`
+	FileFormat         xsd.String   `xml:"trc:FileFormat"`
+	StorageDestination xsd.AnyType  `xml:"trc:StorageDestination"`
+}
+
+type ExportRecordedDataResponse struct {
+	OperationToken onvif.ReferenceToken
+	FileNames      []xsd.String
+	Extension      *ExportRecordedDataResponseExtension
+}
+
+type StopExportRecordedData struct {
+	XMLName        string               `xml:"trc:StopExportRecordedData"`
+	OperationToken onvif.ReferenceToken `xml:"trc:OperationToken"`
+}
+
+type StopExportRecordedDataResponse struct {
+	Progress           xsd.Float
+	FileProgressStatus xsd.AnyType
+}
+
+type GetExportRecordedDataState struct {
+	XMLName        string               `xml:"trc:GetExportRecordedDataState"`
+	OperationToken onvif.ReferenceToken `xml:"trc:OperationToken"`
+}
+
+type GetExportRecordedDataStateResponse struct {
+	Progress           xsd.Float
+	FileProgressStatus xsd.AnyType
+}
+
+type RecordingOptions struct {
+	Job   JobOptions   `xml:"trc:Job"`
+	Track TrackOptions `xml:"trc:Track"`
+}
+
+type JobOptions struct {
+	Spare             xsd.Int              `xml:"Spare,attr,omitempty"`
+	CompatibleSources onvif.StringAttrList `xml:"CompatibleSources,attr,omitempty"`
+}
+
+type TrackOptions struct {
+	SpareTotal    xsd.Int `xml:"SpareTotal,attr,omitempty"`
+	SpareVideo    xsd.Int `xml:"SpareVideo,attr,omitempty"`
+	SpareAudio    xsd.Int `xml:"SpareAudio,attr,omitempty"`
+	SpareMetadata xsd.Int `xml:"SpareMetadata,attr,omitempty"`
+}
+
+type ExportRecordedDataResponseExtension struct {
+}
+
+// Actions maps the request elements of the service to their WS-Addressing action
+var Actions = map[string]string{
+	"trc:GetServiceCapabilities":       "http://www.onvif.org/ver10/recording/wsdl/GetServiceCapabilities",
+	"trc:CreateRecording":              "http://www.onvif.org/ver10/recording/wsdl/CreateRecording",
+	"trc:DeleteRecording":              "http://www.onvif.org/ver10/recording/wsdl/DeleteRecording",
+	"trc:GetRecordings":                "http://www.onvif.org/ver10/recording/wsdl/GetRecordings",
+	"trc:SetRecordingConfiguration":    "http://www.onvif.org/ver10/recording/wsdl/SetRecordingConfiguration",
+	"trc:GetRecordingConfiguration":    "http://www.onvif.org/ver10/recording/wsdl/GetRecordingConfiguration",
+	"trc:GetRecordingOptions":          "http://www.onvif.org/ver10/recording/wsdl/GetRecordingOptions",
+	"trc:CreateTrack":                  "http://www.onvif.org/ver10/recording/wsdl/CreateTrack",
+	"trc:DeleteTrack":                  "http://www.onvif.org/ver10/recording/wsdl/DeleteTrack",
+	"trc:GetTrackConfiguration":        "http://www.onvif.org/ver10/recording/wsdl/GetTrackConfiguration",
+	"trc:SetTrackConfiguration":        "http://www.onvif.org/ver10/recording/wsdl/SetTrackConfiguration",
+	"trc:CreateRecordingJob":           "http://www.onvif.org/ver10/recording/wsdl/CreateRecordingJob",
+	"trc:DeleteRecordingJob":           "http://www.onvif.org/ver10/recording/wsdl/DeleteRecordingJob",
+	"trc:GetRecordingJobs":             "http://www.onvif.org/ver10/recording/wsdl/GetRecordingJobs",
+	"trc:SetRecordingJobConfiguration": "http://www.onvif.org/ver10/recording/wsdl/SetRecordingJobConfiguration",
+	"trc:GetRecordingJobConfiguration": "http://www.onvif.org/ver10/recording/wsdl/GetRecordingJobConfiguration",
+	"trc:SetRecordingJobMode":          "http://www.onvif.org/ver10/recording/wsdl/SetRecordingJobMode",
+	"trc:GetRecordingJobState":         "http://www.onvif.org/ver10/recording/wsdl/GetRecordingJobState",
+	"trc:ExportRecordedData":           "http://www.onvif.org/ver10/recording/wsdl/ExportRecordedData",
+	"trc:StopExportRecordedData":       "http://www.onvif.org/ver10/recording/wsdl/StopExportRecordedData",
+	"trc:GetExportRecordedDataState":   "http://www.onvif.org/ver10/recording/wsdl/GetExportRecordedDataState",
+}
+
+func init() {
+	for tag, action := range Actions {
+		gosoap.RegisterAction(tag, action)
+	}
+}
@@ -0,0 +1,70 @@
+// Code generated by gonvif-gen from replay.wsdl. DO NOT EDIT.
+
+package replay
+
+import (
+	"github.com/sonnt85/gonvif/gosoap"
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Namespace of the replay service
+const Namespace = "http://www.onvif.org/ver10/replay/wsdl"
+
+// Prefix of the replay service elements
+const Prefix = "trp"
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"trp:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type Capabilities struct {
+	ReversePlayback     xsd.Boolean         `xml:"ReversePlayback,attr,omitempty"`
+	SessionTimeoutRange onvif.FloatAttrList `xml:"SessionTimeoutRange,attr,omitempty"`
+	RTPRTSPTCP          xsd.Boolean         `xml:"RTP_RTSP_TCP,attr,omitempty"`
+	RTSPWebSocketUri    xsd.AnyURI          `xml:"RTSPWebSocketUri,attr,omitempty"`
+}
+
+type GetReplayUri struct {
+	XMLName        string               `xml:"trp:GetReplayUri"`
+	StreamSetup    onvif.StreamSetup    `xml:"trp:StreamSetup"`
+	RecordingToken onvif.ReferenceToken `xml:"trp:RecordingToken"`
+}
+
+type GetReplayUriResponse struct {
+	Uri xsd.AnyURI
+}
+
+type SetReplayConfiguration struct {
+	XMLName       string      `xml:"trp:SetReplayConfiguration"`
+	Configuration xsd.AnyType `xml:"trp:Configuration"`
+}
+
+type SetReplayConfigurationResponse struct {
+}
+
+type GetReplayConfiguration struct {
+	XMLName string `xml:"trp:GetReplayConfiguration"`
+}
+
+type GetReplayConfigurationResponse struct {
+	Configuration xsd.AnyType
+}
+
+// Actions maps the request elements of the service to their WS-Addressing action
+var Actions = map[string]string{
+	"trp:GetServiceCapabilities": "http://www.onvif.org/ver10/replay/wsdl/GetServiceCapabilities",
+	"trp:GetReplayUri":           "http://www.onvif.org/ver10/replay/wsdl/GetReplayUri",
+	"trp:GetReplayConfiguration": "http://www.onvif.org/ver10/replay/wsdl/GetReplayConfiguration",
+	"trp:SetReplayConfiguration": "http://www.onvif.org/ver10/replay/wsdl/SetReplayConfiguration",
+}
+
+func init() {
+	for tag, action := range Actions {
+		gosoap.RegisterAction(tag, action)
+	}
+}
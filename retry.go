@@ -0,0 +1,130 @@
+package gonvif
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy replays SOAP calls that failed because of the network
+// (connection reset, timeout) or because the device answered 503.
+// Only idempotent operations are replayed, state changing operations
+// (Set*, Create*, SystemReboot...) are never sent twice unless Idempotent
+// explicitly allows them
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one
+	MaxAttempts int
+	// InitialBackoff is the delay before the second attempt
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts
+	MaxBackoff time.Duration
+	// Multiplier grows the delay after every attempt, 2 when unset
+	Multiplier float64
+	// Jitter randomizes every delay by +/- Jitter (0..1) of its value
+	Jitter float64
+	// Idempotent reports whether an operation may be replayed,
+	// IsIdempotentOperation when nil
+	Idempotent func(operation string) bool
+}
+
+// DefaultRetryPolicy returns a policy suited to cameras on lossy links:
+// 3 attempts, 200ms initial backoff doubled up to 2s, 20% jitter
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// IsIdempotentOperation reports whether an operation only reads device
+// state (Get*, Pull*) and can be replayed safely
+func IsIdempotentOperation(operation string) bool {
+	return strings.HasPrefix(operation, "Get") || strings.HasPrefix(operation, "Pull")
+}
+
+// SetRetryPolicy replaces the retry policy of the device, nil disables retries
+func (dev *Device) SetRetryPolicy(policy *RetryPolicy) {
+	dev.params.Retry = policy
+}
+
+// shouldRetry decides whether the attempt'th call of operation is replayed and after which delay
+func (policy *RetryPolicy) shouldRetry(ctx context.Context, operation string, attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	if policy == nil || attempt >= policy.MaxAttempts || ctx.Err() != nil {
+		return 0, false
+	}
+	idempotent := policy.Idempotent
+	if idempotent == nil {
+		idempotent = IsIdempotentOperation
+	}
+	if !idempotent(operation) {
+		return 0, false
+	}
+
+	delay := policy.backoff(attempt)
+	switch {
+	case err != nil:
+		if !isRetryableError(err) {
+			return 0, false
+		}
+	case resp.StatusCode == http.StatusServiceUnavailable:
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			if after := time.Duration(seconds) * time.Second; after > delay {
+				delay = after
+			}
+			if policy.MaxBackoff > 0 && delay > policy.MaxBackoff {
+				delay = policy.MaxBackoff
+			}
+		}
+	default:
+		return 0, false
+	}
+	return delay, true
+}
+
+var (
+	jitterMu   sync.Mutex
+	jitterRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// backoff returns the delay after the attempt'th failure
+func (policy *RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := policy.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+	delay := float64(policy.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if policy.MaxBackoff > 0 && delay > float64(policy.MaxBackoff) {
+		delay = float64(policy.MaxBackoff)
+	}
+	if policy.Jitter > 0 {
+		jitterMu.Lock()
+		delay *= 1 + policy.Jitter*(2*jitterRand.Float64()-1)
+		jitterMu.Unlock()
+	}
+	return time.Duration(delay)
+}
+
+// isRetryableError reports transport errors worth a second attempt
+func isRetryableError(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,159 @@
+package gonvif
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/sonnt85/gonvif/device"
+	"github.com/sonnt85/gonvif/media"
+)
+
+func TestIsIdempotentOperation(t *testing.T) {
+	tests := map[string]bool{
+		"GetProfiles":                  true,
+		"GetStatus":                    true,
+		"PullMessages":                 true,
+		"SetVideoEncoderConfiguration": false,
+		"CreateUsers":                  false,
+		"CreatePullPointSubscription":  false,
+		"SystemReboot":                 false,
+		"AbsoluteMove":                 false,
+		"Renew":                        false,
+	}
+	for operation, want := range tests {
+		if got := IsIdempotentOperation(operation); got != want {
+			t.Errorf("IsIdempotentOperation(%s) = %v", operation, got)
+		}
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestShouldRetry(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 3, InitialBackoff: 100 * time.Millisecond, MaxBackoff: 2 * time.Second}
+	status := func(code int, retryAfter string) *http.Response {
+		resp := &http.Response{StatusCode: code, Header: http.Header{}}
+		if retryAfter != "" {
+			resp.Header.Set("Retry-After", retryAfter)
+		}
+		return resp
+	}
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name      string
+		policy    *RetryPolicy
+		ctx       context.Context
+		operation string
+		attempt   int
+		resp      *http.Response
+		err       error
+		delay     time.Duration
+		retry     bool
+	}{
+		{name: "get reset", operation: "GetProfiles", attempt: 1, err: syscall.ECONNRESET, delay: 100 * time.Millisecond, retry: true},
+		{name: "pull timeout", operation: "PullMessages", attempt: 2, err: timeoutError{}, delay: 200 * time.Millisecond, retry: true},
+		{name: "get 503", operation: "GetStatus", attempt: 1, resp: status(503, ""), delay: 100 * time.Millisecond, retry: true},
+		{name: "get 503 retry after", operation: "GetStatus", attempt: 1, resp: status(503, "1"), delay: time.Second, retry: true},
+		{name: "retry after capped", operation: "GetStatus", attempt: 1, resp: status(503, "60"), delay: 2 * time.Second, retry: true},
+		{name: "get 500", operation: "GetProfiles", attempt: 1, resp: status(500, "")},
+		{name: "get ok", operation: "GetProfiles", attempt: 1, resp: status(200, "")},
+		{name: "set reset", operation: "SetVideoEncoderConfiguration", attempt: 1, err: syscall.ECONNRESET},
+		{name: "create 503", operation: "CreateUsers", attempt: 1, resp: status(503, "")},
+		{name: "reboot timeout", operation: "SystemReboot", attempt: 1, err: timeoutError{}},
+		{name: "other error", operation: "GetProfiles", attempt: 1, err: errors.New("bad certificate")},
+		{name: "max attempts", operation: "GetProfiles", attempt: 3, err: syscall.ECONNRESET},
+		{name: "canceled", ctx: canceled, operation: "GetProfiles", attempt: 1, err: syscall.ECONNRESET},
+		{
+			name:      "idempotent override",
+			policy:    &RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond, Idempotent: func(string) bool { return true }},
+			operation: "SetPreset", attempt: 1, err: io.EOF, delay: time.Millisecond, retry: true,
+		},
+	}
+	for _, test := range tests {
+		p, ctx := policy, test.ctx
+		if test.policy != nil {
+			p = test.policy
+		}
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		delay, retry := p.shouldRetry(ctx, test.operation, test.attempt, test.resp, test.err)
+		if delay != test.delay || retry != test.retry {
+			t.Errorf("%s: shouldRetry = %s, %v, want %s, %v", test.name, delay, retry, test.delay, test.retry)
+		}
+	}
+	if _, retry := (*RetryPolicy)(nil).shouldRetry(context.Background(), "GetProfiles", 1, nil, syscall.ECONNRESET); retry {
+		t.Error("retried without a policy")
+	}
+}
+
+// unavailableCamera answers GetCapabilities and 503 to every other call
+func unavailableCamera(calls *int32) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(data), "GetCapabilities") {
+			io.WriteString(w, strings.Replace(envelope, "%s", `<tds:GetCapabilitiesResponse><tds:Capabilities>
+				<tt:Media><tt:XAddr>`+server.URL+`/onvif/media_service</tt:XAddr></tt:Media>
+			</tds:Capabilities></tds:GetCapabilitiesResponse>`, 1))
+			return
+		}
+		atomic.AddInt32(calls, 1)
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	return server
+}
+
+func TestRetry(t *testing.T) {
+	var calls int32
+	server := unavailableCamera(&calls)
+	defer server.Close()
+
+	dev, err := NewDevice(DeviceParams{Xaddr: strings.TrimPrefix(server.URL, "http://"), EndpointPolicy: EndpointKeepAdvertised,
+		Retry: &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	atomic.StoreInt32(&calls, 0)
+	resp, err := dev.CallMethod(media.GetProfiles{})
+	if err != nil || resp.StatusCode != http.StatusServiceUnavailable || atomic.LoadInt32(&calls) != 3 {
+		t.Errorf("GetProfiles: %v after %d calls", err, calls)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	if _, err := dev.CallMethod(media.SetVideoEncoderConfiguration{}); err != nil || atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("SetVideoEncoderConfiguration: %v after %d calls", err, calls)
+	}
+	atomic.StoreInt32(&calls, 0)
+	if _, err := dev.CallMethod(device.SystemReboot{}); err != nil || atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("SystemReboot: %v after %d calls", err, calls)
+	}
+
+	// the Retry-After delay is cut short by the context
+	dev.SetRetryPolicy(&RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Minute, MaxBackoff: time.Minute})
+	atomic.StoreInt32(&calls, 0)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := dev.CallMethodContext(ctx, media.GetProfiles{}); err != context.DeadlineExceeded || time.Since(start) > 5*time.Second {
+		t.Errorf("GetProfiles with a deadline: %v after %s", err, time.Since(start))
+	}
+	if calls := atomic.LoadInt32(&calls); calls != 1 {
+		t.Errorf("GetProfiles with a deadline: %d calls", calls)
+	}
+}
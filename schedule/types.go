@@ -0,0 +1,265 @@
+// Code generated by gonvif-gen from schedule.wsdl. DO NOT EDIT.
+
+package schedule
+
+import (
+	"github.com/sonnt85/gonvif/gosoap"
+	"github.com/sonnt85/gonvif/xsd"
+)
+
+// Namespace of the schedule service
+const Namespace = "http://www.onvif.org/ver10/schedule/wsdl"
+
+// Prefix of the schedule service elements
+const Prefix = "tsc"
+
+// ServiceCapabilities the service capabilities reflect optional functionality of a service
+type ServiceCapabilities struct {
+	MaxLimit                    xsd.AnyType `xml:"MaxLimit,attr"`
+	MaxSchedules                xsd.AnyType `xml:"MaxSchedules,attr"`
+	MaxTimePeriodsPerDay        xsd.AnyType `xml:"MaxTimePeriodsPerDay,attr"`
+	MaxSpecialDayGroups         xsd.AnyType `xml:"MaxSpecialDayGroups,attr"`
+	MaxDaysInSpecialDayGroup    xsd.AnyType `xml:"MaxDaysInSpecialDayGroup,attr"`
+	MaxSpecialDaysSchedules     xsd.AnyType `xml:"MaxSpecialDaysSchedules,attr"`
+	ExtendedRecurrenceSupported xsd.Boolean `xml:"ExtendedRecurrenceSupported,attr"`
+	SpecialDaysSupported        xsd.Boolean `xml:"SpecialDaysSupported,attr"`
+	StateReportingSupported     xsd.Boolean `xml:"StateReportingSupported,attr"`
+}
+
+// ScheduleInfo the ScheduleInfo type represents the schedule as a physical object
+type ScheduleInfo struct {
+	xsd.AnyType
+	Name        xsd.AnyType `xml:"tsc:Name"`
+	Description xsd.AnyType `xml:"tsc:Description,omitempty"`
+}
+
+// Schedule the schedule structure shall include all properties of the ScheduleInfo structure and also the standard events (iCalendar format) and a list of SpecialDaysSchedule instances
+type Schedule struct {
+	ScheduleInfo
+	Standard    xsd.String            `xml:"tsc:Standard"`
+	SpecialDays []SpecialDaysSchedule `xml:"tsc:SpecialDays"`
+	Extension   *ScheduleExtension    `xml:"tsc:Extension,omitempty"`
+}
+
+type ScheduleExtension struct {
+}
+
+// SpecialDaysSchedule a override schedule that defines alternate time periods for a group of special days
+type SpecialDaysSchedule struct {
+	GroupToken xsd.AnyType                   `xml:"tsc:GroupToken"`
+	TimeRange  []TimePeriod                  `xml:"tsc:TimeRange"`
+	Extension  *SpecialDaysScheduleExtension `xml:"tsc:Extension,omitempty"`
+}
+
+type SpecialDaysScheduleExtension struct {
+}
+
+// ScheduleState the ScheduleState contains state information for a schedule
+type ScheduleState struct {
+	Active     xsd.Boolean             `xml:"tsc:Active"`
+	SpecialDay *xsd.Boolean            `xml:"tsc:SpecialDay,omitempty"`
+	Extension  *ScheduleStateExtension `xml:"tsc:Extension,omitempty"`
+}
+
+type ScheduleStateExtension struct {
+}
+
+// TimePeriod a time period defines a start and end time
+type TimePeriod struct {
+	From      xsd.Time             `xml:"tsc:From"`
+	Until     xsd.Time             `xml:"tsc:Until,omitempty"`
+	Extension *TimePeriodExtension `xml:"tsc:Extension,omitempty"`
+}
+
+type TimePeriodExtension struct {
+}
+
+// SpecialDayGroupInfo the SpecialDayGroupInfo structure contains the basic information about the special days list
+type SpecialDayGroupInfo struct {
+	xsd.AnyType
+	Name        xsd.AnyType `xml:"tsc:Name"`
+	Description xsd.AnyType `xml:"tsc:Description,omitempty"`
+}
+
+// SpecialDayGroup the special day group structure shall include all properties of the SpecialDayGroupInfo structure and also a set of special days
+type SpecialDayGroup struct {
+	SpecialDayGroupInfo
+	Days      xsd.String                `xml:"tsc:Days,omitempty"`
+	Extension *SpecialDayGroupExtension `xml:"tsc:Extension,omitempty"`
+}
+
+type SpecialDayGroupExtension struct {
+}
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tsc:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities ServiceCapabilities
+}
+
+type GetScheduleState struct {
+	XMLName string      `xml:"tsc:GetScheduleState"`
+	Token   xsd.AnyType `xml:"tsc:Token"`
+}
+
+type GetScheduleStateResponse struct {
+	ScheduleState ScheduleState
+}
+
+type GetScheduleInfo struct {
+	XMLName string        `xml:"tsc:GetScheduleInfo"`
+	Token   []xsd.AnyType `xml:"tsc:Token"`
+}
+
+type GetScheduleInfoResponse struct {
+	ScheduleInfo []ScheduleInfo
+}
+
+type GetScheduleInfoList struct {
+	XMLName        string     `xml:"tsc:GetScheduleInfoList"`
+	Limit          xsd.Int    `xml:"tsc:Limit,omitempty"`
+	StartReference xsd.String `xml:"tsc:StartReference,omitempty"`
+}
+
+type GetScheduleInfoListResponse struct {
+	NextStartReference xsd.String
+	ScheduleInfo       []ScheduleInfo
+}
+
+type GetSchedules struct {
+	XMLName string        `xml:"tsc:GetSchedules"`
+	Token   []xsd.AnyType `xml:"tsc:Token"`
+}
+
+type GetSchedulesResponse struct {
+	Schedule []Schedule
+}
+
+type GetScheduleList struct {
+	XMLName        string     `xml:"tsc:GetScheduleList"`
+	Limit          xsd.Int    `xml:"tsc:Limit,omitempty"`
+	StartReference xsd.String `xml:"tsc:StartReference,omitempty"`
+}
+
+type GetScheduleListResponse struct {
+	NextStartReference xsd.String
+	Schedule           []Schedule
+}
+
+type CreateSchedule struct {
+	XMLName  string   `xml:"tsc:CreateSchedule"`
+	Schedule Schedule `xml:"tsc:Schedule"`
+}
+
+type CreateScheduleResponse struct {
+	Token xsd.AnyType
+}
+
+type ModifySchedule struct {
+	XMLName  string   `xml:"tsc:ModifySchedule"`
+	Schedule Schedule `xml:"tsc:Schedule"`
+}
+
+type ModifyScheduleResponse struct {
+}
+
+type DeleteSchedule struct {
+	XMLName string      `xml:"tsc:DeleteSchedule"`
+	Token   xsd.AnyType `xml:"tsc:Token"`
+}
+
+type DeleteScheduleResponse struct {
+}
+
+type GetSpecialDayGroupInfo struct {
+	XMLName string        `xml:"tsc:GetSpecialDayGroupInfo"`
+	Token   []xsd.AnyType `xml:"tsc:Token"`
+}
+
+type GetSpecialDayGroupInfoResponse struct {
+	SpecialDayGroupInfo []SpecialDayGroupInfo
+}
+
+type GetSpecialDayGroupInfoList struct {
+	XMLName        string     `xml:"tsc:GetSpecialDayGroupInfoList"`
+	Limit          xsd.Int    `xml:"tsc:Limit,omitempty"`
+	StartReference xsd.String `xml:"tsc:StartReference,omitempty"`
+}
+
+type GetSpecialDayGroupInfoListResponse struct {
+	NextStartReference  xsd.String
+	SpecialDayGroupInfo []SpecialDayGroupInfo
+}
+
+type GetSpecialDayGroups struct {
+	XMLName string        `xml:"tsc:GetSpecialDayGroups"`
+	Token   []xsd.AnyType `xml:"tsc:Token"`
+}
+
+type GetSpecialDayGroupsResponse struct {
+	SpecialDayGroup []SpecialDayGroup
+}
+
+type GetSpecialDayGroupList struct {
+	XMLName        string     `xml:"tsc:GetSpecialDayGroupList"`
+	Limit          xsd.Int    `xml:"tsc:Limit,omitempty"`
+	StartReference xsd.String `xml:"tsc:StartReference,omitempty"`
+}
+
+type GetSpecialDayGroupListResponse struct {
+	NextStartReference xsd.String
+	SpecialDayGroup    []SpecialDayGroup
+}
+
+type CreateSpecialDayGroup struct {
+	XMLName         string          `xml:"tsc:CreateSpecialDayGroup"`
+	SpecialDayGroup SpecialDayGroup `xml:"tsc:SpecialDayGroup"`
+}
+
+type CreateSpecialDayGroupResponse struct {
+	Token xsd.AnyType
+}
+
+type ModifySpecialDayGroup struct {
+	XMLName         string          `xml:"tsc:ModifySpecialDayGroup"`
+	SpecialDayGroup SpecialDayGroup `xml:"tsc:SpecialDayGroup"`
+}
+
+type ModifySpecialDayGroupResponse struct {
+}
+
+type DeleteSpecialDayGroup struct {
+	XMLName string      `xml:"tsc:DeleteSpecialDayGroup"`
+	Token   xsd.AnyType `xml:"tsc:Token"`
+}
+
+type DeleteSpecialDayGroupResponse struct {
+}
+
+// Actions maps the request elements of the service to their WS-Addressing action
+var Actions = map[string]string{
+	"tsc:GetServiceCapabilities":     "http://www.onvif.org/ver10/schedule/wsdl/GetServiceCapabilities",
+	"tsc:GetScheduleState":           "http://www.onvif.org/ver10/schedule/wsdl/GetScheduleState",
+	"tsc:GetScheduleInfo":            "http://www.onvif.org/ver10/schedule/wsdl/GetScheduleInfo",
+	"tsc:GetScheduleInfoList":        "http://www.onvif.org/ver10/schedule/wsdl/GetScheduleInfoList",
+	"tsc:GetSchedules":               "http://www.onvif.org/ver10/schedule/wsdl/GetSchedules",
+	"tsc:GetScheduleList":            "http://www.onvif.org/ver10/schedule/wsdl/GetScheduleList",
+	"tsc:CreateSchedule":             "http://www.onvif.org/ver10/schedule/wsdl/CreateSchedule",
+	"tsc:ModifySchedule":             "http://www.onvif.org/ver10/schedule/wsdl/ModifySchedule",
+	"tsc:DeleteSchedule":             "http://www.onvif.org/ver10/schedule/wsdl/DeleteSchedule",
+	"tsc:GetSpecialDayGroupInfo":     "http://www.onvif.org/ver10/schedule/wsdl/GetSpecialDayGroupInfo",
+	"tsc:GetSpecialDayGroupInfoList": "http://www.onvif.org/ver10/schedule/wsdl/GetSpecialDayGroupInfoList",
+	"tsc:GetSpecialDayGroups":        "http://www.onvif.org/ver10/schedule/wsdl/GetSpecialDayGroups",
+	"tsc:GetSpecialDayGroupList":     "http://www.onvif.org/ver10/schedule/wsdl/GetSpecialDayGroupList",
+	"tsc:CreateSpecialDayGroup":      "http://www.onvif.org/ver10/schedule/wsdl/CreateSpecialDayGroup",
+	"tsc:ModifySpecialDayGroup":      "http://www.onvif.org/ver10/schedule/wsdl/ModifySpecialDayGroup",
+	"tsc:DeleteSpecialDayGroup":      "http://www.onvif.org/ver10/schedule/wsdl/DeleteSpecialDayGroup",
+}
+
+func init() {
+	for tag, action := range Actions {
+		gosoap.RegisterAction(tag, action)
+	}
+}
@@ -0,0 +1,263 @@
+package gonvif
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/beevik/etree"
+)
+
+// schemaPackages maps the service packages to the WSDL documenting them
+var schemaPackages = map[string]string{
+	"device":    "devicemgmt.wsdl",
+	"media":     "media.wsdl",
+	"media2":    "media2.wsdl",
+	"ptz":       "ptz.wsdl",
+	"Imaging":   "imaging.wsdl",
+	"analytics": "analytics.wsdl",
+	"event":     "event.wsdl",
+}
+
+// schemaElement is a child element of a WSDL complex type
+type schemaElement struct {
+	Name      string
+	MinOccurs int
+	MaxOccurs int // -1 when unbounded
+}
+
+// goTypes indexes the type declarations of the parsed packages by "package.Name"
+type goTypes map[string]ast.Expr
+
+// TestStructsMatchSchema checks every request/response struct of the service
+// packages against the complex types of the bundled WSDLs: elements with
+// maxOccurs > 1 must be slices, optional structs must be pointers and
+// optional simple values of requests must be omitempty so they are not sent
+// when unset. The xsd/onvif types are only resolved, onvif.xsd is not bundled
+func TestStructsMatchSchema(t *testing.T) {
+	types := goTypes{}
+	for _, dir := range []string{"xsd", "xsd/onvif", "device", "media", "media2", "ptz", "Imaging", "analytics", "event"} {
+		types.parse(t, dir)
+	}
+
+	for dir, wsdl := range schemaPackages {
+		path := filepath.Join("docs", "wsdl", wsdl)
+		schema := parseSchema(t, path)
+		pkg := filepath.Base(dir)
+		checked := 0
+		for name, elements := range schema {
+			expr, ok := types[pkg+"."+name]
+			if !ok {
+				continue
+			}
+			st, ok := expr.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			checked++
+			// responses are only decoded, absent simple values are left empty
+			response := strings.HasSuffix(name, "Response")
+			for _, element := range elements {
+				where := pkg + "." + name + "." + element.Name
+				field := findField(st, element.Name)
+				if field == nil {
+					t.Errorf("%s: no field encodes the element", where)
+					continue
+				}
+				isSlice, isPointer := isSliceType(field.Type), isPointerType(field.Type)
+				if element.MaxOccurs != 1 && !isSlice {
+					t.Errorf("%s: maxOccurs %d needs a slice", where, element.MaxOccurs)
+				}
+				if element.MaxOccurs == 1 && isSlice && !isBytesType(field.Type) {
+					t.Errorf("%s: maxOccurs 1 must not be a slice", where)
+				}
+				if element.MinOccurs == 0 && !isSlice && !isPointer {
+					if types.isStruct(pkg, field.Type) {
+						t.Errorf("%s: minOccurs 0 struct needs a pointer, omitempty does not omit structs", where)
+					} else if !response && !hasOmitempty(field) {
+						t.Errorf("%s: minOccurs 0 needs a pointer or omitempty", where)
+					}
+				}
+			}
+		}
+		if checked == 0 {
+			t.Errorf("%s: no struct checked against %s", dir, path)
+		}
+	}
+}
+
+// parse adds the type declarations of the package in dir
+func (types goTypes) parse(t *testing.T, dir string) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pkg := range pkgs {
+		if strings.HasSuffix(pkg.Name, "_test") {
+			continue
+		}
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					spec := spec.(*ast.TypeSpec)
+					types[filepath.Base(dir)+"."+spec.Name.Name] = spec.Type
+				}
+			}
+		}
+	}
+}
+
+// resolve follows named types up to their underlying type expression
+func (types goTypes) resolve(pkg string, expr ast.Expr) (string, ast.Expr) {
+	for i := 0; i < 16; i++ {
+		var key string
+		switch e := expr.(type) {
+		case *ast.Ident:
+			key = pkg + "." + e.Name
+		case *ast.SelectorExpr:
+			if x, ok := e.X.(*ast.Ident); ok {
+				pkg = x.Name
+				key = pkg + "." + e.Sel.Name
+			}
+		}
+		next, ok := types[key]
+		if !ok {
+			return pkg, expr
+		}
+		expr = next
+	}
+	return pkg, expr
+}
+
+func (types goTypes) isStruct(pkg string, expr ast.Expr) bool {
+	_, expr = types.resolve(pkg, expr)
+	_, ok := expr.(*ast.StructType)
+	return ok
+}
+
+func isBytesType(expr ast.Expr) bool {
+	array, ok := expr.(*ast.ArrayType)
+	if !ok {
+		return false
+	}
+	ident, ok := array.Elt.(*ast.Ident)
+	return ok && ident.Name == "byte"
+}
+
+func isSliceType(expr ast.Expr) bool {
+	array, ok := expr.(*ast.ArrayType)
+	return ok && array.Len == nil
+}
+
+func isPointerType(expr ast.Expr) bool {
+	_, ok := expr.(*ast.StarExpr)
+	return ok
+}
+
+func xmlTag(field *ast.Field) string {
+	if field.Tag == nil {
+		return ""
+	}
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return ""
+	}
+	return reflect.StructTag(tag).Get("xml")
+}
+
+func hasOmitempty(field *ast.Field) bool {
+	return strings.Contains(xmlTag(field), ",omitempty")
+}
+
+// findField returns the field encoding the element name
+func findField(st *ast.StructType, name string) *ast.Field {
+	for _, field := range st.Fields.List {
+		tag := strings.Split(xmlTag(field), ",")
+		local := tag[0]
+		if i := strings.LastIndex(local, ":"); i >= 0 {
+			local = local[i+1:]
+		}
+		if local == "-" || len(tag) > 1 && (tag[1] == "attr" || tag[1] == "chardata" || tag[1] == "innerxml") {
+			continue
+		}
+		if local == name {
+			return field
+		}
+		for _, ident := range field.Names {
+			if local == "" && ident.Name == name {
+				return field
+			}
+		}
+	}
+	return nil
+}
+
+// parseSchema returns the child elements of the complex types and of the
+// elements with an anonymous complex type declared in the WSDL
+func parseSchema(t *testing.T, path string) map[string][]schemaElement {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromFile(path); err != nil {
+		t.Fatal(err)
+	}
+	schema := make(map[string][]schemaElement)
+	for _, s := range doc.FindElements("//types/schema") {
+		for _, child := range s.ChildElements() {
+			name := child.SelectAttrValue("name", "")
+			switch child.Tag {
+			case "element":
+				if complexType := child.SelectElement("complexType"); complexType != nil {
+					schema[name] = collectElements(complexType, false)
+				}
+			case "complexType":
+				schema[name] = collectElements(child, false)
+			}
+		}
+	}
+	return schema
+}
+
+// collectElements walks sequences, choices and extensions of a complex type.
+// Elements of a choice are optional
+func collectElements(parent *etree.Element, optional bool) []schemaElement {
+	var elements []schemaElement
+	for _, child := range parent.ChildElements() {
+		switch child.Tag {
+		case "element":
+			name := child.SelectAttrValue("name", "")
+			if name == "" {
+				name = child.SelectAttrValue("ref", "")
+				if i := strings.LastIndex(name, ":"); i >= 0 {
+					name = name[i+1:]
+				}
+			}
+			element := schemaElement{Name: name, MinOccurs: 1, MaxOccurs: 1}
+			if min, err := strconv.Atoi(child.SelectAttrValue("minOccurs", "1")); err == nil {
+				element.MinOccurs = min
+			}
+			switch max := child.SelectAttrValue("maxOccurs", "1"); max {
+			case "unbounded":
+				element.MaxOccurs = -1
+			default:
+				element.MaxOccurs, _ = strconv.Atoi(max)
+			}
+			if optional {
+				element.MinOccurs = 0
+			}
+			elements = append(elements, element)
+		case "choice":
+			elements = append(elements, collectElements(child, true)...)
+		case "sequence", "complexContent", "extension", "all":
+			elements = append(elements, collectElements(child, optional || child.SelectAttrValue("minOccurs", "1") == "0")...)
+		}
+	}
+	return elements
+}
@@ -0,0 +1,207 @@
+// Code generated by gonvif-gen from search.wsdl. DO NOT EDIT.
+
+package search
+
+import (
+	"github.com/sonnt85/gonvif/gosoap"
+	"github.com/sonnt85/gonvif/xsd"
+)
+
+// Namespace of the search service
+const Namespace = "http://www.onvif.org/ver10/search/wsdl"
+
+// Prefix of the search service elements
+const Prefix = "tse"
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tse:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type Capabilities struct {
+	MetadataSearch     xsd.Boolean `xml:"MetadataSearch,attr,omitempty"`
+	GeneralStartEvents xsd.Boolean `xml:"GeneralStartEvents,attr,omitempty"`
+}
+
+type GetRecordingSummary struct {
+	XMLName string `xml:"tse:GetRecordingSummary"`
+}
+
+type GetRecordingSummaryResponse struct {
+	Summary xsd.AnyType
+}
+
+type GetRecordingInformation struct {
+	XMLName        string      `xml:"tse:GetRecordingInformation"`
+	RecordingToken xsd.AnyType `xml:"tse:RecordingToken"`
+}
+
+type GetRecordingInformationResponse struct {
+	RecordingInformation xsd.AnyType
+}
+
+type GetMediaAttributes struct {
+	XMLName         string        `xml:"tse:GetMediaAttributes"`
+	RecordingTokens []xsd.AnyType `xml:"tse:RecordingTokens"`
+	Time            xsd.DateTime  `xml:"tse:Time"`
+}
+
+type GetMediaAttributesResponse struct {
+	MediaAttributes []xsd.AnyType
+}
+
+type FindRecordings struct {
+	XMLName       string       `xml:"tse:FindRecordings"`
+	Scope         xsd.AnyType  `xml:"tse:Scope"`
+	MaxMatches    xsd.Int      `xml:"tse:MaxMatches,omitempty"`
+	KeepAliveTime xsd.Duration `xml:"tse:KeepAliveTime"`
+}
+
+type FindRecordingsResponse struct {
+	SearchToken xsd.AnyType
+}
+
+// GetRecordingSearchResults gets results from a particular recording listingession
+type GetRecordingSearchResults struct {
+	XMLName     string       `xml:"tse:GetRecordingSearchResults"`
+	SearchToken xsd.AnyType  `xml:"tse:SearchToken"`
+	MinResults  xsd.Int      `xml:"tse:MinResults,omitempty"`
+	MaxResults  xsd.Int      `xml:"tse:MaxResults,omitempty"`
+	WaitTime    xsd.Duration `xml:"tse:WaitTime,omitempty"`
+}
+
+type GetRecordingSearchResultsResponse struct {
+	ResultList xsd.AnyType
+}
+
+// FindEvents starts a search session and specifies the search parameters
+type FindEvents struct {
+	XMLName           string       `xml:"tse:FindEvents"`
+	StartPoint        xsd.DateTime `xml:"tse:StartPoint"`
+	EndPoint          xsd.DateTime `xml:"tse:EndPoint,omitempty"`
+	Scope             xsd.AnyType  `xml:"tse:Scope"`
+	SearchFilter      xsd.AnyType  `xml:"tse:SearchFilter"`
+	IncludeStartState xsd.Boolean  `xml:"tse:IncludeStartState"`
+	MaxMatches        xsd.Int      `xml:"tse:MaxMatches,omitempty"`
+	KeepAliveTime     xsd.Duration `xml:"tse:KeepAliveTime"`
+}
+
+type FindEventsResponse struct {
+	SearchToken xsd.AnyType
+}
+
+// GetEventSearchResults gets results from a particular search session
+type GetEventSearchResults struct {
+	XMLName     string       `xml:"tse:GetEventSearchResults"`
+	SearchToken xsd.AnyType  `xml:"tse:SearchToken"`
+	MinResults  xsd.Int      `xml:"tse:MinResults,omitempty"`
+	MaxResults  xsd.Int      `xml:"tse:MaxResults,omitempty"`
+	WaitTime    xsd.Duration `xml:"tse:WaitTime,omitempty"`
+}
+
+type GetEventSearchResultsResponse struct {
+	ResultList xsd.AnyType
+}
+
+// FindPTZPosition starts a search session and specifies the search parameters
+type FindPTZPosition struct {
+	XMLName       string       `xml:"tse:FindPTZPosition"`
+	StartPoint    xsd.DateTime `xml:"tse:StartPoint"`
+	EndPoint      xsd.DateTime `xml:"tse:EndPoint,omitempty"`
+	Scope         xsd.AnyType  `xml:"tse:Scope"`
+	SearchFilter  xsd.AnyType  `xml:"tse:SearchFilter"`
+	MaxMatches    xsd.Int      `xml:"tse:MaxMatches,omitempty"`
+	KeepAliveTime xsd.Duration `xml:"tse:KeepAliveTime"`
+}
+
+type FindPTZPositionResponse struct {
+	SearchToken xsd.AnyType
+}
+
+// GetPTZPositionSearchResults gets results from a particular search session
+type GetPTZPositionSearchResults struct {
+	XMLName     string       `xml:"tse:GetPTZPositionSearchResults"`
+	SearchToken xsd.AnyType  `xml:"tse:SearchToken"`
+	MinResults  xsd.Int      `xml:"tse:MinResults,omitempty"`
+	MaxResults  xsd.Int      `xml:"tse:MaxResults,omitempty"`
+	WaitTime    xsd.Duration `xml:"tse:WaitTime,omitempty"`
+}
+
+type GetPTZPositionSearchResultsResponse struct {
+	ResultList xsd.AnyType
+}
+
+// FindMetadata starts a search session and specifies the search parameters
+type FindMetadata struct {
+	XMLName        string       `xml:"tse:FindMetadata"`
+	StartPoint     xsd.DateTime `xml:"tse:StartPoint"`
+	EndPoint       xsd.DateTime `xml:"tse:EndPoint,omitempty"`
+	Scope          xsd.AnyType  `xml:"tse:Scope"`
+	MetadataFilter xsd.AnyType  `xml:"tse:MetadataFilter"`
+	MaxMatches     xsd.Int      `xml:"tse:MaxMatches,omitempty"`
+	KeepAliveTime  xsd.Duration `xml:"tse:KeepAliveTime"`
+}
+
+type FindMetadataResponse struct {
+	SearchToken xsd.AnyType
+}
+
+// GetMetadataSearchResults gets results from a particular search session
+type GetMetadataSearchResults struct {
+	XMLName     string       `xml:"tse:GetMetadataSearchResults"`
+	SearchToken xsd.AnyType  `xml:"tse:SearchToken"`
+	MinResults  xsd.Int      `xml:"tse:MinResults,omitempty"`
+	MaxResults  xsd.Int      `xml:"tse:MaxResults,omitempty"`
+	WaitTime    xsd.Duration `xml:"tse:WaitTime,omitempty"`
+}
+
+type GetMetadataSearchResultsResponse struct {
+	ResultList xsd.AnyType
+}
+
+// GetSearchState returns the state of an ongoing search session
+type GetSearchState struct {
+	XMLName     string      `xml:"tse:GetSearchState"`
+	SearchToken xsd.AnyType `xml:"tse:SearchToken"`
+}
+
+type GetSearchStateResponse struct {
+	State xsd.AnyType
+}
+
+// EndSearch ends an ongoing search session, freeing any resources
+type EndSearch struct {
+	XMLName     string      `xml:"tse:EndSearch"`
+	SearchToken xsd.AnyType `xml:"tse:SearchToken"`
+}
+
+type EndSearchResponse struct {
+	Endpoint xsd.DateTime
+}
+
+// Actions maps the request elements of the service to their WS-Addressing action
+var Actions = map[string]string{
+	"tse:GetServiceCapabilities":      "http://www.onvif.org/ver10/search/wsdl/GetServiceCapabilities",
+	"tse:GetRecordingSummary":         "http://www.onvif.org/ver10/search/wsdl/GetRecordingSummary",
+	"tse:GetRecordingInformation":     "http://www.onvif.org/ver10/search/wsdl/GetRecordingInformation",
+	"tse:GetMediaAttributes":          "http://www.onvif.org/ver10/search/wsdl/GetMediaAttributes",
+	"tse:FindRecordings":              "http://www.onvif.org/ver10/search/wsdl/FindRecordings",
+	"tse:GetRecordingSearchResults":   "http://www.onvif.org/ver10/search/wsdl/GetRecordingSearchResults",
+	"tse:FindEvents":                  "http://www.onvif.org/ver10/search/wsdl/FindEvents",
+	"tse:GetEventSearchResults":       "http://www.onvif.org/ver10/search/wsdl/GetEventSearchResults",
+	"tse:FindPTZPosition":             "http://www.onvif.org/ver10/search/wsdl/FindPTZPosition",
+	"tse:GetPTZPositionSearchResults": "http://www.onvif.org/ver10/search/wsdl/GetPTZPositionSearchResults",
+	"tse:GetSearchState":              "http://www.onvif.org/ver10/search/wsdl/GetSearchState",
+	"tse:EndSearch":                   "http://www.onvif.org/ver10/search/wsdl/EndSearch",
+	"tse:FindMetadata":                "http://www.onvif.org/ver10/search/wsdl/FindMetadata",
+	"tse:GetMetadataSearchResults":    "http://www.onvif.org/ver10/search/wsdl/GetMetadataSearchResults",
+}
+
+func init() {
+	for tag, action := range Actions {
+		gosoap.RegisterAction(tag, action)
+	}
+}
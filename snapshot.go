@@ -0,0 +1,133 @@
+package gonvif
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sonnt85/gonvif/networking"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// ErrNotJPEG is returned by Snapshot when the device answers with something else than a JPEG image
+var ErrNotJPEG = errors.New("snapshot is not a JPEG image")
+
+// maxSnapshotSize bounds the image read from a device
+const maxSnapshotSize = 32 << 20
+
+// Snapshot is a JPEG image taken from a media profile
+type Snapshot struct {
+	ProfileToken onvif.ReferenceToken
+	URI          string
+	Image        []byte
+	ContentType  string
+	// Auth is the HTTP authentication accepted by the device: none, basic or digest
+	Auth string
+	// Taken is the time the image was received
+	Taken time.Time
+	// Resolve is the duration of GetSnapshotUri, Fetch the duration of the download
+	Resolve time.Duration
+	Fetch   time.Duration
+}
+
+// Snapshot resolves the snapshot URI of a profile and downloads the image,
+// authenticating with the device credentials (Digest or Basic, as requested
+// by the device)
+func (dev Device) Snapshot(ctx context.Context, profileToken onvif.ReferenceToken) (*Snapshot, error) {
+	start := time.Now()
+	uri, err := dev.GetMediaSnapshotUri(ctx, profileToken)
+	if err != nil {
+		return nil, err
+	}
+	if uri == "" {
+		return nil, errors.New("device returned an empty snapshot uri")
+	}
+	snapshot := &Snapshot{ProfileToken: profileToken, URI: uri, Resolve: time.Since(start)}
+
+	start = time.Now()
+	resp, auth, err := networking.GetWithAuth(ctx, dev.params.HttpClient, uri, dev.params.Username, dev.params.Password)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	snapshot.Auth = auth
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("snapshot %s: %s", uri, resp.Status)
+	}
+
+	image, err := io.ReadAll(io.LimitReader(resp.Body, maxSnapshotSize))
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Fetch = time.Since(start)
+	snapshot.Taken = time.Now()
+	snapshot.Image = image
+	snapshot.ContentType = resp.Header.Get("Content-Type")
+	if !isJPEG(snapshot.ContentType, image) {
+		return snapshot, ErrNotJPEG
+	}
+	return snapshot, nil
+}
+
+// isJPEG checks the content type of a snapshot, falling back to the JPEG
+// magic number for devices sending no or a generic content type
+func isJPEG(contentType string, image []byte) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "image/jpeg", "image/jpg", "image/pjpeg":
+		return len(image) > 0
+	case "", "application/octet-stream", "binary/octet-stream", "image/*":
+		return bytes.HasPrefix(image, []byte{0xFF, 0xD8, 0xFF})
+	}
+	return false
+}
+
+// SnapshotRequest names a profile of a device to take a snapshot from
+type SnapshotRequest struct {
+	Device       *Device
+	ProfileToken onvif.ReferenceToken
+}
+
+// SnapshotResult is the outcome of one SnapshotRequest
+type SnapshotResult struct {
+	SnapshotRequest
+	Snapshot *Snapshot
+	Err      error
+}
+
+// SnapshotAll takes the snapshots of requests with at most concurrency
+// downloads in flight (1 when concurrency < 1). Results are in the order of
+// requests, failures are reported per request
+func SnapshotAll(ctx context.Context, requests []SnapshotRequest, concurrency int) []SnapshotResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	results := make([]SnapshotResult, len(requests))
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range requests {
+		results[i].SnapshotRequest = requests[i]
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(result *SnapshotResult) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			result.Snapshot, result.Err = result.Device.Snapshot(ctx, result.ProfileToken)
+		}(&results[i])
+	}
+	wg.Wait()
+	return results
+}
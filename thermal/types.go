@@ -0,0 +1,206 @@
+// Code generated by gonvif-gen from thermal.wsdl. DO NOT EDIT.
+
+package thermal
+
+import (
+	"github.com/sonnt85/gonvif/gosoap"
+	"github.com/sonnt85/gonvif/xsd"
+	"github.com/sonnt85/gonvif/xsd/onvif"
+)
+
+// Namespace of the thermal service
+const Namespace = "http://www.onvif.org/ver10/thermal/wsdl"
+
+// Prefix of the thermal service elements
+const Prefix = "tth"
+
+type Polarity xsd.String
+
+const (
+	PolarityWhiteHot Polarity = "WhiteHot"
+	PolarityBlackHot Polarity = "BlackHot"
+)
+
+// ColorPaletteType describes standard Color Palette types, used to facilitate Multi-language support and client display
+type ColorPaletteType xsd.String
+
+const (
+	ColorPaletteTypeCustom    ColorPaletteType = "Custom"
+	ColorPaletteTypeGrayscale ColorPaletteType = "Grayscale"
+	ColorPaletteTypeBlackHot  ColorPaletteType = "BlackHot"
+	ColorPaletteTypeWhiteHot  ColorPaletteType = "WhiteHot"
+	ColorPaletteTypeSepia     ColorPaletteType = "Sepia"
+	ColorPaletteTypeRed       ColorPaletteType = "Red"
+	ColorPaletteTypeIron      ColorPaletteType = "Iron"
+	ColorPaletteTypeRain      ColorPaletteType = "Rain"
+	ColorPaletteTypeRainbow   ColorPaletteType = "Rainbow"
+	ColorPaletteTypeIsotherm  ColorPaletteType = "Isotherm"
+)
+
+// ColorPalette describes a Color Palette element
+type ColorPalette struct {
+	Name  onvif.Name           `xml:"tth:Name"`
+	Token onvif.ReferenceToken `xml:"token,attr"`
+	Type  xsd.String           `xml:"Type,attr"`
+}
+
+// NUCTable type describing a NUC Table element
+type NUCTable struct {
+	Name            onvif.Name           `xml:"tth:Name"`
+	Token           onvif.ReferenceToken `xml:"token,attr"`
+	LowTemperature  xsd.Float            `xml:"LowTemperature,attr,omitempty"`
+	HighTemperature xsd.Float            `xml:"HighTemperature,attr,omitempty"`
+}
+
+// Cooler type describing the Cooler settings
+type Cooler struct {
+	Enabled xsd.Boolean `xml:"tth:Enabled"`
+	RunTime xsd.Float   `xml:"tth:RunTime,omitempty"`
+}
+
+// CoolerOptions describes valid ranges for the thermal device cooler settings
+type CoolerOptions struct {
+	Enabled *xsd.Boolean `xml:"tth:Enabled,omitempty"`
+}
+
+// RadiometryGlobalParameters holds default values that will be used in measurement modules when local parameters are not specified for the module (these are still required for valid temperature calculations)
+type RadiometryGlobalParameters struct {
+	ReflectedAmbientTemperature xsd.Float `xml:"tth:ReflectedAmbientTemperature"`
+	Emissivity                  xsd.Float `xml:"tth:Emissivity"`
+	DistanceToObject            xsd.Float `xml:"tth:DistanceToObject"`
+	RelativeHumidity            xsd.Float `xml:"tth:RelativeHumidity,omitempty"`
+	AtmosphericTemperature      xsd.Float `xml:"tth:AtmosphericTemperature,omitempty"`
+	AtmosphericTransmittance    xsd.Float `xml:"tth:AtmosphericTransmittance,omitempty"`
+	ExtOpticsTemperature        xsd.Float `xml:"tth:ExtOpticsTemperature,omitempty"`
+	ExtOpticsTransmittance      xsd.Float `xml:"tth:ExtOpticsTransmittance,omitempty"`
+}
+
+// RadiometryGlobalParameterOptions describes valid ranges for the different radiometry parameters required for accurate temperature calculation
+type RadiometryGlobalParameterOptions struct {
+	ReflectedAmbientTemperature onvif.FloatRange  `xml:"tth:ReflectedAmbientTemperature"`
+	Emissivity                  onvif.FloatRange  `xml:"tth:Emissivity"`
+	DistanceToObject            onvif.FloatRange  `xml:"tth:DistanceToObject"`
+	RelativeHumidity            *onvif.FloatRange `xml:"tth:RelativeHumidity,omitempty"`
+	AtmosphericTemperature      *onvif.FloatRange `xml:"tth:AtmosphericTemperature,omitempty"`
+	AtmosphericTransmittance    *onvif.FloatRange `xml:"tth:AtmosphericTransmittance,omitempty"`
+	ExtOpticsTemperature        *onvif.FloatRange `xml:"tth:ExtOpticsTemperature,omitempty"`
+	ExtOpticsTransmittance      *onvif.FloatRange `xml:"tth:ExtOpticsTransmittance,omitempty"`
+}
+
+type Configuration struct {
+	ColorPalette ColorPalette `xml:"tth:ColorPalette"`
+	Polarity     Polarity     `xml:"tth:Polarity"`
+	NUCTable     *NUCTable    `xml:"tth:NUCTable,omitempty"`
+	Cooler       *Cooler      `xml:"tth:Cooler,omitempty"`
+}
+
+type Configurations struct {
+	Configuration Configuration        `xml:"tth:Configuration"`
+	Token         onvif.ReferenceToken `xml:"token,attr"`
+}
+
+type RadiometryConfiguration struct {
+	RadiometryGlobalParameters *RadiometryGlobalParameters `xml:"tth:RadiometryGlobalParameters,omitempty"`
+}
+
+type ConfigurationOptions struct {
+	ColorPalette  []ColorPalette `xml:"tth:ColorPalette"`
+	NUCTable      []NUCTable     `xml:"tth:NUCTable"`
+	CoolerOptions *CoolerOptions `xml:"tth:CoolerOptions,omitempty"`
+}
+
+type RadiometryConfigurationOptions struct {
+	RadiometryGlobalParameterOptions *RadiometryGlobalParameterOptions `xml:"tth:RadiometryGlobalParameterOptions,omitempty"`
+}
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tth:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type Capabilities struct {
+	Radiometry xsd.Boolean `xml:"Radiometry,attr,omitempty"`
+}
+
+type GetConfigurationOptions struct {
+	XMLName          string               `xml:"tth:GetConfigurationOptions"`
+	VideoSourceToken onvif.ReferenceToken `xml:"tth:VideoSourceToken"`
+}
+
+type GetConfigurationOptionsResponse struct {
+	ConfigurationOptions ConfigurationOptions
+}
+
+type GetConfiguration struct {
+	XMLName          string               `xml:"tth:GetConfiguration"`
+	VideoSourceToken onvif.ReferenceToken `xml:"tth:VideoSourceToken"`
+}
+
+type GetConfigurationResponse struct {
+	Configuration Configuration
+}
+
+type GetConfigurations struct {
+	XMLName string `xml:"tth:GetConfigurations"`
+}
+
+type GetConfigurationsResponse struct {
+	Configurations []Configurations
+}
+
+type SetConfiguration struct {
+	XMLName          string               `xml:"tth:SetConfiguration"`
+	VideoSourceToken onvif.ReferenceToken `xml:"tth:VideoSourceToken"`
+	Configuration    Configuration        `xml:"tth:Configuration"`
+}
+
+type SetConfigurationResponse struct {
+}
+
+type GetRadiometryConfigurationOptions struct {
+	XMLName          string               `xml:"tth:GetRadiometryConfigurationOptions"`
+	VideoSourceToken onvif.ReferenceToken `xml:"tth:VideoSourceToken"`
+}
+
+type GetRadiometryConfigurationOptionsResponse struct {
+	ConfigurationOptions RadiometryConfigurationOptions
+}
+
+type GetRadiometryConfiguration struct {
+	XMLName          string               `xml:"tth:GetRadiometryConfiguration"`
+	VideoSourceToken onvif.ReferenceToken `xml:"tth:VideoSourceToken"`
+}
+
+type GetRadiometryConfigurationResponse struct {
+	Configuration RadiometryConfiguration
+}
+
+type SetRadiometryConfiguration struct {
+	XMLName          string                  `xml:"tth:SetRadiometryConfiguration"`
+	VideoSourceToken onvif.ReferenceToken    `xml:"tth:VideoSourceToken"`
+	Configuration    RadiometryConfiguration `xml:"tth:Configuration"`
+}
+
+type SetRadiometryConfigurationResponse struct {
+}
+
+// Actions maps the request elements of the service to their WS-Addressing action
+var Actions = map[string]string{
+	"tth:GetServiceCapabilities":            "http://www.onvif.org/ver10/thermal/wsdl/GetServiceCapabilities",
+	"tth:GetConfigurationOptions":           "http://www.onvif.org/ver10/thermal/wsdl/GetConfigurationOptions",
+	"tth:GetConfiguration":                  "http://www.onvif.org/ver10/thermal/wsdl/GetConfiguration",
+	"tth:GetConfigurations":                 "http://www.onvif.org/ver10/thermal/wsdl/GetConfigurations",
+	"tth:SetConfiguration":                  "http://www.onvif.org/ver10/thermal/wsdl/SetConfiguration",
+	"tth:GetRadiometryConfigurationOptions": "http://www.onvif.org/ver10/thermal/wsdl/GetRadiometryConfigurationOptions",
+	"tth:GetRadiometryConfiguration":        "http://www.onvif.org/ver10/thermal/wsdl/GetRadiometryConfiguration",
+	"tth:SetRadiometryConfiguration":        "http://www.onvif.org/ver10/thermal/wsdl/SetRadiometryConfiguration",
+}
+
+func init() {
+	for tag, action := range Actions {
+		gosoap.RegisterAction(tag, action)
+	}
+}
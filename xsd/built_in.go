@@ -8,6 +8,7 @@ import (
 	"log"
 	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -205,6 +206,41 @@ func (tp Duration) NewDateTime(years, months, days, hours, minutes, seconds stri
 	return Duration(i.ISO8601Duration())
 }
 
+var durationRegex = regexp.MustCompile(`^(-)?P(?:([0-9.]+)Y)?(?:([0-9.]+)M)?(?:([0-9.]+)W)?(?:([0-9.]+)D)?(?:T(?:([0-9.]+)H)?(?:([0-9.]+)M)?(?:([0-9.]+)S)?)?$`)
+
+// NewDuration returns d as an xsd duration, e.g. PT1.5S
+func NewDuration(d time.Duration) Duration {
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	return Duration(sign + "PT" + strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "S")
+}
+
+// TimeDuration returns the duration tp, years count 365 days and months 30 days
+func (tp Duration) TimeDuration() (time.Duration, error) {
+	match := durationRegex.FindStringSubmatch(strings.TrimSpace(string(tp)))
+	if match == nil || strings.HasSuffix(string(tp), "T") {
+		return 0, fmt.Errorf("invalid duration %q", string(tp))
+	}
+	units := []time.Duration{365 * 24 * time.Hour, 30 * 24 * time.Hour, 7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if match[i+2] == "" {
+			continue
+		}
+		var value float64
+		if _, err := fmt.Sscanf(match[i+2], "%g", &value); err != nil {
+			return 0, fmt.Errorf("invalid duration %q", string(tp))
+		}
+		d += time.Duration(value * float64(unit))
+	}
+	if match[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
 /*
 	DateTime values may be viewed as objects with integer-valued year, month, day, hour
 	and minute properties, a decimal-valued second property, and a boolean timezoned property.
@@ -305,7 +341,7 @@ type GYearMonth AnySimpleType
 	Construct an instance of xsd GYearMonth type
 */
 func (tp GYearMonth) NewGYearMonth(time time.Time) GYearMonth {
-	return GYearMonth(fmt.Sprintf("", time.Year(), "-", time.Month()))
+	return GYearMonth(fmt.Sprintf("%04d-%02d", time.Year(), int(time.Month())))
 	//return GYearMonth(time.Format("2004-04-05:00"))
 }
 
@@ -327,7 +363,7 @@ type GYear AnySimpleType
 	Construct an instance of xsd GYear type
 */
 func (tp GYear) NewGYear(time time.Time) GYear {
-	return GYear(fmt.Sprintf("", time.Year()))
+	return GYear(fmt.Sprintf("%04d", time.Year()))
 	//return GYearMonth(time.Format("2004-04-05:00"))
 }
 
@@ -347,7 +383,7 @@ type GMonthDay AnySimpleType
 	Construct an instance of xsd GMonthDay type
 */
 func (tp GMonthDay) NewGMonthDay(time time.Time) GMonthDay {
-	return GMonthDay(fmt.Sprintf("--", time.Month(), "-", time.Day()))
+	return GMonthDay(fmt.Sprintf("--%02d-%02d", int(time.Month()), time.Day()))
 }
 
 /*
@@ -367,7 +403,7 @@ type GDay AnySimpleType
 	Construct an instance of xsd GDay type
 */
 func (tp GDay) NewGDay(time time.Time) GDay {
-	return GDay(fmt.Sprintf("---", time.Day()))
+	return GDay(fmt.Sprintf("---%02d", time.Day()))
 }
 
 /*
@@ -385,7 +421,7 @@ func (tp GDay) NewGDay(time time.Time) GDay {
 type GMonth AnySimpleType
 
 func (tp GMonth) NewGMonth(time time.Time) GMonth {
-	return GMonth(fmt.Sprintf("--", time.Month()))
+	return GMonth(fmt.Sprintf("--%02d", int(time.Month())))
 }
 
 /*
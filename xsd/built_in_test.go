@@ -0,0 +1,70 @@
+package xsd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDurationRoundTrip(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want Duration
+	}{
+		{0, "PT0S"},
+		{1500 * time.Millisecond, "PT1.5S"},
+		{50 * time.Microsecond, "PT0.00005S"},
+		{300 * time.Hour, "PT1080000S"},
+		{-2 * time.Minute, "-PT120S"},
+		{time.Nanosecond, "PT0.000000001S"},
+	}
+	for _, test := range tests {
+		got := NewDuration(test.d)
+		if got != test.want {
+			t.Errorf("NewDuration(%v) = %s, want %s", test.d, got, test.want)
+		}
+		back, err := got.TimeDuration()
+		if err != nil || back != test.d {
+			t.Errorf("%s.TimeDuration() = %v, %v, want %v", got, back, err, test.d)
+		}
+	}
+}
+
+func TestTimeDuration(t *testing.T) {
+	tests := []struct {
+		in      Duration
+		want    time.Duration
+		wantErr bool
+	}{
+		{"PT1H2M3.5S", time.Hour + 2*time.Minute + 3500*time.Millisecond, false},
+		{"P1DT12H", 36 * time.Hour, false},
+		{"P1W", 7 * 24 * time.Hour, false},
+		{" PT10S ", 10 * time.Second, false},
+		{"PT", 0, true},
+		{"10S", 0, true},
+		{"", 0, true},
+	}
+	for _, test := range tests {
+		got, err := test.in.TimeDuration()
+		if (err != nil) != test.wantErr || got != test.want {
+			t.Errorf("%q.TimeDuration() = %v, %v", test.in, got, err)
+		}
+	}
+}
+
+func TestGregorianTypes(t *testing.T) {
+	date := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		got, want string
+	}{
+		{string(GYearMonth("").NewGYearMonth(date)), "2024-03"},
+		{string(GYear("").NewGYear(date)), "2024"},
+		{string(GMonthDay("").NewGMonthDay(date)), "--03-05"},
+		{string(GDay("").NewGDay(date)), "---05"},
+		{string(GMonth("").NewGMonth(date)), "--03"},
+	}
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Errorf("got %s, want %s", test.got, test.want)
+		}
+	}
+}
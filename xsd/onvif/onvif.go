@@ -62,17 +62,17 @@ type OSDConfiguration struct {
 	VideoSourceConfigurationToken OSDReference              `xml:"onvif:VideoSourceConfigurationToken"`
 	Type                          OSDType                   `xml:"onvif:Type"`
 	Position                      OSDPosConfiguration       `xml:"onvif:Position"`
-	TextString                    OSDTextConfiguration      `xml:"onvif:TextString"`
-	Image                         OSDImgConfiguration       `xml:"onvif:Image"`
-	Extension                     OSDConfigurationExtension `xml:"onvif:Extension"`
+	TextString                    *OSDTextConfiguration     `xml:"onvif:TextString,omitempty"`
+	Image                         *OSDImgConfiguration      `xml:"onvif:Image,omitempty"`
+	Extension                     OSDConfigurationExtension `xml:"onvif:Extension,omitempty"`
 }
 
 type OSDType xsd.String
 
 type OSDPosConfiguration struct {
 	Type      string                       `xml:"onvif:Type"`
-	Pos       Vector                       `xml:"onvif:Pos"`
-	Extension OSDPosConfigurationExtension `xml:"onvif:Extension"`
+	Pos       *Vector                      `xml:"onvif:Pos,omitempty"`
+	Extension OSDPosConfigurationExtension `xml:"onvif:Extension,omitempty"`
 }
 
 type Vector struct {
@@ -85,20 +85,20 @@ type OSDPosConfigurationExtension xsd.AnyType
 type OSDReference ReferenceToken
 
 type OSDTextConfiguration struct {
-	IsPersistentText xsd.Boolean `xml:"IsPersistentText,attr"`
+	IsPersistentText xsd.Boolean `xml:"IsPersistentText,attr,omitempty"`
 
 	Type            xsd.String                    `xml:"onvif:Type"`
-	DateFormat      xsd.String                    `xml:"onvif:DateFormat"`
-	TimeFormat      xsd.String                    `xml:"onvif:TimeFormat"`
-	FontSize        xsd.Int                       `xml:"onvif:FontSize"`
-	FontColor       OSDColor                      `xml:"onvif:FontColor"`
-	BackgroundColor OSDColor                      `xml:"onvif:BackgroundColor"`
-	PlainText       xsd.String                    `xml:"onvif:PlainText"`
-	Extension       OSDTextConfigurationExtension `xml:"onvif:Extension"`
+	DateFormat      xsd.String                    `xml:"onvif:DateFormat,omitempty"`
+	TimeFormat      xsd.String                    `xml:"onvif:TimeFormat,omitempty"`
+	FontSize        xsd.Int                       `xml:"onvif:FontSize,omitempty"`
+	FontColor       *OSDColor                     `xml:"onvif:FontColor,omitempty"`
+	BackgroundColor *OSDColor                     `xml:"onvif:BackgroundColor,omitempty"`
+	PlainText       xsd.String                    `xml:"onvif:PlainText,omitempty"`
+	Extension       OSDTextConfigurationExtension `xml:"onvif:Extension,omitempty"`
 }
 
 type OSDColor struct {
-	Transparent int `xml:"Transparent,attr"`
+	Transparent int `xml:"Transparent,attr,omitempty"`
 
 	Color Color `xml:"onvif:Color"`
 }
@@ -107,14 +107,14 @@ type Color struct {
 	X          float64    `xml:"X,attr"`
 	Y          float64    `xml:"Y,attr"`
 	Z          float64    `xml:"Z,attr"`
-	Colorspace xsd.AnyURI `xml:"Colorspace,attr"`
+	Colorspace xsd.AnyURI `xml:"Colorspace,attr,omitempty"`
 }
 
 type OSDTextConfigurationExtension xsd.AnyType
 
 type OSDImgConfiguration struct {
 	ImgPath   xsd.AnyURI                   `xml:"onvif:ImgPath"`
-	Extension OSDImgConfigurationExtension `xml:"onvif:Extension"`
+	Extension OSDImgConfigurationExtension `xml:"onvif:Extension,omitempty"`
 }
 
 type OSDImgConfigurationExtension xsd.AnyType
@@ -215,72 +215,72 @@ type VideoSourceExtension struct {
 }
 
 type ImagingSettings20 struct {
-	BacklightCompensation BacklightCompensation20    `xml:"onvif:BacklightCompensation"`
-	Brightness            float64                    `xml:"onvif:Brightness"`
-	ColorSaturation       float64                    `xml:"onvif:ColorSaturation"`
-	Contrast              float64                    `xml:"onvif:Contrast"`
-	Exposure              Exposure20                 `xml:"onvif:Exposure"`
-	Focus                 FocusConfiguration20       `xml:"onvif:Focus"`
-	IrCutFilter           IrCutFilterMode            `xml:"onvif:IrCutFilter"`
-	Sharpness             float64                    `xml:"onvif:Sharpness"`
-	WideDynamicRange      WideDynamicRange20         `xml:"onvif:WideDynamicRange"`
-	WhiteBalance          WhiteBalance20             `xml:"onvif:WhiteBalance"`
-	Extension             ImagingSettingsExtension20 `xml:"onvif:Extension"`
+	BacklightCompensation *BacklightCompensation20    `xml:"onvif:BacklightCompensation,omitempty"`
+	Brightness            *float64                    `xml:"onvif:Brightness,omitempty"`
+	ColorSaturation       *float64                    `xml:"onvif:ColorSaturation,omitempty"`
+	Contrast              *float64                    `xml:"onvif:Contrast,omitempty"`
+	Exposure              *Exposure20                 `xml:"onvif:Exposure,omitempty"`
+	Focus                 *FocusConfiguration20       `xml:"onvif:Focus,omitempty"`
+	IrCutFilter           IrCutFilterMode             `xml:"onvif:IrCutFilter,omitempty"`
+	Sharpness             *float64                    `xml:"onvif:Sharpness,omitempty"`
+	WideDynamicRange      *WideDynamicRange20         `xml:"onvif:WideDynamicRange,omitempty"`
+	WhiteBalance          *WhiteBalance20             `xml:"onvif:WhiteBalance,omitempty"`
+	Extension             *ImagingSettingsExtension20 `xml:"onvif:Extension,omitempty"`
 }
 
 type BacklightCompensation20 struct {
 	Mode  BacklightCompensationMode `xml:"onvif:Mode"`
-	Level float64                   `xml:"onvif:Level"`
+	Level *float64                  `xml:"onvif:Level,omitempty"`
 }
 
 type Exposure20 struct {
 	Mode            ExposureMode     `xml:"onvif:Mode"`
-	Priority        ExposurePriority `xml:"onvif:Priority"`
-	Window          Rectangle        `xml:"onvif:Window"`
-	MinExposureTime float64          `xml:"onvif:MinExposureTime"`
-	MaxExposureTime float64          `xml:"onvif:MaxExposureTime"`
-	MinGain         float64          `xml:"onvif:MinGain"`
-	MaxGain         float64          `xml:"onvif:MaxGain"`
-	MinIris         float64          `xml:"onvif:MinIris"`
-	MaxIris         float64          `xml:"onvif:MaxIris"`
-	ExposureTime    float64          `xml:"onvif:ExposureTime"`
-	Gain            float64          `xml:"onvif:Gain"`
-	Iris            float64          `xml:"onvif:Iris"`
+	Priority        ExposurePriority `xml:"onvif:Priority,omitempty"`
+	Window          *Rectangle       `xml:"onvif:Window,omitempty"`
+	MinExposureTime *float64         `xml:"onvif:MinExposureTime,omitempty"`
+	MaxExposureTime *float64         `xml:"onvif:MaxExposureTime,omitempty"`
+	MinGain         *float64         `xml:"onvif:MinGain,omitempty"`
+	MaxGain         *float64         `xml:"onvif:MaxGain,omitempty"`
+	MinIris         *float64         `xml:"onvif:MinIris,omitempty"`
+	MaxIris         *float64         `xml:"onvif:MaxIris,omitempty"`
+	ExposureTime    *float64         `xml:"onvif:ExposureTime,omitempty"`
+	Gain            *float64         `xml:"onvif:Gain,omitempty"`
+	Iris            *float64         `xml:"onvif:Iris,omitempty"`
 }
 
 type FocusConfiguration20 struct {
 	AutoFocusMode AutoFocusMode                 `xml:"onvif:AutoFocusMode"`
-	DefaultSpeed  float64                       `xml:"onvif:DefaultSpeed"`
-	NearLimit     float64                       `xml:"onvif:NearLimit"`
-	FarLimit      float64                       `xml:"onvif:FarLimit"`
-	Extension     FocusConfiguration20Extension `xml:"onvif:Extension"`
+	DefaultSpeed  *float64                      `xml:"onvif:DefaultSpeed,omitempty"`
+	NearLimit     *float64                      `xml:"onvif:NearLimit,omitempty"`
+	FarLimit      *float64                      `xml:"onvif:FarLimit,omitempty"`
+	Extension     FocusConfiguration20Extension `xml:"onvif:Extension,omitempty"`
 }
 
 type FocusConfiguration20Extension xsd.AnyType
 
 type WideDynamicRange20 struct {
 	Mode  WideDynamicMode `xml:"onvif:Mode"`
-	Level float64         `xml:"onvif:Level"`
+	Level *float64        `xml:"onvif:Level,omitempty"`
 }
 
 type WhiteBalance20 struct {
 	Mode      WhiteBalanceMode        `xml:"onvif:Mode"`
-	CrGain    float64                 `xml:"onvif:CrGain"`
-	CbGain    float64                 `xml:"onvif:CbGain"`
-	Extension WhiteBalance20Extension `xml:"onvif:Extension"`
+	CrGain    *float64                `xml:"onvif:CrGain,omitempty"`
+	CbGain    *float64                `xml:"onvif:CbGain,omitempty"`
+	Extension WhiteBalance20Extension `xml:"onvif:Extension,omitempty"`
 }
 
 type WhiteBalance20Extension xsd.AnyType
 
 type ImagingSettingsExtension20 struct {
-	ImageStabilization ImageStabilization          `xml:"onvif:ImageStabilization"`
-	Extension          ImagingSettingsExtension202 `xml:"onvif:Extension"`
+	ImageStabilization *ImageStabilization          `xml:"onvif:ImageStabilization,omitempty"`
+	Extension          *ImagingSettingsExtension202 `xml:"onvif:Extension,omitempty"`
 }
 
 type ImageStabilization struct {
 	Mode      ImageStabilizationMode      `xml:"onvif:Mode"`
-	Level     float64                     `xml:"onvif:Level"`
-	Extension ImageStabilizationExtension `xml:"onvif:Extension"`
+	Level     *float64                    `xml:"onvif:Level,omitempty"`
+	Extension ImageStabilizationExtension `xml:"onvif:Extension,omitempty"`
 }
 
 type ImageStabilizationMode xsd.String
@@ -288,38 +288,38 @@ type ImageStabilizationMode xsd.String
 type ImageStabilizationExtension xsd.AnyType
 
 type ImagingSettingsExtension202 struct {
-	IrCutFilterAutoAdjustment IrCutFilterAutoAdjustment   `xml:"onvif:IrCutFilterAutoAdjustment"`
-	Extension                 ImagingSettingsExtension203 `xml:"onvif:Extension"`
+	IrCutFilterAutoAdjustment []IrCutFilterAutoAdjustment  `xml:"onvif:IrCutFilterAutoAdjustment,omitempty"`
+	Extension                 *ImagingSettingsExtension203 `xml:"onvif:Extension,omitempty"`
 }
 
 type IrCutFilterAutoAdjustment struct {
 	BoundaryType   string                             `xml:"onvif:BoundaryType"`
-	BoundaryOffset float64                            `xml:"onvif:BoundaryOffset"`
-	ResponseTime   xsd.Duration                       `xml:"onvif:ResponseTime"`
-	Extension      IrCutFilterAutoAdjustmentExtension `xml:"onvif:Extension"`
+	BoundaryOffset *float64                           `xml:"onvif:BoundaryOffset,omitempty"`
+	ResponseTime   xsd.Duration                       `xml:"onvif:ResponseTime,omitempty"`
+	Extension      IrCutFilterAutoAdjustmentExtension `xml:"onvif:Extension,omitempty"`
 }
 
 type IrCutFilterAutoAdjustmentExtension xsd.AnyType
 
 type ImagingSettingsExtension203 struct {
-	ToneCompensation ToneCompensation            `xml:"onvif:ToneCompensation"`
-	Defogging        Defogging                   `xml:"onvif:Defogging"`
-	NoiseReduction   NoiseReduction              `xml:"onvif:NoiseReduction"`
-	Extension        ImagingSettingsExtension204 `xml:"onvif:Extension"`
+	ToneCompensation *ToneCompensation           `xml:"onvif:ToneCompensation,omitempty"`
+	Defogging        *Defogging                  `xml:"onvif:Defogging,omitempty"`
+	NoiseReduction   *NoiseReduction             `xml:"onvif:NoiseReduction,omitempty"`
+	Extension        ImagingSettingsExtension204 `xml:"onvif:Extension,omitempty"`
 }
 
 type ToneCompensation struct {
 	Mode      string                    `xml:"onvif:Mode"`
-	Level     float64                   `xml:"onvif:Level"`
-	Extension ToneCompensationExtension `xml:"onvif:Extension"`
+	Level     *float64                  `xml:"onvif:Level,omitempty"`
+	Extension ToneCompensationExtension `xml:"onvif:Extension,omitempty"`
 }
 
 type ToneCompensationExtension xsd.AnyType
 
 type Defogging struct {
-	Mode      string
-	Level     float64
-	Extension DefoggingExtension
+	Mode      string             `xml:"onvif:Mode"`
+	Level     *float64           `xml:"onvif:Level,omitempty"`
+	Extension DefoggingExtension `xml:"onvif:Extension,omitempty"`
 }
 
 type DefoggingExtension xsd.AnyType
@@ -330,6 +330,140 @@ type NoiseReduction struct {
 
 type ImagingSettingsExtension204 xsd.AnyType
 
+type ImagingOptions20 struct {
+	BacklightCompensation *BacklightCompensationOptions20
+	Brightness            *FloatRange
+	ColorSaturation       *FloatRange
+	Contrast              *FloatRange
+	Exposure              *ExposureOptions20
+	Focus                 *FocusOptions20
+	IrCutFilterModes      []IrCutFilterMode
+	Sharpness             *FloatRange
+	WideDynamicRange      *WideDynamicRangeOptions20
+	WhiteBalance          *WhiteBalanceOptions20
+	Extension             *ImagingOptions20Extension
+}
+
+type BacklightCompensationOptions20 struct {
+	Mode  []BacklightCompensationMode
+	Level *FloatRange
+}
+
+type ExposureOptions20 struct {
+	Mode            []ExposureMode
+	Priority        []ExposurePriority
+	MinExposureTime *FloatRange
+	MaxExposureTime *FloatRange
+	MinGain         *FloatRange
+	MaxGain         *FloatRange
+	MinIris         *FloatRange
+	MaxIris         *FloatRange
+	ExposureTime    *FloatRange
+	Gain            *FloatRange
+	Iris            *FloatRange
+}
+
+type FocusOptions20 struct {
+	AutoFocusModes []AutoFocusMode
+	DefaultSpeed   *FloatRange
+	NearLimit      *FloatRange
+	FarLimit       *FloatRange
+	Extension      FocusOptions20Extension
+}
+
+type FocusOptions20Extension xsd.AnyType
+
+type WideDynamicRangeOptions20 struct {
+	Mode  []WideDynamicMode
+	Level *FloatRange
+}
+
+type WhiteBalanceOptions20 struct {
+	Mode      []WhiteBalanceMode
+	YrGain    *FloatRange
+	YbGain    *FloatRange
+	Extension WhiteBalanceOptions20Extension
+}
+
+type WhiteBalanceOptions20Extension xsd.AnyType
+
+type ImagingOptions20Extension struct {
+	ImageStabilization *ImageStabilizationOptions
+	Extension          *ImagingOptions20Extension2
+}
+
+type ImageStabilizationOptions struct {
+	Mode  []ImageStabilizationMode
+	Level *FloatRange
+}
+
+type ImagingOptions20Extension2 struct {
+	IrCutFilterAutoAdjustment *IrCutFilterAutoAdjustmentOptions
+	Extension                 *ImagingOptions20Extension3
+}
+
+type IrCutFilterAutoAdjustmentOptions struct {
+	BoundaryType      []string
+	BoundaryOffset    xsd.Boolean
+	ResponseTimeRange *DurationRange
+}
+
+type ImagingOptions20Extension3 struct {
+	ToneCompensationOptions *ToneCompensationOptions
+	DefoggingOptions        *DefoggingOptions
+	NoiseReductionOptions   *NoiseReductionOptions
+}
+
+type ToneCompensationOptions struct {
+	Mode  []string
+	Level xsd.Boolean
+}
+
+type DefoggingOptions struct {
+	Mode  []string
+	Level xsd.Boolean
+}
+
+type NoiseReductionOptions struct {
+	Level xsd.Boolean
+}
+
+type MoveOptions20 struct {
+	Absolute   *AbsoluteFocusOptions
+	Relative   *RelativeFocusOptions20
+	Continuous *ContinuousFocusOptions
+}
+
+type AbsoluteFocusOptions struct {
+	Position FloatRange
+	Speed    *FloatRange
+}
+
+type RelativeFocusOptions20 struct {
+	Distance FloatRange
+	Speed    *FloatRange
+}
+
+type ContinuousFocusOptions struct {
+	Speed FloatRange
+}
+
+type ImagingStatus20 struct {
+	FocusStatus20 *FocusStatus20
+	Extension     ImagingStatus20Extension
+}
+
+type ImagingStatus20Extension xsd.AnyType
+
+type FocusStatus20 struct {
+	Position   float64
+	MoveStatus MoveStatus
+	Error      string
+	Extension  FocusStatus20Extension
+}
+
+type FocusStatus20Extension xsd.AnyType
+
 type VideoSourceExtension2 xsd.AnyType
 
 type AudioSource struct {
@@ -385,15 +519,15 @@ type RotateMode xsd.String
 type RotateExtension xsd.AnyType
 
 type VideoSourceConfigurationExtension2 struct {
-	LensDescription  LensDescription  `xml:"onvif:LensDescription"`
-	SceneOrientation SceneOrientation `xml:"onvif:SceneOrientation"`
+	LensDescription  []LensDescription `xml:"onvif:LensDescription"`
+	SceneOrientation SceneOrientation  `xml:"onvif:SceneOrientation"`
 }
 
 type LensDescription struct {
-	FocalLength float64        `xml:"FocalLength,attr"`
-	Offset      LensOffset     `xml:"onvif:Offset"`
-	Projection  LensProjection `xml:"onvif:Projection"`
-	XFactor     float64        `xml:"onvif:XFactor"`
+	FocalLength float64          `xml:"FocalLength,attr"`
+	Offset      LensOffset       `xml:"onvif:Offset"`
+	Projection  []LensProjection `xml:"onvif:Projection"`
+	XFactor     float64          `xml:"onvif:XFactor"`
 }
 
 type LensOffset struct {
@@ -425,8 +559,8 @@ type VideoEncoderConfiguration struct {
 	Resolution     VideoResolution        `xml:"onvif:Resolution"`
 	Quality        float64                `xml:"onvif:Quality"`
 	RateControl    VideoRateControl       `xml:"onvif:RateControl"`
-	MPEG4          Mpeg4Configuration     `xml:"onvif:MPEG4"`
-	H264           H264Configuration      `xml:"onvif:H264"`
+	MPEG4          *Mpeg4Configuration    `xml:"onvif:MPEG4,omitempty"`
+	H264           *H264Configuration     `xml:"onvif:H264,omitempty"`
 	Multicast      MulticastConfiguration `xml:"onvif:Multicast"`
 	SessionTimeout xsd.Duration           `xml:"onvif:SessionTimeout"`
 }
@@ -485,6 +619,65 @@ type AudioEncoderConfiguration struct {
 
 type AudioEncoding xsd.String
 
+//Media2
+
+type VideoEncoder2Configuration struct {
+	ConfigurationEntity
+	GovLength           int                     `xml:"GovLength,attr,omitempty"`
+	Profile             string                  `xml:"Profile,attr,omitempty"`
+	GuaranteedFrameRate *xsd.Boolean            `xml:"GuaranteedFrameRate,attr,omitempty"`
+	Encoding            string                  `xml:"onvif:Encoding"`
+	Resolution          VideoResolution2        `xml:"onvif:Resolution"`
+	RateControl         *VideoRateControl2      `xml:"onvif:RateControl,omitempty"`
+	Multicast           *MulticastConfiguration `xml:"onvif:Multicast,omitempty"`
+	Quality             float64                 `xml:"onvif:Quality"`
+}
+
+type VideoResolution2 struct {
+	Width  xsd.Int `xml:"onvif:Width"`
+	Height xsd.Int `xml:"onvif:Height"`
+}
+
+type VideoRateControl2 struct {
+	ConstantBitRate *xsd.Boolean `xml:"ConstantBitRate,attr,omitempty"`
+	FrameRateLimit  float64      `xml:"onvif:FrameRateLimit"`
+	BitrateLimit    xsd.Int      `xml:"onvif:BitrateLimit"`
+}
+
+type VideoEncoder2ConfigurationOptions struct {
+	GovLengthRange               IntAttrList        `xml:"GovLengthRange,attr"`
+	FrameRatesSupported          FloatAttrList      `xml:"FrameRatesSupported,attr"`
+	ProfilesSupported            StringAttrList     `xml:"ProfilesSupported,attr"`
+	ConstantBitRateSupported     xsd.Boolean        `xml:"ConstantBitRateSupported,attr"`
+	GuaranteedFrameRateSupported xsd.Boolean        `xml:"GuaranteedFrameRateSupported,attr"`
+	Encoding                     string             `xml:"onvif:Encoding"`
+	QualityRange                 FloatRange         `xml:"onvif:QualityRange"`
+	ResolutionsAvailable         []VideoResolution2 `xml:"onvif:ResolutionsAvailable"`
+	BitrateRange                 IntRange           `xml:"onvif:BitrateRange"`
+}
+
+type FloatAttrList struct {
+	FloatAttrList []float64
+}
+
+type AudioEncoder2Configuration struct {
+	ConfigurationEntity
+	Encoding   string                  `xml:"onvif:Encoding"`
+	Multicast  *MulticastConfiguration `xml:"onvif:Multicast,omitempty"`
+	Bitrate    int                     `xml:"onvif:Bitrate"`
+	SampleRate int                     `xml:"onvif:SampleRate"`
+}
+
+type AudioEncoder2ConfigurationOptions struct {
+	Encoding       string      `xml:"onvif:Encoding"`
+	BitrateList    IntAttrList `xml:"onvif:BitrateList"`
+	SampleRateList IntAttrList `xml:"onvif:SampleRateList"`
+}
+
+type Polygon struct {
+	Point []Vector `xml:"onvif:Point"`
+}
+
 type VideoAnalyticsConfiguration struct {
 	ConfigurationEntity
 	AnalyticsEngineConfiguration AnalyticsEngineConfiguration `xml:"onvif:AnalyticsEngineConfiguration"`
@@ -492,7 +685,7 @@ type VideoAnalyticsConfiguration struct {
 }
 
 type AnalyticsEngineConfiguration struct {
-	AnalyticsModule Config                                `xml:"onvif:AnalyticsModule"`
+	AnalyticsModule []Config                              `xml:"onvif:AnalyticsModule"`
 	Extension       AnalyticsEngineConfigurationExtension `xml:"onvif:Extension"`
 }
 
@@ -503,8 +696,8 @@ type Config struct {
 }
 
 type ItemList struct {
-	SimpleItem  SimpleItem        `xml:"onvif:SimpleItem"`
-	ElementItem ElementItem       `xml:"onvif:ElementItem"`
+	SimpleItem  []SimpleItem      `xml:"onvif:SimpleItem"`
+	ElementItem []ElementItem     `xml:"onvif:ElementItem"`
 	Extension   ItemListExtension `xml:"onvif:Extension"`
 }
 
@@ -522,7 +715,7 @@ type ItemListExtension xsd.AnyType
 type AnalyticsEngineConfigurationExtension xsd.AnyType
 
 type RuleEngineConfiguration struct {
-	Rule      Config                           `xml:"onvif:Rule"`
+	Rule      []Config                         `xml:"onvif:Rule"`
 	Extension RuleEngineConfigurationExtension `xml:"onvif:Extension"`
 }
 
@@ -548,8 +741,8 @@ type PTZConfiguration struct {
 }
 
 type PTZSpeed struct {
-	PanTilt Vector2D `xml:"onvif:PanTilt"`
-	Zoom    Vector1D `xml:"onvif:Zoom"`
+	PanTilt *Vector2D `xml:"onvif:PanTilt,omitempty"`
+	Zoom    *Vector1D `xml:"onvif:Zoom,omitempty"`
 }
 
 type Vector2D struct {
@@ -659,7 +852,7 @@ type ProfileExtension2 xsd.AnyType
 type VideoSourceConfigurationOptions struct {
 	MaximumNumberOfProfiles    int `xml:"MaximumNumberOfProfiles,attr"`
 	BoundsRange                IntRectangleRange
-	VideoSourceTokensAvailable ReferenceToken
+	VideoSourceTokensAvailable []ReferenceToken
 	Extension                  VideoSourceConfigurationOptionsExtension
 }
 
@@ -669,7 +862,7 @@ type VideoSourceConfigurationOptionsExtension struct {
 }
 
 type RotateOptions struct {
-	Mode       RotateMode
+	Mode       []RotateMode
 	DegreeList IntList
 	Extension  RotateOptionsExtension
 }
@@ -681,7 +874,7 @@ type IntList struct {
 type RotateOptionsExtension xsd.AnyType
 
 type VideoSourceConfigurationOptionsExtension2 struct {
-	SceneOrientationMode SceneOrientationMode
+	SceneOrientationMode []SceneOrientationMode
 }
 
 type VideoEncoderConfigurationOptions struct {
@@ -693,25 +886,25 @@ type VideoEncoderConfigurationOptions struct {
 }
 
 type JpegOptions struct {
-	ResolutionsAvailable  VideoResolution
+	ResolutionsAvailable  []VideoResolution
 	FrameRateRange        IntRange
 	EncodingIntervalRange IntRange
 }
 
 type Mpeg4Options struct {
-	ResolutionsAvailable   VideoResolution
+	ResolutionsAvailable   []VideoResolution
 	GovLengthRange         IntRange
 	FrameRateRange         IntRange
 	EncodingIntervalRange  IntRange
-	Mpeg4ProfilesSupported Mpeg4Profile
+	Mpeg4ProfilesSupported []Mpeg4Profile
 }
 
 type H264Options struct {
-	ResolutionsAvailable  VideoResolution
+	ResolutionsAvailable  []VideoResolution
 	GovLengthRange        IntRange
 	FrameRateRange        IntRange
 	EncodingIntervalRange IntRange
-	H264ProfilesSupported H264Profile
+	H264ProfilesSupported []H264Profile
 }
 
 type VideoEncoderOptionsExtension struct {
@@ -739,14 +932,14 @@ type H264Options2 struct {
 type VideoEncoderOptionsExtension2 xsd.AnyType
 
 type AudioSourceConfigurationOptions struct {
-	InputTokensAvailable ReferenceToken
+	InputTokensAvailable []ReferenceToken
 	Extension            AudioSourceOptionsExtension
 }
 
 type AudioSourceOptionsExtension xsd.AnyType
 
 type AudioEncoderConfigurationOptions struct {
-	Options AudioEncoderConfigurationOption
+	Options []AudioEncoderConfigurationOption
 }
 
 type AudioEncoderConfigurationOption struct {
@@ -771,15 +964,15 @@ type PTZStatusFilterOptions struct {
 type PTZStatusFilterOptionsExtension xsd.AnyType
 
 type MetadataConfigurationOptionsExtension struct {
-	CompressionType string
+	CompressionType []string
 	Extension       MetadataConfigurationOptionsExtension2
 }
 
 type MetadataConfigurationOptionsExtension2 xsd.AnyType
 
 type AudioOutputConfigurationOptions struct {
-	OutputTokensAvailable ReferenceToken
-	SendPrimacyOptions    xsd.AnyURI
+	OutputTokensAvailable []ReferenceToken
+	SendPrimacyOptions    []xsd.AnyURI
 	OutputLevelRange      IntRange
 }
 
@@ -852,8 +1045,8 @@ type VideoSourceModeExtension xsd.AnyType
 
 type OSDConfigurationOptions struct {
 	MaximumNumberOfOSDs MaximumNumberOfOSDs
-	Type                OSDType
-	PositionOption      string
+	Type                []OSDType
+	PositionOption      []string
 	TextOption          OSDTextOptions
 	ImageOption         OSDImgOptions
 	Extension           OSDConfigurationOptionsExtension
@@ -869,10 +1062,10 @@ type MaximumNumberOfOSDs struct {
 }
 
 type OSDTextOptions struct {
-	Type            string
+	Type            []string
 	FontSizeRange   IntRange
-	DateFormat      string
-	TimeFormat      string
+	DateFormat      []string
+	TimeFormat      []string
 	FontColor       OSDColorOptions
 	BackgroundColor OSDColorOptions
 	Extension       OSDTextOptionsExtension
@@ -885,8 +1078,8 @@ type OSDColorOptions struct {
 }
 
 type ColorOptions struct {
-	ColorList       Color
-	ColorspaceRange ColorspaceRange
+	ColorList       []Color
+	ColorspaceRange []ColorspaceRange
 }
 
 type ColorspaceRange struct {
@@ -906,7 +1099,7 @@ type OSDImgOptions struct {
 	MaxWidth         int            `xml:"MaxWidth,attr"`
 	MaxHeight        int            `xml:"MaxHeight,attr"`
 
-	ImagePath xsd.AnyURI
+	ImagePath []xsd.AnyURI
 	Extension OSDImgOptionsExtension
 }
 
@@ -928,19 +1121,19 @@ type PTZNode struct {
 	SupportedPTZSpaces     PTZSpaces
 	MaximumNumberOfPresets int
 	HomeSupported          xsd.Boolean
-	AuxiliaryCommands      AuxiliaryData
+	AuxiliaryCommands      []AuxiliaryData
 	Extension              PTZNodeExtension
 }
 
 type PTZSpaces struct {
-	AbsolutePanTiltPositionSpace    Space2DDescription
-	AbsoluteZoomPositionSpace       Space1DDescription
-	RelativePanTiltTranslationSpace Space2DDescription
-	RelativeZoomTranslationSpace    Space1DDescription
-	ContinuousPanTiltVelocitySpace  Space2DDescription
-	ContinuousZoomVelocitySpace     Space1DDescription
-	PanTiltSpeedSpace               Space1DDescription
-	ZoomSpeedSpace                  Space1DDescription
+	AbsolutePanTiltPositionSpace    []Space2DDescription
+	AbsoluteZoomPositionSpace       []Space1DDescription
+	RelativePanTiltTranslationSpace []Space2DDescription
+	RelativeZoomTranslationSpace    []Space1DDescription
+	ContinuousPanTiltVelocitySpace  []Space2DDescription
+	ContinuousZoomVelocitySpace     []Space1DDescription
+	PanTiltSpeedSpace               []Space1DDescription
+	ZoomSpeedSpace                  []Space1DDescription
 	Extension                       PTZSpacesExtension
 }
 
@@ -956,7 +1149,7 @@ type PTZNodeExtension struct {
 
 type PTZPresetTourSupported struct {
 	MaximumNumberOfPresetTours int
-	PTZPresetTourOperation     PTZPresetTourOperation
+	PTZPresetTourOperation     []PTZPresetTourOperation
 	Extension                  PTZPresetTourSupportedExtension
 }
 
@@ -989,14 +1182,14 @@ type PTControlDirectionOptions struct {
 }
 
 type EFlipOptions struct {
-	Mode      EFlipMode
+	Mode      []EFlipMode
 	Extension EFlipOptionsExtension
 }
 
 type EFlipOptionsExtension xsd.AnyType
 
 type ReverseOptions struct {
-	Mode      ReverseMode
+	Mode      []ReverseMode
 	Extension ReverseOptionsExtension
 }
 
@@ -1013,8 +1206,8 @@ type PTZPreset struct {
 }
 
 type PTZVector struct {
-	PanTilt Vector2D `xml:"onvif:PanTilt"`
-	Zoom    Vector1D `xml:"onvif:Zoom"`
+	PanTilt *Vector2D `xml:"onvif:PanTilt,omitempty"`
+	Zoom    *Vector1D `xml:"onvif:Zoom,omitempty"`
 }
 
 type PTZStatus struct {
@@ -1029,9 +1222,7 @@ type PTZMoveStatus struct {
 	Zoom    MoveStatus
 }
 
-type MoveStatus struct {
-	Status string
-}
+type MoveStatus xsd.String
 
 type GeoLocation struct {
 	Lon       xsd.Double `xml:"lon,attr"`
@@ -1041,34 +1232,34 @@ type GeoLocation struct {
 
 type PresetTour struct {
 	Token             ReferenceToken                 `xml:"token,attr"`
-	Name              Name                           `xml:"onvif:Name"`
+	Name              Name                           `xml:"onvif:Name,omitempty"`
 	Status            PTZPresetTourStatus            `xml:"onvif:Status"`
 	AutoStart         xsd.Boolean                    `xml:"onvif:AutoStart"`
 	StartingCondition PTZPresetTourStartingCondition `xml:"onvif:StartingCondition"`
-	TourSpot          PTZPresetTourSpot              `xml:"onvif:TourSpot"`
-	Extension         PTZPresetTourExtension         `xml:"onvif:Extension"`
+	TourSpot          []PTZPresetTourSpot            `xml:"onvif:TourSpot"`
+	Extension         PTZPresetTourExtension         `xml:"onvif:Extension,omitempty"`
 }
 
 type PTZPresetTourStatus struct {
 	State           PTZPresetTourState           `xml:"onvif:State"`
-	CurrentTourSpot PTZPresetTourSpot            `xml:"onvif:CurrentTourSpot"`
-	Extension       PTZPresetTourStatusExtension `xml:"onvif:Extension"`
+	CurrentTourSpot *PTZPresetTourSpot           `xml:"onvif:CurrentTourSpot,omitempty"`
+	Extension       PTZPresetTourStatusExtension `xml:"onvif:Extension,omitempty"`
 }
 
 type PTZPresetTourState xsd.String
 
 type PTZPresetTourSpot struct {
 	PresetDetail PTZPresetTourPresetDetail  `xml:"onvif:PresetDetail"`
-	Speed        PTZSpeed                   `xml:"onvif:Speed"`
-	StayTime     xsd.Duration               `xml:"onvif:StayTime"`
-	Extension    PTZPresetTourSpotExtension `xml:"onvif:Extension"`
+	Speed        *PTZSpeed                  `xml:"onvif:Speed,omitempty"`
+	StayTime     xsd.Duration               `xml:"onvif:StayTime,omitempty"`
+	Extension    PTZPresetTourSpotExtension `xml:"onvif:Extension,omitempty"`
 }
 
 type PTZPresetTourPresetDetail struct {
-	PresetToken   ReferenceToken             `xml:"onvif:PresetToken"`
-	Home          xsd.Boolean                `xml:"onvif:Home"`
-	PTZPosition   PTZVector                  `xml:"onvif:PTZPosition"`
-	TypeExtension PTZPresetTourTypeExtension `xml:"onvif:TypeExtension"`
+	PresetToken   ReferenceToken             `xml:"onvif:PresetToken,omitempty"`
+	Home          *xsd.Boolean               `xml:"onvif:Home,omitempty"`
+	PTZPosition   *PTZVector                 `xml:"onvif:PTZPosition,omitempty"`
+	TypeExtension PTZPresetTourTypeExtension `xml:"onvif:TypeExtension,omitempty"`
 }
 
 type PTZPresetTourTypeExtension xsd.AnyType
@@ -1078,11 +1269,11 @@ type PTZPresetTourSpotExtension xsd.AnyType
 type PTZPresetTourStatusExtension xsd.AnyType
 
 type PTZPresetTourStartingCondition struct {
-	RandomPresetOrder xsd.Boolean                             `xml:"RandomPresetOrder,attr"`
-	RecurringTime     xsd.Int                                 `xml:"onvif:RecurringTime"`
-	RecurringDuration xsd.Duration                            `xml:"onvif:RecurringDuration"`
-	Direction         PTZPresetTourDirection                  `xml:"onvif:Direction"`
-	Extension         PTZPresetTourStartingConditionExtension `xml:"onvif:Extension"`
+	RandomPresetOrder xsd.Boolean                             `xml:"RandomPresetOrder,attr,omitempty"`
+	RecurringTime     xsd.Int                                 `xml:"onvif:RecurringTime,omitempty"`
+	RecurringDuration xsd.Duration                            `xml:"onvif:RecurringDuration,omitempty"`
+	Direction         PTZPresetTourDirection                  `xml:"onvif:Direction,omitempty"`
+	Extension         PTZPresetTourStartingConditionExtension `xml:"onvif:Extension,omitempty"`
 }
 
 type PTZPresetTourDirection xsd.String
@@ -1100,7 +1291,7 @@ type PTZPresetTourOptions struct {
 type PTZPresetTourStartingConditionOptions struct {
 	RecurringTime     IntRange
 	RecurringDuration DurationRange
-	Direction         PTZPresetTourDirection
+	Direction         []PTZPresetTourDirection
 	Extension         PTZPresetTourStartingConditionOptionsExtension
 }
 
@@ -1112,7 +1303,7 @@ type PTZPresetTourSpotOptions struct {
 }
 
 type PTZPresetTourPresetDetailOptions struct {
-	PresetToken          ReferenceToken
+	PresetToken          []ReferenceToken
 	Home                 xsd.Boolean
 	PanTiltPositionSpace Space2DDescription
 	ZoomPositionSpace    Space1DDescription
@@ -1267,7 +1458,7 @@ type SystemCapabilities struct {
 	SystemBackup      xsd.Boolean
 	SystemLogging     xsd.Boolean
 	FirmwareUpgrade   xsd.Boolean
-	SupportedVersions OnvifVersion
+	SupportedVersions []OnvifVersion
 	Extension         SystemCapabilitiesExtension
 }
 
@@ -1289,18 +1480,18 @@ type IOCapabilities struct {
 
 type IOCapabilitiesExtension struct {
 	Auxiliary         xsd.Boolean
-	AuxiliaryCommands AuxiliaryData
+	AuxiliaryCommands []AuxiliaryData
 	Extension         IOCapabilitiesExtension2
 }
 
 type IOCapabilitiesExtension2 xsd.AnyType
 
 type SecurityCapabilities struct {
-	TLS1_1               xsd.Boolean
-	TLS1_2               xsd.Boolean
+	TLS1_1               xsd.Boolean `xml:"TLS1.1"`
+	TLS1_2               xsd.Boolean `xml:"TLS1.2"`
 	OnboardKeyGeneration xsd.Boolean
 	AccessPolicyConfig   xsd.Boolean
-	X_509Token           xsd.Boolean
+	X_509Token           xsd.Boolean `xml:"X.509Token"`
 	SAMLToken            xsd.Boolean
 	KerberosToken        xsd.Boolean
 	RELToken             xsd.Boolean
@@ -1308,13 +1499,13 @@ type SecurityCapabilities struct {
 }
 
 type SecurityCapabilitiesExtension struct {
-	TLS1_0    xsd.Boolean
+	TLS1_0    xsd.Boolean `xml:"TLS1.0"`
 	Extension SecurityCapabilitiesExtension2
 }
 
 type SecurityCapabilitiesExtension2 struct {
 	Dot1X              xsd.Boolean
-	SupportedEAPMethod int
+	SupportedEAPMethod []int
 	RemoteUserHandling xsd.Boolean
 }
 
@@ -1430,9 +1621,9 @@ type HostnameInformationExtension xsd.AnyType
 
 type DNSInformation struct {
 	FromDHCP     xsd.Boolean
-	SearchDomain xsd.Token
-	DNSFromDHCP  IPAddress
-	DNSManual    IPAddress
+	SearchDomain []xsd.Token
+	DNSFromDHCP  []IPAddress
+	DNSManual    []IPAddress
 	Extension    DNSInformationExtension
 }
 
@@ -1440,8 +1631,8 @@ type DNSInformationExtension xsd.AnyType
 
 type NTPInformation struct {
 	FromDHCP    xsd.Boolean
-	NTPFromDHCP NetworkHost
-	NTPManual   NetworkHost
+	NTPFromDHCP []NetworkHost
+	NTPManual   []NetworkHost
 	Extension   NTPInformationExtension
 }
 
@@ -1480,7 +1671,7 @@ type HwAddress xsd.Token
 type NetworkInterfaceLink struct {
 	AdminSettings NetworkInterfaceConnectionSetting
 	OperSettings  NetworkInterfaceConnectionSetting
-	InterfaceType IANA_IfTypes `xml:"IANA-IfTypes"`
+	InterfaceType IANA_IfTypes
 }
 
 type IANA_IfTypes xsd.Int
@@ -1496,8 +1687,8 @@ type Duplex xsd.String
 
 type NetworkInterfaceExtension struct {
 	InterfaceType IANA_IfTypes
-	Dot3          Dot3Configuration
-	Dot11         Dot11Configuration
+	Dot3          []Dot3Configuration
+	Dot11         []Dot11Configuration
 	Extension     NetworkInterfaceExtension2
 }
 
@@ -1558,10 +1749,10 @@ type IPv6NetworkInterface struct {
 type IPv6Configuration struct {
 	AcceptRouterAdvert xsd.Boolean
 	DHCP               IPv6DHCPConfiguration
-	Manual             PrefixedIPv6Address
-	LinkLocal          PrefixedIPv6Address
-	FromDHCP           PrefixedIPv6Address
-	FromRA             PrefixedIPv6Address
+	Manual             []PrefixedIPv6Address
+	LinkLocal          []PrefixedIPv6Address
+	FromDHCP           []PrefixedIPv6Address
+	FromRA             []PrefixedIPv6Address
 	Extension          IPv6ConfigurationExtension
 }
 
@@ -1581,7 +1772,7 @@ type IPv4NetworkInterface struct {
 }
 
 type IPv4Configuration struct {
-	Manual    PrefixedIPv4Address
+	Manual    []PrefixedIPv4Address
 	LinkLocal PrefixedIPv4Address
 	FromDHCP  PrefixedIPv4Address
 	DHCP      xsd.Boolean
@@ -1603,8 +1794,8 @@ type NetworkInterfaceSetConfiguration struct {
 }
 
 type NetworkInterfaceSetConfigurationExtension struct {
-	Dot3      Dot3Configuration                          `xml:"onvif:Dot3"`
-	Dot11     Dot11Configuration                         `xml:"onvif:Dot11"`
+	Dot3      []Dot3Configuration                        `xml:"onvif:Dot3"`
+	Dot11     []Dot11Configuration                       `xml:"onvif:Dot11"`
 	Extension NetworkInterfaceSetConfigurationExtension2 `xml:"onvif:Extension"`
 }
 
@@ -1613,20 +1804,20 @@ type NetworkInterfaceSetConfigurationExtension2 xsd.AnyType
 type IPv6NetworkInterfaceSetConfiguration struct {
 	Enabled            xsd.Boolean           `xml:"onvif:Enabled"`
 	AcceptRouterAdvert xsd.Boolean           `xml:"onvif:AcceptRouterAdvert"`
-	Manual             PrefixedIPv6Address   `xml:"onvif:Manual"`
+	Manual             []PrefixedIPv6Address `xml:"onvif:Manual"`
 	DHCP               IPv6DHCPConfiguration `xml:"onvif:DHCP"`
 }
 
 type IPv4NetworkInterfaceSetConfiguration struct {
-	Enabled xsd.Boolean         `xml:"onvif:Enabled"`
-	Manual  PrefixedIPv4Address `xml:"onvif:Manual"`
-	DHCP    xsd.Boolean         `xml:"onvif:DHCP"`
+	Enabled xsd.Boolean           `xml:"onvif:Enabled"`
+	Manual  []PrefixedIPv4Address `xml:"onvif:Manual"`
+	DHCP    xsd.Boolean           `xml:"onvif:DHCP"`
 }
 
 type NetworkProtocol struct {
 	Name      NetworkProtocolType      `xml:"onvif:Name"`
 	Enabled   xsd.Boolean              `xml:"onvif:Enabled"`
-	Port      xsd.Int                  `xml:"onvif:Port"`
+	Port      []xsd.Int                `xml:"onvif:Port"`
 	Extension NetworkProtocolExtension `xml:"onvif:Extension"`
 }
 
@@ -1636,19 +1827,19 @@ type NetworkProtocolExtension xsd.AnyType
 type NetworkProtocolType xsd.String
 
 type NetworkGateway struct {
-	IPv4Address IPv4Address
-	IPv6Address IPv6Address
+	IPv4Address []IPv4Address
+	IPv6Address []IPv6Address
 }
 
 type NetworkZeroConfiguration struct {
 	InterfaceToken ReferenceToken
 	Enabled        xsd.Boolean
-	Addresses      IPv4Address
+	Addresses      []IPv4Address
 	Extension      NetworkZeroConfigurationExtension
 }
 
 type NetworkZeroConfigurationExtension struct {
-	Additional *NetworkZeroConfiguration
+	Additional []NetworkZeroConfiguration
 	Extension  NetworkZeroConfigurationExtension2
 }
 
@@ -1656,8 +1847,8 @@ type NetworkZeroConfigurationExtension2 xsd.AnyType
 
 type IPAddressFilter struct {
 	Type        IPAddressFilterType      `xml:"onvif:Type"`
-	IPv4Address PrefixedIPv4Address      `xml:"onvif:IPv4Address,omitempty"`
-	IPv6Address PrefixedIPv6Address      `xml:"onvif:IPv6Address,omitempty"`
+	IPv4Address []PrefixedIPv4Address    `xml:"onvif:IPv4Address,omitempty"`
+	IPv6Address []PrefixedIPv6Address    `xml:"onvif:IPv6Address,omitempty"`
 	Extension   IPAddressFilterExtension `xml:"onvif:Extension,omitempty"`
 }
 
@@ -1740,7 +1931,7 @@ type Dot1XConfiguration struct {
 	Identity                xsd.String                  `xml:"onvif:Identity"`
 	AnonymousID             xsd.String                  `xml:"onvif:AnonymousID,omitempty"`
 	EAPMethod               xsd.Int                     `xml:"onvif:EAPMethod"`
-	CACertificateID         xsd.Token                   `xml:"onvif:CACertificateID,omitempty"`
+	CACertificateID         []xsd.Token                 `xml:"onvif:CACertificateID,omitempty"`
 	EAPMethodConfiguration  EAPMethodConfiguration      `xml:"onvif:EAPMethodConfiguration,omitempty"`
 	Extension               Dot1XConfigurationExtension `xml:"onvif:Extension,omitempty"`
 }
@@ -1782,9 +1973,9 @@ type Dot11SignalStrength xsd.String
 type Dot11AvailableNetworks struct {
 	SSID                  Dot11SSIDType
 	BSSID                 xsd.String
-	AuthAndMangementSuite Dot11AuthAndMangementSuite
-	PairCipher            Dot11Cipher
-	GroupCipher           Dot11Cipher
+	AuthAndMangementSuite []Dot11AuthAndMangementSuite
+	PairCipher            []Dot11Cipher
+	GroupCipher           []Dot11Cipher
 	SignalStrength        Dot11SignalStrength
 	Extension             Dot11AvailableNetworksExtension
 }
@@ -1795,7 +1986,7 @@ type Dot11AvailableNetworksExtension xsd.AnyType
 type Dot11AuthAndMangementSuite xsd.String
 
 type SystemLogUriList struct {
-	SystemLog SystemLogUri
+	SystemLog []SystemLogUri
 }
 
 type SystemLogUri struct {
@@ -1810,16 +2001,16 @@ type LocationEntity struct {
 	GeoSource xsd.AnyURI     `xml:"GeoSource,attr"`
 	AutoGeo   xsd.Boolean    `xml:"AutoGeo,attr"`
 
-	GeoLocation      GeoLocation      `xml:"onvif:GeoLocation"`
-	GeoOrientation   GeoOrientation   `xml:"onvif:GeoOrientation"`
-	LocalLocation    LocalLocation    `xml:"onvif:LocalLocation"`
-	LocalOrientation LocalOrientation `xml:"onvif:LocalOrientation"`
+	GeoLocation      *GeoLocation      `xml:"onvif:GeoLocation,omitempty"`
+	GeoOrientation   *GeoOrientation   `xml:"onvif:GeoOrientation,omitempty"`
+	LocalLocation    *LocalLocation    `xml:"onvif:LocalLocation,omitempty"`
+	LocalOrientation *LocalOrientation `xml:"onvif:LocalOrientation,omitempty"`
 }
 
 type LocalOrientation struct {
-	Lon       xsd.Double `xml:"lon,attr"`
-	Lat       xsd.Double `xml:"lat,attr"`
-	Elevation xsd.Float  `xml:"elevation,attr"`
+	Pan  xsd.Float `xml:"pan,attr"`
+	Tilt xsd.Float `xml:"tilt,attr"`
+	Roll xsd.Float `xml:"roll,attr"`
 }
 
 type LocalLocation struct {
@@ -1835,9 +2026,9 @@ type GeoOrientation struct {
 }
 
 type FocusMove struct {
-	Absolute   AbsoluteFocus   `xml:"onvif:Absolute"`
-	Relative   RelativeFocus   `xml:"onvif:Relative"`
-	Continuous ContinuousFocus `xml:"onvif:Continuous"`
+	Absolute   *AbsoluteFocus   `xml:"onvif:Absolute,omitempty"`
+	Relative   *RelativeFocus   `xml:"onvif:Relative,omitempty"`
+	Continuous *ContinuousFocus `xml:"onvif:Continuous,omitempty"`
 }
 
 type ContinuousFocus struct {
@@ -1845,13 +2036,13 @@ type ContinuousFocus struct {
 }
 
 type RelativeFocus struct {
-	Distance xsd.Float `xml:"onvif:Distance"`
-	Speed    xsd.Float `xml:"onvif:Speed"`
+	Distance xsd.Float  `xml:"onvif:Distance"`
+	Speed    *xsd.Float `xml:"onvif:Speed,omitempty"`
 }
 
 type AbsoluteFocus struct {
-	Position xsd.Float `xml:"onvif:Position"`
-	Speed    xsd.Float `xml:"onvif:Speed"`
+	Position xsd.Float  `xml:"onvif:Position"`
+	Speed    *xsd.Float `xml:"onvif:Speed,omitempty"`
 }
 
 type DateTime struct {